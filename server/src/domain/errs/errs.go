@@ -0,0 +1,113 @@
+// Package errs classifies the errors of the domain, the usecases and the
+// repositories by kind, and identifies them by stable codes. Errors are declared
+// by the constructors of their kinds, like
+//
+//	var TermNotFound = errs.NotFound("term_not_found", "term not found")
+//
+// so that they are matched by errors.Is regardless of their messages,
+// and classified by KindOf and CodeOf of this package.
+package errs
+
+import (
+	"errors"
+)
+
+// Kind is the kind of an error.
+type Kind int
+
+const (
+	// KindInternal is the kind of errors not classified, which are failures of the server.
+	KindInternal Kind = iota
+	// KindInvalid is of a request which is malformed or breaks a rule of the domain.
+	KindInvalid
+	// KindUnauthorized is of a request without valid credentials.
+	KindUnauthorized
+	// KindForbidden is of a request for what the user is not allowed to do.
+	KindForbidden
+	// KindNotFound is of a request for what does not exist, or is not visible to the user.
+	KindNotFound
+	// KindConflict is of a request which conflicts with the current state.
+	KindConflict
+	// KindGone is of a request for what existed but is no longer available.
+	KindGone
+	// KindTooLarge is of a request whose body is larger than allowed.
+	KindTooLarge
+	// KindUnavailable is of a request which the server could not serve in time, and may be retried.
+	KindUnavailable
+)
+
+// Internal is the code of the errors not classified.
+const Internal = "internal_server_error"
+
+// Error is an error of a kind, identified by its code.
+// Its message may be reworded, while its code must not.
+type Error struct {
+	kind    Kind
+	code    string
+	message string
+}
+
+func (e Error) Error() string {
+	return e.message
+}
+
+func (e Error) Kind() Kind {
+	return e.kind
+}
+
+func (e Error) Code() string {
+	return e.code
+}
+
+func Invalid(code, message string) Error {
+	return Error{KindInvalid, code, message}
+}
+
+func Unauthorized(code, message string) Error {
+	return Error{KindUnauthorized, code, message}
+}
+
+func Forbidden(code, message string) Error {
+	return Error{KindForbidden, code, message}
+}
+
+func NotFound(code, message string) Error {
+	return Error{KindNotFound, code, message}
+}
+
+func Conflict(code, message string) Error {
+	return Error{KindConflict, code, message}
+}
+
+func Gone(code, message string) Error {
+	return Error{KindGone, code, message}
+}
+
+func TooLarge(code, message string) Error {
+	return Error{KindTooLarge, code, message}
+}
+
+func Unavailable(code, message string) Error {
+	return Error{KindUnavailable, code, message}
+}
+
+// KindOf returns the kind of the first error classified in the chain of err.
+func KindOf(err error) Kind {
+	var e Error
+	if errors.As(err, &e) {
+		return e.kind
+	}
+
+	return KindInternal
+}
+
+// CodeOf returns the code of the first error classified in the chain of err,
+// or Internal if none is.
+func CodeOf(err error) string {
+	var e Error
+	if errors.As(err, &e) {
+		return e.code
+	}
+
+	return Internal
+}
@@ -0,0 +1,77 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+var (
+	termNotFound = NotFound("term_not_found", "term not found")
+	invalidToken = Unauthorized("invalid_token", "invalid token")
+)
+
+func TestIs(t *testing.T) {
+	var err error = termNotFound
+	if !errors.Is(err, termNotFound) {
+		t.Fatalf("expected: %v; got: %v\n", termNotFound, err)
+	}
+	if errors.Is(err, invalidToken) {
+		t.Fatalf("unexpected match: %v\n", invalidToken)
+	}
+	if errors.Is(err, NotFound("term_not_found", "no such term")) {
+		t.Fatalf("unexpected match: %v\n", "no such term")
+	}
+
+	wrapped := fmt.Errorf("loading timetables: %w", err)
+	if !errors.Is(wrapped, termNotFound) {
+		t.Fatalf("expected: %v; got: %v\n", termNotFound, wrapped)
+	}
+
+	var e Error
+	if !errors.As(wrapped, &e) || e != termNotFound {
+		t.Fatalf("expected: %v; got: %v\n", termNotFound, e)
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected Kind
+	}{
+		{Invalid("invalid_date", "invalid date"), KindInvalid},
+		{invalidToken, KindUnauthorized},
+		{Forbidden("past_term_is_read_only", "past term is read only"), KindForbidden},
+		{fmt.Errorf("wrapped: %w", termNotFound), KindNotFound},
+		{Conflict("already_exists", "already exists"), KindConflict},
+		{Gone("expired", "expired"), KindGone},
+		{TooLarge("too_large", "too large"), KindTooLarge},
+		{Unavailable("unavailable", "unavailable"), KindUnavailable},
+		{fmt.Errorf("connection refused"), KindInternal},
+		{nil, KindInternal},
+	}
+
+	for _, test := range tests {
+		if k := KindOf(test.err); k != test.expected {
+			t.Fatalf("expected: %v; got: %v (%v)\n", test.expected, k, test.err)
+		}
+	}
+}
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{termNotFound, "term_not_found"},
+		{fmt.Errorf("wrapped: %w", invalidToken), "invalid_token"},
+		{fmt.Errorf("connection refused"), Internal},
+		{nil, Internal},
+	}
+
+	for _, test := range tests {
+		if c := CodeOf(test.err); c != test.expected {
+			t.Fatalf("expected: %v; got: %v (%v)\n", test.expected, c, test.err)
+		}
+	}
+}
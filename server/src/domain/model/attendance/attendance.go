@@ -0,0 +1,107 @@
+package attendance
+
+import (
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+type Status int
+
+const (
+	Present Status = iota
+	Absent
+	Late
+	Cancelled
+)
+
+var statusNames = map[Status]string{
+	Present:   "present",
+	Absent:    "absent",
+	Late:      "late",
+	Cancelled: "cancelled",
+}
+
+func (s Status) String() string {
+	return statusNames[s]
+}
+
+func ParseStatus(s string) (Status, error) {
+	for status, name := range statusNames {
+		if name == s {
+			return status, nil
+		}
+	}
+
+	return Present, InvalidStatus
+}
+
+const (
+	Layout = "2006-01-02"
+)
+
+var (
+	InvalidStatus     = errs.Invalid("invalid_status", "invalid status")
+	InvalidDateFormat = errs.Invalid("invalid_date_format", "invalid date format")
+	InvalidPeriod     = errs.Invalid("invalid_period", "invalid period")
+)
+
+// Record is the attendance of the user at the class of a period on a date.
+// It keeps the subject of the class at the time of recording.
+type Record struct {
+	date      time.Time
+	period    int
+	status    Status
+	subjectID int
+	subject   string
+}
+
+func NewRecord(date string, period int, status Status) (Record, error) {
+	d, err := time.Parse(Layout, date)
+	if err != nil {
+		return Record{}, InvalidDateFormat
+	}
+	if period < 1 || period > timetables.Periods {
+		return Record{}, InvalidPeriod
+	}
+
+	return Record{date: d, period: period, status: status}, nil
+}
+
+// Of returns a copy of the record at the class.
+func (r Record) Of(c timetables.Class) Record {
+	return r.WithSubject(c.SubjectID(), c.Subject())
+}
+
+// WithSubject returns a copy of the record of the subject, whose id is 0 if it is not in the catalog.
+func (r Record) WithSubject(id int, name string) Record {
+	r.subjectID = id
+	r.subject = name
+	return r
+}
+
+func (r Record) Date() time.Time {
+	return r.date
+}
+
+func (r Record) TextDate() string {
+	return r.date.Format(Layout)
+}
+
+func (r Record) Period() int {
+	return r.period
+}
+
+func (r Record) Status() Status {
+	return r.status
+}
+
+// SubjectID returns the ID of the subject in the catalog, or 0 if the class referred to none.
+func (r Record) SubjectID() int {
+	return r.subjectID
+}
+
+func (r Record) Subject() string {
+	return r.subject
+}
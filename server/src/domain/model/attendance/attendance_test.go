@@ -0,0 +1,280 @@
+package attendance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+func TestNewRecord(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     string
+		period   int
+		expected error
+	}{
+		{"valid", "2020-04-08", 1, nil},
+		{"invalid date", "2020/04/08", 1, InvalidDateFormat},
+		{"period 0", "2020-04-08", 0, InvalidPeriod},
+		{"period 6", "2020-04-08", 6, InvalidPeriod},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewRecord(test.date, test.period, Present)
+			if test.expected == nil && err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if test.expected != nil && !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	for s, name := range statusNames {
+		parsed, err := ParseStatus(name)
+		if err != nil || parsed != s {
+			t.Fatalf("expected: %v; got: %v %v\n", s, parsed, err)
+		}
+	}
+
+	if _, err := ParseStatus("skipped"); !errors.Is(err, InvalidStatus) {
+		t.Fatalf("expected: %v; got: %v\n", InvalidStatus, err)
+	}
+}
+
+func TestNewPolicy(t *testing.T) {
+	tests := []struct {
+		name                                         string
+		weeks, numerator, denominator, lates, margin int
+		valid                                        bool
+	}{
+		{"a third", 15, 1, 3, 3, 1, true},
+		{"lateness ignored", 15, 1, 3, 0, 0, true},
+		{"no weeks", 0, 1, 3, 3, 1, false},
+		{"over 1", 15, 4, 3, 3, 1, false},
+		{"zero fraction", 15, 0, 3, 3, 1, false},
+		{"negative margin", 15, 1, 3, 3, -1, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewPolicy(test.weeks, test.numerator, test.denominator, test.lates, test.margin)
+			if (err == nil) != test.valid {
+				t.Fatalf("expected: %v; got: %v\n", test.valid, err)
+			}
+		})
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	p := DefaultPolicy()
+
+	if limit := p.Limit(15); limit != 5 {
+		t.Fatalf("expected: %v; got: %v\n", 5, limit)
+	}
+	if limit := p.Limit(16); limit != 6 {
+		t.Fatalf("expected: %v; got: %v\n", 6, limit)
+	}
+	if absences := p.Absences(2, 7); absences != 4 {
+		t.Fatalf("expected: %v; got: %v\n", 4, absences)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	a := timetables.NewClass("A", "101", "").WithSubject(1)
+	b := timetables.NoRoom("B", "")
+	day := timetables.NewTimetable(a, b, timetables.NoClass(), timetables.NoClass(), timetables.NoClass())
+	empty := timetables.NewTimetable(
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	ts := timetables.NewTimetables(day, empty, empty, empty, empty)
+	start := time.Date(2020, time.April, 6, 0, 0, 0, 0, time.UTC)
+
+	record := func(date string, period int, s Status, c timetables.Class) Record {
+		r, _ := NewRecord(date, period, s)
+		return r.Of(c)
+	}
+	renamed := timetables.NewClass("Old A", "101", "").WithSubject(1)
+	dropped := timetables.NewClass("C", "", "")
+
+	records := []Record{
+		record("2020-04-06", 1, Absent, renamed),
+		record("2020-04-13", 1, Absent, a),
+		record("2020-04-20", 1, Absent, a),
+		record("2020-04-27", 1, Late, a),
+		record("2020-04-06", 2, Present, b),
+		record("2020-04-13", 2, Cancelled, b),
+		record("2020-04-08", 3, Absent, dropped),
+	}
+
+	tallies := Summarize(ts, start, nil, records, DefaultPolicy())
+	if len(tallies) != 3 {
+		t.Fatalf("expected: %v; got: %v\n", 3, len(tallies))
+	}
+
+	tests := []struct {
+		name      string
+		tally     Tally
+		subject   string
+		absences  int
+		remaining int
+		standing  Standing
+	}{
+		{"renamed subject", tallies[0], "A", 3, 2, Safe},
+		{"subject by name", tallies[1], "B", 0, 5, Safe},
+		{"subject out of timetables", tallies[2], "C", 1, 0, Safe},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.tally.Subject() != test.subject {
+				t.Fatalf("expected: %v; got: %v\n", test.subject, test.tally.Subject())
+			}
+			if test.tally.Absences() != test.absences {
+				t.Fatalf("expected: %v; got: %v\n", test.absences, test.tally.Absences())
+			}
+			if remaining, _ := test.tally.Remaining(); remaining != test.remaining {
+				t.Fatalf("expected: %v; got: %v\n", test.remaining, remaining)
+			}
+			if test.tally.Standing() != test.standing {
+				t.Fatalf("expected: %v; got: %v\n", test.standing, test.tally.Standing())
+			}
+		})
+	}
+
+	if rate, _ := tallies[0].Rate(); rate != 0.25 {
+		t.Fatalf("expected: %v; got: %v\n", 0.25, rate)
+	}
+	if rate, _ := tallies[1].Rate(); rate != 1 {
+		t.Fatalf("expected: %v; got: %v\n", 1, rate)
+	}
+	if _, ok := tallies[2].Limit(); ok {
+		t.Fatalf("expected no limit for a subject out of timetables\n")
+	}
+
+	t.Run("close to the limit", func(t *testing.T) {
+		more := append(records, record("2020-05-11", 1, Absent, a))
+		if s := Summarize(ts, start, nil, more, DefaultPolicy())[0].Standing(); s != Warning {
+			t.Fatalf("expected: %v; got: %v\n", Warning, s)
+		}
+
+		more = append(more, record("2020-05-18", 1, Absent, a))
+		if s := Summarize(ts, start, nil, more, DefaultPolicy())[0].Standing(); s != Exceeded {
+			t.Fatalf("expected: %v; got: %v\n", Exceeded, s)
+		}
+	})
+}
+
+func TestSummarizeRules(t *testing.T) {
+	date := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	dates, _ := timetables.Only([]time.Time{date("2020-04-07"), date("2020-04-21"), date("2021-01-12")})
+
+	lab := timetables.NewClass("Lab", "201", "").WithSubject(1).WithRule(timetables.Odd())
+	lecture := timetables.NewClass("Lecture", "101", "").WithSubject(2)
+	seminar := timetables.NewClass("Seminar", "301", "").WithSubject(3).WithRule(dates)
+	fallback := timetables.NewClass("Lecture", "101", "").WithSubject(2)
+
+	empty := timetables.NewTimetable(
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	mon := timetables.NewTimetableOfSlots(
+		timetables.NewSlot(lab, lecture),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+	)
+	tue := timetables.NewTimetableOfSlots(
+		timetables.NewSlot(seminar, fallback),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+	)
+	ts := timetables.NewTimetables(mon, tue, empty, empty, empty)
+	start := date("2020-04-06")
+
+	tallies := Summarize(ts, start, nil, nil, DefaultPolicy())
+	if len(tallies) != 3 {
+		t.Fatalf("expected: %v; got: %v\n", 3, len(tallies))
+	}
+
+	tests := []struct {
+		name     string
+		tally    Tally
+		subject  string
+		sessions int
+	}{
+		{"odd weeks", tallies[0], "Lab", 8},
+		{"even weeks and days without the dates", tallies[1], "Lecture", 7 + 13},
+		{"dates in the term", tallies[2], "Seminar", 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.tally.Subject() != test.subject {
+				t.Fatalf("expected: %v; got: %v\n", test.subject, test.tally.Subject())
+			}
+			if test.tally.Sessions() != test.sessions {
+				t.Fatalf("expected: %v; got: %v\n", test.sessions, test.tally.Sessions())
+			}
+		})
+	}
+}
+
+func TestSummarizeHeld(t *testing.T) {
+	a := timetables.NewClass("A", "101", "").WithSubject(1)
+	b := timetables.NewClass("B", "201", "").WithSubject(2)
+	mon := timetables.NewTimetable(a, timetables.NoClass(), timetables.NoClass(), timetables.NoClass(), timetables.NoClass())
+	wed := timetables.NewTimetable(b, timetables.NoClass(), timetables.NoClass(), timetables.NoClass(), timetables.NoClass())
+	empty := timetables.EmptyTimetable()
+	ts := timetables.NewTimetables(mon, empty, wed, empty, empty)
+	// The term starts on Wednesday 2020-04-08, after the class of A in its first week.
+	start := time.Date(2020, time.April, 8, 0, 0, 0, 0, time.UTC)
+	policy, _ := NewPolicy(2, 1, 3, 0, 0)
+
+	cancelledB, _ := exception.NewCancellation(1, "2020-04-15", 1)
+	record := func(date string, s Status, c timetables.Class) Record {
+		r, _ := NewRecord(date, 1, s)
+		return r.Of(c)
+	}
+
+	tests := []struct {
+		name       string
+		exceptions []exception.Exception
+		records    []Record
+		a, b       int
+	}{
+		{"days from the start", nil, nil, 1, 2},
+		{"cancelled by an exception", []exception.Exception{cancelledB}, nil, 1, 1},
+		{"recorded as cancelled", nil, []Record{record("2020-04-13", Cancelled, a)}, 0, 2},
+		{"cancelled before the start", nil, []Record{record("2020-04-06", Cancelled, a)}, 1, 2},
+		{"cancelled both ways", []exception.Exception{cancelledB}, []Record{record("2020-04-15", Cancelled, b)}, 1, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tallies := Summarize(ts, start, test.exceptions, test.records, policy)
+			if tallies[0].Sessions() != test.a || tallies[1].Sessions() != test.b {
+				t.Fatalf("expected: %v %v; got: %v %v\n", test.a, test.b, tallies[0].Sessions(), tallies[1].Sessions())
+			}
+		})
+	}
+}
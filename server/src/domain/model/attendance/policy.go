@@ -0,0 +1,69 @@
+package attendance
+
+import (
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+var InvalidPolicy = errs.Invalid("invalid_policy", "invalid attendance policy")
+
+// Policy is the rule of a university on absences. A subject is failed once
+// the absences reach the limit, a fraction of its sessions in a term.
+type Policy struct {
+	weeks       int
+	numerator   int
+	denominator int
+	lates       int
+	margin      int
+}
+
+// NewPolicy makes a policy failing subjects absent from numerator/denominator of
+// their sessions, which are held every week for the weeks of a term. Every lates
+// lateness count as an absence unless lates is 0. Subjects with margin or fewer
+// absences left before the limit are warned.
+func NewPolicy(weeks, numerator, denominator, lates, margin int) (Policy, error) {
+	if weeks < 1 || numerator < 1 || denominator < numerator || lates < 0 || margin < 0 {
+		return Policy{}, InvalidPolicy
+	}
+
+	return Policy{weeks, numerator, denominator, lates, margin}, nil
+}
+
+// DefaultPolicy is the common rule of Japanese universities: subjects are failed
+// after missing a third of 15 weeks, and three lateness count as an absence.
+func DefaultPolicy() Policy {
+	return Policy{15, 1, 3, 3, 1}
+}
+
+func (p Policy) Weeks() int {
+	return p.weeks
+}
+
+func (p Policy) Numerator() int {
+	return p.numerator
+}
+
+func (p Policy) Denominator() int {
+	return p.denominator
+}
+
+func (p Policy) Lates() int {
+	return p.lates
+}
+
+func (p Policy) Margin() int {
+	return p.margin
+}
+
+// Limit returns the number of absences failing a subject of the sessions.
+func (p Policy) Limit(sessions int) int {
+	return (sessions*p.numerator + p.denominator - 1) / p.denominator
+}
+
+// Absences returns the absences counting lateness in.
+func (p Policy) Absences(absent, late int) int {
+	if p.lates == 0 {
+		return absent
+	}
+
+	return absent + late/p.lates
+}
@@ -0,0 +1,203 @@
+package attendance
+
+import (
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+type Standing int
+
+const (
+	Safe Standing = iota
+	Warning
+	Exceeded
+)
+
+var standingNames = map[Standing]string{
+	Safe:     "safe",
+	Warning:  "warning",
+	Exceeded: "exceeded",
+}
+
+func (s Standing) String() string {
+	return standingNames[s]
+}
+
+// Tally is the attendance of a subject in a term.
+type Tally struct {
+	subjectID int
+	subject   string
+	sessions  int
+	counts    map[Status]int
+	absences  int
+	limit     int
+	standing  Standing
+}
+
+func (t Tally) SubjectID() int {
+	return t.subjectID
+}
+
+func (t Tally) Subject() string {
+	return t.subject
+}
+
+// Sessions returns the sessions of the subject held in the term. It is 0 for
+// subjects no longer in the timetables, whose limits are unknown.
+func (t Tally) Sessions() int {
+	return t.sessions
+}
+
+func (t Tally) Count(s Status) int {
+	return t.counts[s]
+}
+
+// Rate returns the ratio of sessions attended, late or not, to the recorded
+// sessions which were not cancelled. It returns false if there is none.
+func (t Tally) Rate() (float64, bool) {
+	attended := t.counts[Present] + t.counts[Late]
+	held := attended + t.counts[Absent]
+	if held == 0 {
+		return 0, false
+	}
+
+	return float64(attended) / float64(held), true
+}
+
+// Absences returns the absences counting lateness in by the policy.
+func (t Tally) Absences() int {
+	return t.absences
+}
+
+// Limit returns the absences failing the subject. It returns false if the sessions are unknown.
+func (t Tally) Limit() (int, bool) {
+	return t.limit, t.sessions > 0
+}
+
+// Remaining returns the absences left before the limit.
+func (t Tally) Remaining() (int, bool) {
+	if t.sessions == 0 {
+		return 0, false
+	}
+	if t.absences > t.limit {
+		return 0, true
+	}
+
+	return t.limit - t.absences, true
+}
+
+func (t Tally) Standing() Standing {
+	return t.standing
+}
+
+type key struct {
+	id   int
+	name string
+}
+
+func keyOf(id int, name string) key {
+	if id > 0 {
+		return key{id: id}
+	}
+	return key{name: name}
+}
+
+// session is a period on a date.
+type session struct {
+	date   time.Time
+	period int
+}
+
+// Summarize tallies the records by subject. The sessions of a subject are the classes
+// of it taking place in the weeks of the policy from the week of start, the first day
+// of the term, but not before it. The timetables of each day resolve the rules of the
+// classes and the exceptions change them. Sessions recorded as cancelled are not counted either. Subjects appear
+// in the order of the timetables, followed by those found only in the records.
+func Summarize(ts timetables.Timetables, start time.Time, es []exception.Exception, records []Record, p Policy) []Tally {
+	tallies := make([]*Tally, 0)
+	index := map[key]*Tally{}
+	tally := func(id int, name string) *Tally {
+		k := keyOf(id, name)
+		if t, ok := index[k]; ok {
+			return t
+		}
+		t := &Tally{subjectID: id, subject: name, counts: map[Status]int{}}
+		index[k] = t
+		tallies = append(tallies, t)
+		return t
+	}
+
+	for w := time.Monday; w <= time.Friday; w++ {
+		day, _ := ts.Day(w)
+		for _, s := range day.Slots() {
+			for _, c := range s.Classes() {
+				tally(c.SubjectID(), c.Subject())
+			}
+		}
+	}
+
+	resolve := func(d time.Time) timetables.Timetable {
+		day, ok := ts.Resolve(start, d).Day(d.Weekday())
+		if !ok {
+			return timetables.EmptyTimetable()
+		}
+		return day
+	}
+	origin := func(d time.Time, n int) timetables.Class {
+		s, ok := resolve(d).Period(n)
+		if !ok || s.IsNoClass() {
+			return timetables.NoClass()
+		}
+		return s.Classes()[0]
+	}
+
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	monday := timetables.Monday(start)
+	held := map[session]*Tally{}
+	for i := 0; i < 7*p.weeks; i++ {
+		d := monday.AddDate(0, 0, i)
+		if d.Before(first) {
+			continue
+		}
+		day := exception.Apply(resolve(d), d, es, origin)
+		for n, s := range day.Slots() {
+			for _, c := range s.Classes() {
+				t := tally(c.SubjectID(), c.Subject())
+				t.sessions++
+				held[session{d, n + 1}] = t
+			}
+		}
+	}
+
+	for _, r := range records {
+		t := tally(r.subjectID, r.subject)
+		t.counts[r.status]++
+
+		k := session{r.date, r.period}
+		if r.status == Cancelled && held[k] == t {
+			t.sessions--
+			delete(held, k)
+		}
+	}
+
+	result := make([]Tally, 0, len(tallies))
+	for _, t := range tallies {
+		t.absences = p.Absences(t.counts[Absent], t.counts[Late])
+		t.limit = p.Limit(t.sessions)
+		switch {
+		case t.sessions == 0:
+			t.standing = Safe
+		case t.absences >= t.limit:
+			t.standing = Exceeded
+		case t.limit-t.absences <= p.margin:
+			t.standing = Warning
+		default:
+			t.standing = Safe
+		}
+		result = append(result, *t)
+	}
+
+	return result
+}
@@ -0,0 +1,201 @@
+package bell
+
+import (
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+const (
+	Layout = "15:04"
+
+	DefaultTimeZone = "Asia/Tokyo"
+)
+
+var (
+	InvalidTimeFormat = errs.Invalid("invalid_time_format", "invalid time format")
+	InvalidTimeZone   = errs.Invalid("invalid_time_zone", "invalid time zone")
+	InvalidPeriod     = errs.Invalid("invalid_bell_period", "period ends before it starts")
+	InvalidPeriods    = errs.Invalid("invalid_periods", "bell schedule must have a time for every period")
+	PeriodsOverlap    = errs.Invalid("periods_overlap", "periods overlap")
+)
+
+// Period is the time of day a period starts and ends.
+type Period struct {
+	start time.Duration
+	end   time.Duration
+}
+
+func NewPeriod(start, end string) (Period, error) {
+	s, err := parseTime(start)
+	if err != nil {
+		return Period{}, err
+	}
+	e, err := parseTime(end)
+	if err != nil {
+		return Period{}, err
+	}
+	if e <= s {
+		return Period{}, InvalidPeriod
+	}
+
+	return Period{s, e}, nil
+}
+
+func parseTime(s string) (time.Duration, error) {
+	t, err := time.Parse(Layout, s)
+	if err != nil {
+		return 0, InvalidTimeFormat
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Start returns the time elapsed from midnight to the start of the period.
+func (p Period) Start() time.Duration {
+	return p.start
+}
+
+// End returns the time elapsed from midnight to the end of the period.
+func (p Period) End() time.Duration {
+	return p.end
+}
+
+func (p Period) TextStart() string {
+	return format(p.start)
+}
+
+func (p Period) TextEnd() string {
+	return format(p.end)
+}
+
+func format(d time.Duration) string {
+	return time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(d).Format(Layout)
+}
+
+// Schedule is the bell schedule of a user: the times of the periods of a day
+// and the time zone they are in.
+type Schedule struct {
+	location *time.Location
+	periods  []Period
+}
+
+func NewSchedule(timeZone string, periods []Period) (Schedule, error) {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil || timeZone == "" {
+		return Schedule{}, InvalidTimeZone
+	}
+
+	if len(periods) != timetables.Periods {
+		return Schedule{}, InvalidPeriods
+	}
+	for i := 1; i < len(periods); i++ {
+		if periods[i].start < periods[i-1].end {
+			return Schedule{}, PeriodsOverlap
+		}
+	}
+
+	ps := make([]Period, len(periods))
+	copy(ps, periods)
+
+	return Schedule{loc, ps}, nil
+}
+
+// Default is the bell schedule of users who have not registered their own,
+// which follows the common 90-minute periods of Japanese universities.
+func Default() Schedule {
+	loc, err := time.LoadLocation(DefaultTimeZone)
+	if err != nil {
+		loc = time.FixedZone(DefaultTimeZone, 9*60*60)
+	}
+
+	return Schedule{loc, []Period{
+		{9 * time.Hour, 10*time.Hour + 30*time.Minute},
+		{10*time.Hour + 40*time.Minute, 12*time.Hour + 10*time.Minute},
+		{13 * time.Hour, 14*time.Hour + 30*time.Minute},
+		{14*time.Hour + 40*time.Minute, 16*time.Hour + 10*time.Minute},
+		{16*time.Hour + 20*time.Minute, 17*time.Hour + 50*time.Minute},
+	}}
+}
+
+func (s Schedule) Location() *time.Location {
+	return s.location
+}
+
+func (s Schedule) TimeZone() string {
+	return s.location.String()
+}
+
+func (s Schedule) Periods() []Period {
+	return s.periods
+}
+
+// Period returns the time of the nth period, counted from 1.
+func (s Schedule) Period(n int) (Period, bool) {
+	if n < 1 || n > len(s.periods) {
+		return Period{}, false
+	}
+
+	return s.periods[n-1], true
+}
+
+// Start returns the moment the nth period starts on the day of d.
+func (s Schedule) Start(d time.Time, n int) time.Time {
+	p, _ := s.Period(n)
+	return s.at(d, p.start)
+}
+
+// End returns the moment the nth period ends on the day of d.
+func (s Schedule) End(d time.Time, n int) time.Time {
+	p, _ := s.Period(n)
+	return s.at(d, p.end)
+}
+
+func (s Schedule) at(d time.Time, offset time.Duration) time.Time {
+	return time.Date(
+		d.Year(), d.Month(), d.Day(),
+		int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0,
+		s.location,
+	)
+}
+
+// PeriodOf returns the period which overlaps most with the time from start
+// to end on the day of start. If end is not after start, it returns the
+// period containing start.
+func (s Schedule) PeriodOf(start, end time.Time) (int, bool) {
+	start, end = start.In(s.location), end.In(s.location)
+	midnight := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, s.location)
+	from, to := start.Sub(midnight), end.Sub(midnight)
+
+	best, longest := 0, time.Duration(0)
+	for i, p := range s.periods {
+		if to <= from {
+			if p.start <= from && from < p.end {
+				return i + 1, true
+			}
+			continue
+		}
+
+		overlap := min(to, p.end) - max(from, p.start)
+		if overlap > longest {
+			best, longest = i+1, overlap
+		}
+	}
+
+	return best, best > 0
+}
+
+func min(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
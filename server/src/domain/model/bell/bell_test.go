@@ -0,0 +1,170 @@
+package bell
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewPeriod(t *testing.T) {
+	tests := []struct {
+		name       string
+		start      string
+		end        string
+		shouldFail bool
+	}{
+		{"valid", "09:00", "10:30", false},
+		{"invalid start", "9am", "10:30", true},
+		{"invalid end", "09:00", "25:00", true},
+		{"reversed", "10:30", "09:00", true},
+		{"empty", "09:00", "09:00", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, e := NewPeriod(test.start, test.end)
+
+			if !test.shouldFail && e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			} else if test.shouldFail && e == nil {
+				t.Fatalf("expected error but got nil")
+			}
+		})
+	}
+}
+
+func TestPeriodGetters(t *testing.T) {
+	p, _ := NewPeriod("08:50", "10:20")
+	tests := []struct {
+		expected interface{}
+		got      interface{}
+	}{
+		{8*time.Hour + 50*time.Minute, p.Start()},
+		{10*time.Hour + 20*time.Minute, p.End()},
+		{"08:50", p.TextStart()},
+		{"10:20", p.TextEnd()},
+	}
+
+	for _, test := range tests {
+		if test.expected != test.got {
+			t.Fatalf(
+				"expected: %v; got: %v\n",
+				test.expected,
+				test.got,
+			)
+		}
+	}
+}
+
+func periods(times ...string) []Period {
+	ps := make([]Period, 0)
+	for i := 0; i+1 < len(times); i += 2 {
+		p, _ := NewPeriod(times[i], times[i+1])
+		ps = append(ps, p)
+	}
+	return ps
+}
+
+func TestNewSchedule(t *testing.T) {
+	five := periods(
+		"09:00", "10:30",
+		"10:40", "12:10",
+		"13:00", "14:30",
+		"14:40", "16:10",
+		"16:20", "17:50",
+	)
+	overlapping := periods(
+		"09:00", "10:30",
+		"10:00", "12:10",
+		"13:00", "14:30",
+		"14:40", "16:10",
+		"16:20", "17:50",
+	)
+
+	tests := []struct {
+		name       string
+		timeZone   string
+		periods    []Period
+		shouldFail bool
+	}{
+		{"valid", "Asia/Tokyo", five, false},
+		{"UTC", "UTC", five, false},
+		{"empty time zone", "", five, true},
+		{"unknown time zone", "Asia/Kiwi", five, true},
+		{"too few periods", "Asia/Tokyo", five[:4], true},
+		{"overlapping periods", "Asia/Tokyo", overlapping, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, e := NewSchedule(test.timeZone, test.periods)
+
+			if !test.shouldFail && e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			} else if test.shouldFail && e == nil {
+				t.Fatalf("expected error but got nil")
+			}
+		})
+	}
+}
+
+func TestScheduleTimes(t *testing.T) {
+	s := Default()
+	d := time.Date(2020, 4, 6, 0, 0, 0, 0, time.UTC)
+
+	if s.TimeZone() != DefaultTimeZone {
+		t.Fatalf("expected: %v; got: %v\n", DefaultTimeZone, s.TimeZone())
+	}
+
+	tests := []struct {
+		name     string
+		expected time.Time
+		got      time.Time
+	}{
+		{"start of 1st", time.Date(2020, 4, 6, 0, 0, 0, 0, time.UTC), s.Start(d, 1)},
+		{"end of 1st", time.Date(2020, 4, 6, 1, 30, 0, 0, time.UTC), s.End(d, 1)},
+		{"start of 5th", time.Date(2020, 4, 6, 7, 20, 0, 0, time.UTC), s.Start(d, 5)},
+		{"end of 5th", time.Date(2020, 4, 6, 8, 50, 0, 0, time.UTC), s.End(d, 5)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !test.expected.Equal(test.got) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, test.got)
+			}
+		})
+	}
+
+	if _, ok := s.Period(6); ok {
+		t.Fatalf("6th period should not exist")
+	}
+}
+
+func TestPeriodOf(t *testing.T) {
+	s := Default()
+	jst := s.Location()
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected int
+		found    bool
+	}{
+		{"exact", time.Date(2020, 4, 6, 9, 0, 0, 0, jst), time.Date(2020, 4, 6, 10, 30, 0, 0, jst), 1, true},
+		{"shifted", time.Date(2020, 4, 6, 10, 45, 0, 0, jst), time.Date(2020, 4, 6, 12, 15, 0, 0, jst), 2, true},
+		{"overlaps most", time.Date(2020, 4, 6, 14, 0, 0, 0, jst), time.Date(2020, 4, 6, 16, 0, 0, 0, jst), 4, true},
+		{"in UTC", time.Date(2020, 4, 6, 4, 0, 0, 0, time.UTC), time.Date(2020, 4, 6, 5, 30, 0, 0, time.UTC), 3, true},
+		{"point", time.Date(2020, 4, 6, 17, 0, 0, 0, jst), time.Date(2020, 4, 6, 17, 0, 0, 0, jst), 5, true},
+		{"break", time.Date(2020, 4, 6, 12, 15, 0, 0, jst), time.Date(2020, 4, 6, 12, 55, 0, 0, jst), 0, false},
+		{"night", time.Date(2020, 4, 6, 19, 0, 0, 0, jst), time.Date(2020, 4, 6, 20, 0, 0, 0, jst), 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, found := s.PeriodOf(test.start, test.end)
+			if got != test.expected || found != test.found {
+				t.Fatalf("expected: %v %v; got: %v %v\n", test.expected, test.found, got, found)
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+const (
+	// Domain is the right-hand side of the UIDs of events.
+	Domain = "kiwi-basket"
+)
+
+// Span is the range of dates a set of timetables is held in, usually a term.
+// Weeks of the span are counted from the week containing its start.
+type Span struct {
+	key        string
+	start      time.Time
+	end        time.Time
+	timetables timetables.Timetables
+}
+
+// NewSpan builds a span identified by key, which must be unique and stable
+// among the spans of a user since it is part of the UIDs of events.
+func NewSpan(key string, start, end time.Time, ts timetables.Timetables) Span {
+	return Span{key, truncate(start), truncate(end), ts}
+}
+
+func (s Span) Key() string {
+	return s.key
+}
+
+func (s Span) Start() time.Time {
+	return s.start
+}
+
+func (s Span) End() time.Time {
+	return s.end
+}
+
+func (s Span) Timetables() timetables.Timetables {
+	return s.timetables
+}
+
+func (s Span) contains(d time.Time) bool {
+	return !d.Before(s.start) && !d.After(s.end)
+}
+
+// Calendar is everything of a user to be exported to calendar applications.
+type Calendar struct {
+	owner      string
+	schedule   bell.Schedule
+	spans      []Span
+	exceptions []exception.Exception
+	tasks      []task.Task
+	exams      []exam.Exam
+}
+
+func NewCalendar(
+	owner string,
+	schedule bell.Schedule,
+	spans []Span,
+	exceptions []exception.Exception,
+	tasks []task.Task,
+	exams []exam.Exam,
+) Calendar {
+	return Calendar{owner, schedule, spans, exceptions, tasks, exams}
+}
+
+func (c Calendar) Owner() string {
+	return c.owner
+}
+
+func (c Calendar) Schedule() bell.Schedule {
+	return c.schedule
+}
+
+func (c Calendar) Spans() []Span {
+	return c.spans
+}
+
+func (c Calendar) Exceptions() []exception.Exception {
+	return c.exceptions
+}
+
+func (c Calendar) Tasks() []task.Task {
+	return c.tasks
+}
+
+func (c Calendar) Exams() []exam.Exam {
+	return c.exams
+}
+
+// TaskUID returns the UID of the event or to-do of a task.
+func (c Calendar) TaskUID(t task.Task) string {
+	return c.uid(fmt.Sprintf("task-%d", t.ID()))
+}
+
+func (c Calendar) uid(name string) string {
+	return fmt.Sprintf("%s.%s@%s", name, c.owner, Domain)
+}
+
+// Event is a class taking place once, or weekly from its start until its last occurrence.
+// An exam is an event taking place once.
+type Event struct {
+	uid          string
+	class        timetables.Class
+	exam         bool
+	start        time.Time
+	end          time.Time
+	interval     int
+	until        time.Time
+	dates        []time.Time
+	excluded     []time.Time
+	recurrenceID time.Time
+}
+
+func (e Event) UID() string {
+	return e.uid
+}
+
+func (e Event) Class() timetables.Class {
+	return e.class
+}
+
+// IsExam reports whether the event is an exam, whose class is named after
+// the subject and the title of the exam.
+func (e Event) IsExam() bool {
+	return e.exam
+}
+
+// Start returns the start of the first occurrence.
+func (e Event) Start() time.Time {
+	return e.start
+}
+
+// End returns the end of the first occurrence.
+func (e Event) End() time.Time {
+	return e.end
+}
+
+// Interval returns the number of weeks between occurrences, or 0 if the event does not repeat weekly.
+func (e Event) Interval() int {
+	return e.interval
+}
+
+// Until returns the start of the last weekly occurrence.
+func (e Event) Until() time.Time {
+	return e.until
+}
+
+// Dates returns the starts of the occurrences other than the first
+// of an event which takes place on specific dates.
+func (e Event) Dates() []time.Time {
+	return e.dates
+}
+
+// Excluded returns the starts of the weekly occurrences which do not take place.
+func (e Event) Excluded() []time.Time {
+	return e.excluded
+}
+
+// RecurrenceID returns the start of the occurrence this event overrides.
+// It is zero unless the event overrides an occurrence of another one.
+func (e Event) RecurrenceID() time.Time {
+	return e.recurrenceID
+}
+
+// Events expands the timetables into events of classes.
+// Every class of a slot becomes a recurring event, from which the occurrences
+// taken by another class, another span or exceptions are excluded.
+// Exceptions which change the room become overrides of the occurrences,
+// and those which bring in a class become single events.
+// Exams become single events too, taking the place of the classes they overlap.
+func (c Calendar) Events() []Event {
+	events := make([]Event, 0)
+
+	for i, span := range c.spans {
+		mon := timetables.Monday(span.start)
+		for day := 0; day < 5; day++ {
+			weekday := time.Weekday(day + 1)
+			first := mon.AddDate(0, 0, day)
+			if first.Before(span.start) {
+				first = first.AddDate(0, 0, 7)
+			}
+
+			t, _ := span.timetables.Day(weekday)
+			for n := 1; n <= timetables.Periods; n++ {
+				s, _ := t.Period(n)
+				for k := range s.Classes() {
+					uid := c.uid(fmt.Sprintf(
+						"%s-%s-%d-%d", span.key, strings.ToLower(weekday.String()[:3]), n, k,
+					))
+					events = append(events, c.recurring(i, first, n, s, k, uid)...)
+				}
+			}
+		}
+	}
+
+	for _, e := range c.exceptions {
+		var class timetables.Class
+		switch e.Kind() {
+		case exception.MadeUp:
+			class = e.Class()
+		case exception.Moved:
+			class = c.classAt(e.Date(), e.Period())
+		default:
+			continue
+		}
+		if class.IsNoClass() {
+			continue
+		}
+
+		date, period := e.Date(), e.Period()
+		if e.Kind() == exception.Moved {
+			date, period = e.ToDate(), e.ToPeriod()
+		}
+		if c.examined(date, period) {
+			continue
+		}
+
+		events = append(events, Event{
+			uid:   c.uid(fmt.Sprintf("exception-%d", e.ID())),
+			class: class,
+			start: c.schedule.Start(date, period),
+			end:   c.schedule.End(date, period),
+		})
+	}
+
+	for _, e := range c.exams {
+		summary := e.Subject()
+		if e.Title() != "" {
+			summary += " " + e.Title()
+		}
+
+		events = append(events, Event{
+			uid:   c.uid(fmt.Sprintf("exam-%d", e.ID())),
+			class: timetables.NewClass(summary, e.Room(), ""),
+			exam:  true,
+			start: e.Start().In(c.schedule.Location()),
+			end:   e.End().In(c.schedule.Location()),
+		})
+	}
+
+	return events
+}
+
+// recurring builds the event of the kth class of slot s in the nth period,
+// whose first candidate date is first, and the overrides of its occurrences.
+func (c Calendar) recurring(span int, first time.Time, n int, s timetables.Slot, k int, uid string) []Event {
+	class := s.Classes()[k]
+	rule := class.Rule()
+	start := c.spans[span].start
+
+	held := func(d time.Time) bool {
+		return c.active(d) == span &&
+			resolvesTo(s, k, timetables.WeekOf(start, d), d) &&
+			!c.replaced(d, n) &&
+			!c.examined(d, n)
+	}
+
+	candidates := make([]time.Time, 0)
+	for d := first; !d.After(c.spans[span].end); d = d.AddDate(0, 0, 7) {
+		if rule.Applies(timetables.WeekOf(start, d), d) {
+			candidates = append(candidates, d)
+		}
+	}
+
+	occurrences := make([]time.Time, 0)
+	for _, d := range candidates {
+		if held(d) {
+			occurrences = append(occurrences, d)
+		}
+	}
+	if len(occurrences) == 0 {
+		return nil
+	}
+
+	event := Event{
+		uid:   uid,
+		class: class,
+		start: c.schedule.Start(occurrences[0], n),
+		end:   c.schedule.End(occurrences[0], n),
+	}
+
+	if rule.Weeks() == timetables.OnDates {
+		for _, d := range occurrences[1:] {
+			event.dates = append(event.dates, c.schedule.Start(d, n))
+		}
+	} else {
+		event.interval = 1
+		if rule.Weeks() != timetables.EveryWeek {
+			event.interval = 2
+		}
+		last := occurrences[len(occurrences)-1]
+		event.until = c.schedule.Start(last, n)
+		for _, d := range candidates {
+			if d.Before(occurrences[0]) || d.After(last) || held(d) {
+				continue
+			}
+			event.excluded = append(event.excluded, c.schedule.Start(d, n))
+		}
+	}
+
+	events := []Event{event}
+	for _, d := range occurrences {
+		for _, e := range c.exceptions {
+			if e.Kind() != exception.RoomChanged || !e.Date().Equal(d) || e.Period() != n {
+				continue
+			}
+			events = append(events, Event{
+				uid:          uid,
+				class:        timetables.NewClass(class.Subject(), e.Room(), class.Memo()),
+				start:        c.schedule.Start(d, n),
+				end:          c.schedule.End(d, n),
+				recurrenceID: c.schedule.Start(d, n),
+			})
+		}
+	}
+
+	return events
+}
+
+// resolvesTo reports whether the kth class of s is the one held on date d in the given week.
+func resolvesTo(s timetables.Slot, k int, week int, d time.Time) bool {
+	for i, c := range s.Classes() {
+		if c.Rule().Applies(week, d) {
+			return i == k
+		}
+	}
+
+	return false
+}
+
+// active returns the index of the span whose timetables are held on date d,
+// or -1 if there is none. When spans overlap, the one that started last wins.
+func (c Calendar) active(d time.Time) int {
+	active := -1
+	for i, s := range c.spans {
+		if !s.contains(d) {
+			continue
+		}
+		if active < 0 || s.start.After(c.spans[active].start) {
+			active = i
+		}
+	}
+
+	return active
+}
+
+// classAt returns the regular class of the nth period on date d.
+func (c Calendar) classAt(d time.Time, n int) timetables.Class {
+	i := c.active(d)
+	if i < 0 {
+		return timetables.NoClass()
+	}
+
+	t, ok := c.spans[i].timetables.Day(d.Weekday())
+	if !ok {
+		return timetables.NoClass()
+	}
+	s, _ := t.Period(n)
+
+	return s.Resolve(timetables.WeekOf(c.spans[i].start, d), d)
+}
+
+// replaced reports whether the regular class of the nth period on date d
+// gives way to an exception other than a change of room.
+func (c Calendar) replaced(d time.Time, n int) bool {
+	for _, e := range c.exceptions {
+		switch e.Kind() {
+		case exception.Cancelled, exception.MadeUp, exception.Moved:
+			if e.Date().Equal(d) && e.Period() == n {
+				return true
+			}
+		}
+		if e.Kind() == exception.Moved && e.ToDate().Equal(d) && e.ToPeriod() == n &&
+			!c.classAt(e.Date(), e.Period()).IsNoClass() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// examined reports whether an exam takes the place of the nth period on date d.
+func (c Calendar) examined(d time.Time, n int) bool {
+	start, end := c.schedule.Start(d, n), c.schedule.End(d, n)
+	for _, e := range c.exams {
+		if e.Overlaps(start, end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func truncate(d time.Time) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,259 @@
+package calendar
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func schedule() bell.Schedule {
+	ps := make([]bell.Period, 0)
+	for _, times := range [][2]string{
+		{"09:00", "10:30"},
+		{"10:40", "12:10"},
+		{"13:00", "14:30"},
+		{"14:40", "16:10"},
+		{"16:20", "17:50"},
+	} {
+		p, _ := bell.NewPeriod(times[0], times[1])
+		ps = append(ps, p)
+	}
+
+	s, _ := bell.NewSchedule("UTC", ps)
+	return s
+}
+
+// monday builds timetables with the slot s in the 1st period of Monday.
+func monday(s timetables.Slot) timetables.Timetables {
+	empty := timetables.NewTimetableOfSlots(
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+	)
+	mon := empty.WithPeriod(1, s)
+
+	return timetables.NewTimetables(mon, empty, empty, empty, empty)
+}
+
+var (
+	a = timetables.NewClass("A", "101", "")
+	b = timetables.NewClass("B", "202", "")
+)
+
+func at(d time.Time, hour, min int) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), hour, min, 0, 0, time.UTC)
+}
+
+// april is a span of 4 weeks from Wednesday 2020-04-01, whose Mondays are
+// 04-06 (week 2), 04-13 (week 3), 04-20 (week 4) and 04-27 (week 5).
+func april(s timetables.Slot) Span {
+	return NewSpan("term1", date(2020, 4, 1), date(2020, 4, 28), monday(s))
+}
+
+func TestEvents(t *testing.T) {
+	odd := b.WithRule(timetables.Odd())
+	even := a.WithRule(timetables.Even())
+	only, _ := timetables.Only([]time.Time{date(2020, 4, 13), date(2020, 4, 27)})
+
+	tests := []struct {
+		name     string
+		spans    []Span
+		expected []Event
+	}{
+		{
+			"every week",
+			[]Span{april(timetables.NewSlot(a))},
+			[]Event{{
+				uid:      "term1-mon-1-0.user@kiwi-basket",
+				class:    a,
+				start:    at(date(2020, 4, 6), 9, 0),
+				end:      at(date(2020, 4, 6), 10, 30),
+				interval: 1,
+				until:    at(date(2020, 4, 27), 9, 0),
+			}},
+		},
+		{
+			"alternating weeks",
+			[]Span{april(timetables.NewSlot(even, odd))},
+			[]Event{
+				{
+					uid:      "term1-mon-1-0.user@kiwi-basket",
+					class:    even,
+					start:    at(date(2020, 4, 6), 9, 0),
+					end:      at(date(2020, 4, 6), 10, 30),
+					interval: 2,
+					until:    at(date(2020, 4, 20), 9, 0),
+				},
+				{
+					uid:      "term1-mon-1-1.user@kiwi-basket",
+					class:    odd,
+					start:    at(date(2020, 4, 13), 9, 0),
+					end:      at(date(2020, 4, 13), 10, 30),
+					interval: 2,
+					until:    at(date(2020, 4, 27), 9, 0),
+				},
+			},
+		},
+		{
+			"specific dates take precedence",
+			[]Span{april(timetables.NewSlot(b.WithRule(only), a))},
+			[]Event{
+				{
+					uid:   "term1-mon-1-0.user@kiwi-basket",
+					class: b.WithRule(only),
+					start: at(date(2020, 4, 13), 9, 0),
+					end:   at(date(2020, 4, 13), 10, 30),
+					dates: []time.Time{at(date(2020, 4, 27), 9, 0)},
+				},
+				{
+					uid:      "term1-mon-1-1.user@kiwi-basket",
+					class:    a,
+					start:    at(date(2020, 4, 6), 9, 0),
+					end:      at(date(2020, 4, 6), 10, 30),
+					interval: 1,
+					until:    at(date(2020, 4, 20), 9, 0),
+					excluded: []time.Time{at(date(2020, 4, 13), 9, 0)},
+				},
+			},
+		},
+		{
+			"later span wins",
+			[]Span{
+				april(timetables.NewSlot(a)),
+				NewSpan("term2", date(2020, 4, 20), date(2020, 4, 28), monday(timetables.NewSlot(b))),
+			},
+			[]Event{
+				{
+					uid:      "term1-mon-1-0.user@kiwi-basket",
+					class:    a,
+					start:    at(date(2020, 4, 6), 9, 0),
+					end:      at(date(2020, 4, 6), 10, 30),
+					interval: 1,
+					until:    at(date(2020, 4, 13), 9, 0),
+				},
+				{
+					uid:      "term2-mon-1-0.user@kiwi-basket",
+					class:    b,
+					start:    at(date(2020, 4, 20), 9, 0),
+					end:      at(date(2020, 4, 20), 10, 30),
+					interval: 1,
+					until:    at(date(2020, 4, 27), 9, 0),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewCalendar("user", schedule(), test.spans, nil, nil, nil)
+			if got := c.Events(); !reflect.DeepEqual(got, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestEventsWithExceptions(t *testing.T) {
+	cancelled, _ := exception.NewCancellation(1, "2020-04-13", 1)
+	roomChanged, _ := exception.NewRoomChange(2, "2020-04-20", 1, "303")
+	madeUp, _ := exception.NewMakeUp(3, "2020-04-25", 2, b)
+	moved, _ := exception.NewMove(4, "2020-04-27", 1, "2020-04-28", 3)
+	exceptions := []exception.Exception{cancelled, roomChanged, madeUp, moved}
+
+	c := NewCalendar("user", schedule(), []Span{april(timetables.NewSlot(a))}, exceptions, nil, nil)
+
+	expected := []Event{
+		{
+			uid:      "term1-mon-1-0.user@kiwi-basket",
+			class:    a,
+			start:    at(date(2020, 4, 6), 9, 0),
+			end:      at(date(2020, 4, 6), 10, 30),
+			interval: 1,
+			until:    at(date(2020, 4, 20), 9, 0),
+			excluded: []time.Time{at(date(2020, 4, 13), 9, 0)},
+		},
+		{
+			uid:          "term1-mon-1-0.user@kiwi-basket",
+			class:        timetables.NewClass("A", "303", ""),
+			start:        at(date(2020, 4, 20), 9, 0),
+			end:          at(date(2020, 4, 20), 10, 30),
+			recurrenceID: at(date(2020, 4, 20), 9, 0),
+		},
+		{
+			uid:   "exception-3.user@kiwi-basket",
+			class: b,
+			start: at(date(2020, 4, 25), 10, 40),
+			end:   at(date(2020, 4, 25), 12, 10),
+		},
+		{
+			uid:   "exception-4.user@kiwi-basket",
+			class: a,
+			start: at(date(2020, 4, 28), 13, 0),
+			end:   at(date(2020, 4, 28), 14, 30),
+		},
+	}
+
+	if got := c.Events(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, got)
+	}
+}
+
+func TestEventsWithExams(t *testing.T) {
+	madeUp, _ := exception.NewMakeUp(1, "2020-04-25", 2, b)
+	midterm, _ := exam.NewExam(1, 1, 0, "Midterm", at(date(2020, 4, 13), 9, 30), at(date(2020, 4, 13), 10, 0), "301")
+	quiz, _ := exam.NewExam(2, 2, 0, "", at(date(2020, 4, 25), 12, 0), at(date(2020, 4, 25), 12, 30), "")
+	exams := []exam.Exam{midterm.WithSubject("A"), quiz.WithSubject("B")}
+
+	c := NewCalendar("user", schedule(), []Span{april(timetables.NewSlot(a))}, []exception.Exception{madeUp}, nil, exams)
+
+	expected := []Event{
+		{
+			uid:      "term1-mon-1-0.user@kiwi-basket",
+			class:    a,
+			start:    at(date(2020, 4, 6), 9, 0),
+			end:      at(date(2020, 4, 6), 10, 30),
+			interval: 1,
+			until:    at(date(2020, 4, 27), 9, 0),
+			excluded: []time.Time{at(date(2020, 4, 13), 9, 0)},
+		},
+		{
+			uid:   "exam-1.user@kiwi-basket",
+			class: timetables.NewClass("A Midterm", "301", ""),
+			exam:  true,
+			start: at(date(2020, 4, 13), 9, 30),
+			end:   at(date(2020, 4, 13), 10, 0),
+		},
+		{
+			uid:   "exam-2.user@kiwi-basket",
+			class: timetables.NewClass("B", "", ""),
+			exam:  true,
+			start: at(date(2020, 4, 25), 12, 0),
+			end:   at(date(2020, 4, 25), 12, 30),
+		},
+	}
+
+	if got := c.Events(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, got)
+	}
+}
+
+func TestTaskUID(t *testing.T) {
+	tk, _ := task.NewTask(7, "2020-04-10", "report")
+	c := NewCalendar("user", schedule(), nil, nil, nil, nil)
+
+	if expected, got := "task-7.user@kiwi-basket", c.TaskUID(tk); expected != got {
+		t.Fatalf("expected: %v; got: %v\n", expected, got)
+	}
+}
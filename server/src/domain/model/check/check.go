@@ -0,0 +1,307 @@
+package check
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/calendar"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+type Kind int
+
+const (
+	TooManySlots Kind = iota
+	MissingRoom
+	ExamOverlap
+	ExceptionOverlap
+	CreditsOverCap
+)
+
+var kindNames = map[Kind]string{
+	TooManySlots:     "too_many_slots",
+	MissingRoom:      "missing_room",
+	ExamOverlap:      "exam_overlap",
+	ExceptionOverlap: "exception_overlap",
+	CreditsOverCap:   "credits_over_cap",
+}
+
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// Warning is something in timetables which is allowed but likely a mistake.
+// Fields which do not apply to its kind are zero.
+type Warning struct {
+	kind        Kind
+	day         time.Weekday
+	period      int
+	date        time.Time
+	subject     string
+	count       int
+	examID      int
+	exceptionID int
+}
+
+func (w Warning) Kind() Kind {
+	return w.kind
+}
+
+// Day returns the weekday of the slot, which is Sunday for warnings of no slot.
+func (w Warning) Day() time.Weekday {
+	return w.day
+}
+
+// Period returns the period of the slot, or 0 for warnings of no slot.
+func (w Warning) Period() int {
+	return w.period
+}
+
+// Date returns the date an exam or an exception overlaps the slot on.
+func (w Warning) Date() time.Time {
+	return w.date
+}
+
+func (w Warning) Subject() string {
+	return w.subject
+}
+
+// Count returns the slots of the subject for TooManySlots,
+// or the total credits for CreditsOverCap.
+func (w Warning) Count() int {
+	return w.count
+}
+
+func (w Warning) ExamID() int {
+	return w.examID
+}
+
+func (w Warning) ExceptionID() int {
+	return w.exceptionID
+}
+
+// Report is the result of checking timetables.
+type Report struct {
+	warnings []Warning
+	credits  int
+	limits   Limits
+}
+
+func (r Report) Warnings() []Warning {
+	return r.warnings
+}
+
+// Credits returns the total credits of the subjects in the timetables.
+func (r Report) Credits() int {
+	return r.credits
+}
+
+func (r Report) Limits() Limits {
+	return r.limits
+}
+
+var weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// Check reviews the timetables of the span against the limits. Classes are
+// linked to the subjects of the catalog by ID, or by name if they have none,
+// and a class without a room takes the default room of its subject.
+// The exams and exceptions on dates out of the span are ignored.
+func Check(
+	span calendar.Span,
+	schedule bell.Schedule,
+	subjects []subject.Subject,
+	exams []exam.Exam,
+	exceptions []exception.Exception,
+	limits Limits,
+) Report {
+	c := checker{span, schedule, subjects, limits}
+	r := Report{warnings: make([]Warning, 0), limits: limits}
+
+	r.warnings = append(r.warnings, c.slots()...)
+	r.warnings = append(r.warnings, c.rooms()...)
+	r.warnings = append(r.warnings, c.exams(exams)...)
+	r.warnings = append(r.warnings, c.exceptions(exceptions)...)
+
+	r.credits = c.credits()
+	if r.credits > limits.credits {
+		r.warnings = append(r.warnings, Warning{kind: CreditsOverCap, count: r.credits})
+	}
+
+	return r
+}
+
+type checker struct {
+	span     calendar.Span
+	schedule bell.Schedule
+	subjects []subject.Subject
+	limits   Limits
+}
+
+// subjectOf finds the subject of the catalog the class is linked to.
+func (c checker) subjectOf(class timetables.Class) (subject.Subject, bool) {
+	for _, s := range c.subjects {
+		if class.SubjectID() > 0 && s.ID() == class.SubjectID() {
+			return s, true
+		}
+	}
+	for _, s := range c.subjects {
+		if class.SubjectID() == 0 && s.Name() == class.Subject() {
+			return s, true
+		}
+	}
+
+	return subject.Subject{}, false
+}
+
+// each calls f with every class of every slot, in order of days and periods.
+func (c checker) each(f func(day time.Weekday, period int, class timetables.Class)) {
+	for _, w := range weekdays {
+		t, _ := c.span.Timetables().Day(w)
+		for i, s := range t.Slots() {
+			for _, class := range s.Classes() {
+				f(w, i+1, class)
+			}
+		}
+	}
+}
+
+// slots warns the subjects held in more slots a week than the limit.
+// A slot of alternating classes counts once for each of its subjects.
+func (c checker) slots() []Warning {
+	type slot struct {
+		day    time.Weekday
+		period int
+	}
+
+	keys := make([]string, 0)
+	names := make(map[string]string)
+	counted := make(map[string]map[slot]bool)
+	c.each(func(day time.Weekday, period int, class timetables.Class) {
+		key, name := "name:"+class.Subject(), class.Subject()
+		if s, ok := c.subjectOf(class); ok {
+			key, name = "id:"+strconv.Itoa(s.ID()), s.Name()
+		}
+		if _, ok := counted[key]; !ok {
+			keys = append(keys, key)
+			names[key] = name
+			counted[key] = make(map[slot]bool)
+		}
+		counted[key][slot{day, period}] = true
+	})
+
+	warnings := make([]Warning, 0)
+	for _, key := range keys {
+		if n := len(counted[key]); n > c.limits.slots {
+			warnings = append(warnings, Warning{kind: TooManySlots, subject: names[key], count: n})
+		}
+	}
+
+	return warnings
+}
+
+// rooms warns the classes held in no room, even by default.
+func (c checker) rooms() []Warning {
+	warnings := make([]Warning, 0)
+	c.each(func(day time.Weekday, period int, class timetables.Class) {
+		if !class.IsNoRoom() && class.Room() != "" {
+			return
+		}
+		if s, ok := c.subjectOf(class); ok && s.Room() != "" {
+			return
+		}
+		warnings = append(warnings, Warning{kind: MissingRoom, day: day, period: period, subject: class.Subject()})
+	})
+
+	return warnings
+}
+
+// exams warns the classes of the periods the exams overlap.
+func (c checker) exams(exams []exam.Exam) []Warning {
+	warnings := make([]Warning, 0)
+	for _, e := range exams {
+		start := e.Start().In(c.schedule.Location())
+		d := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+		for n := 1; n <= timetables.Periods; n++ {
+			if !e.Overlaps(c.schedule.Start(d, n), c.schedule.End(d, n)) {
+				continue
+			}
+			if class, ok := c.classAt(d, n); ok {
+				warnings = append(warnings, Warning{
+					kind:    ExamOverlap,
+					day:     d.Weekday(),
+					period:  n,
+					date:    d,
+					subject: class.Subject(),
+					examID:  e.ID(),
+				})
+			}
+		}
+	}
+
+	return warnings
+}
+
+// exceptions warns the classes of the periods the exceptions change,
+// including those a class is moved to.
+func (c checker) exceptions(exceptions []exception.Exception) []Warning {
+	warnings := make([]Warning, 0)
+	warn := func(e exception.Exception, d time.Time, n int) {
+		if class, ok := c.classAt(d, n); ok {
+			warnings = append(warnings, Warning{
+				kind:        ExceptionOverlap,
+				day:         d.Weekday(),
+				period:      n,
+				date:        d,
+				subject:     class.Subject(),
+				exceptionID: e.ID(),
+			})
+		}
+	}
+
+	for _, e := range exceptions {
+		warn(e, e.Date(), e.Period())
+		if e.Kind() == exception.Moved {
+			warn(e, e.ToDate(), e.ToPeriod())
+		}
+	}
+
+	return warnings
+}
+
+// classAt returns the class of the timetables held in the nth period on date d.
+func (c checker) classAt(d time.Time, n int) (timetables.Class, bool) {
+	if d.Before(c.span.Start()) || d.After(c.span.End()) {
+		return timetables.Class{}, false
+	}
+
+	t, ok := c.span.Timetables().Resolve(c.span.Start(), d).Day(d.Weekday())
+	if !ok {
+		return timetables.Class{}, false
+	}
+	s, _ := t.Period(n)
+	if s.IsNoClass() {
+		return timetables.Class{}, false
+	}
+
+	return s.Classes()[0], true
+}
+
+// credits sums up the credits of the subjects of the catalog in the timetables.
+func (c checker) credits() int {
+	taken := make(map[int]bool)
+	total := 0
+	c.each(func(_ time.Weekday, _ int, class timetables.Class) {
+		s, ok := c.subjectOf(class)
+		if !ok || taken[s.ID()] {
+			return
+		}
+		taken[s.ID()] = true
+		total += s.Credits()
+	})
+
+	return total
+}
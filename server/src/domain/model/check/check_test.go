@@ -0,0 +1,152 @@
+package check
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/calendar"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+func TestNewLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		slots    int
+		credits  int
+		expected error
+	}{
+		{"valid", 2, 24, nil},
+		{"no slots", 0, 24, InvalidLimits},
+		{"no credits", 2, 0, InvalidLimits},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l, err := NewLimits(test.slots, test.credits)
+			if test.expected != nil {
+				if !errors.Is(err, test.expected) {
+					t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+				}
+				return
+			}
+
+			if err != nil || l.Slots() != test.slots || l.Credits() != test.credits {
+				t.Fatalf("expected: %v %v; got: %v %v\n", test.slots, test.credits, l, err)
+			}
+		})
+	}
+}
+
+func date(m time.Month, d int) time.Time {
+	return time.Date(2020, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCheck(t *testing.T) {
+	schedule := bell.Default()
+	algebra, _ := subject.NewSubject(1, "Algebra", "", 2, "", "", "101")
+	english, _ := subject.NewSubject(2, "English", "", 1, "", "", "")
+	physics, _ := subject.NewSubject(3, "Physics", "", 2, "", "", "")
+	subjects := []subject.Subject{algebra, english, physics}
+
+	empty := timetables.NewTimetable(
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	// Algebra is held three times a week in its default room, English by
+	// name in no room, and Physics in 202 besides a lab of no subject.
+	mon := timetables.NewTimetable(
+		timetables.NoRoom("Algebra", "").WithSubject(1),
+		timetables.NoRoom("English", ""),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	wed := timetables.NewTimetable(
+		timetables.NewClass("Algebra", "", "").WithSubject(1),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	).WithPeriod(3, timetables.NewSlot(
+		timetables.NewClass("Physics", "202", "").WithSubject(3).WithRule(timetables.Odd()),
+		timetables.NewClass("Lab", "Lab 1", "").WithRule(timetables.Even()),
+	))
+	fri := timetables.NewTimetable(
+		timetables.NewClass("Algebra", "101", "").WithSubject(1),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	// The spring term starts on Wednesday 2020-04-01, which is in its week 1.
+	span := calendar.NewSpan("term1", date(4, 1), date(7, 31), timetables.NewTimetables(mon, empty, wed, empty, fri))
+
+	jst := schedule.Location()
+	midterm, _ := exam.NewExam(1, 1, 1, "Midterm",
+		time.Date(2020, 4, 20, 10, 0, 0, 0, jst), time.Date(2020, 4, 20, 11, 0, 0, 0, jst), "Hall")
+	// Wednesday 2020-04-22 is in the even week 4, when the lab is held.
+	lab, _ := exam.NewExam(2, 3, 1, "",
+		time.Date(2020, 4, 22, 13, 0, 0, 0, jst), time.Date(2020, 4, 22, 14, 0, 0, 0, jst), "")
+	summer, _ := exam.NewExam(3, 1, 0, "",
+		time.Date(2020, 8, 3, 9, 0, 0, 0, jst), time.Date(2020, 8, 3, 10, 0, 0, 0, jst), "")
+	cancelled, _ := exception.NewCancellation(1, "2020-04-10", 1)
+	nothing, _ := exception.NewCancellation(2, "2020-04-10", 2)
+	moved, _ := exception.NewMove(3, "2020-04-13", 2, "2020-04-17", 1)
+
+	r := Check(
+		span,
+		schedule,
+		subjects,
+		[]exam.Exam{midterm, lab, summer},
+		[]exception.Exception{cancelled, nothing, moved},
+		DefaultLimits(),
+	)
+
+	expected := []Warning{
+		{kind: TooManySlots, subject: "Algebra", count: 3},
+		{kind: MissingRoom, day: time.Monday, period: 2, subject: "English"},
+		{kind: ExamOverlap, day: time.Monday, period: 1, date: date(4, 20), subject: "Algebra", examID: 1},
+		{kind: ExamOverlap, day: time.Monday, period: 2, date: date(4, 20), subject: "English", examID: 1},
+		{kind: ExamOverlap, day: time.Wednesday, period: 3, date: date(4, 22), subject: "Lab", examID: 2},
+		{kind: ExceptionOverlap, day: time.Friday, period: 1, date: date(4, 10), subject: "Algebra", exceptionID: 1},
+		{kind: ExceptionOverlap, day: time.Monday, period: 2, date: date(4, 13), subject: "English", exceptionID: 3},
+		{kind: ExceptionOverlap, day: time.Friday, period: 1, date: date(4, 17), subject: "Algebra", exceptionID: 3},
+	}
+
+	if len(r.Warnings()) != len(expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, r.Warnings())
+	}
+	for i, w := range expected {
+		got := r.Warnings()[i]
+		if got.Kind() != w.kind || got.Day() != w.day || got.Period() != w.period ||
+			!got.Date().Equal(w.date) || got.Subject() != w.subject || got.Count() != w.count ||
+			got.ExamID() != w.examID || got.ExceptionID() != w.exceptionID {
+			t.Fatalf("expected: %v; got: %v\n", w, got)
+		}
+	}
+	if r.Credits() != 5 {
+		t.Fatalf("expected: %v; got: %v\n", 5, r.Credits())
+	}
+
+	t.Run("credits over the cap", func(t *testing.T) {
+		limits, _ := NewLimits(3, 4)
+		r := Check(span, schedule, subjects, nil, nil, limits)
+
+		ws := r.Warnings()
+		last := ws[len(ws)-1]
+		if last.Kind() != CreditsOverCap || last.Count() != 5 {
+			t.Fatalf("expected: %v; got: %v\n", CreditsOverCap, ws)
+		}
+		if ws[0].Kind() == TooManySlots {
+			t.Fatalf("unexpected warning: %v\n", ws[0])
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package check
+
+import (
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+var InvalidLimits = errs.Invalid("invalid_limits", "invalid limits")
+
+// Limits are the bounds timetables are warned beyond: the weekly slots
+// of a subject, and the credits of the subjects taken in a term.
+type Limits struct {
+	slots   int
+	credits int
+}
+
+func NewLimits(slots, credits int) (Limits, error) {
+	if slots < 1 || credits < 1 {
+		return Limits{}, InvalidLimits
+	}
+
+	return Limits{slots, credits}, nil
+}
+
+// DefaultLimits allows a subject two slots a week, and caps the credits at 24,
+// the common cap of a term at Japanese universities.
+func DefaultLimits() Limits {
+	return Limits{2, 24}
+}
+
+func (l Limits) Slots() int {
+	return l.slots
+}
+
+func (l Limits) Credits() int {
+	return l.credits
+}
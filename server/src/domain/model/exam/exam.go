@@ -0,0 +1,164 @@
+package exam
+
+import (
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+const (
+	MaxTitleLength = 85
+	MaxRoomLength  = 85
+)
+
+var (
+	InvalidSubject   = errs.Invalid("invalid_subject", "invalid subject")
+	InvalidTitle     = errs.Invalid("invalid_exam_title", "invalid exam title")
+	InvalidRoom      = errs.Invalid("invalid_room", "invalid room")
+	InvalidTimeRange = errs.Invalid("invalid_time_range", "start time is not before end time")
+)
+
+// Exam is an exam of a subject in the catalog, held at a time in a room.
+// It takes the place of the classes it overlaps.
+type Exam struct {
+	id        int
+	subjectID int
+	subject   string
+	termID    int
+	title     string
+	start     time.Time
+	end       time.Time
+	room      string
+}
+
+// NewExam builds an exam of the subject of subjectID in the term of termID,
+// which is 0 if the exam belongs to no term.
+func NewExam(id, subjectID, termID int, title string, start, end time.Time, room string) (Exam, error) {
+	if subjectID < 1 {
+		return Exam{}, InvalidSubject
+	}
+	if utf8.RuneCountInString(title) > MaxTitleLength {
+		return Exam{}, InvalidTitle
+	}
+	if utf8.RuneCountInString(room) > MaxRoomLength {
+		return Exam{}, InvalidRoom
+	}
+	if !start.Before(end) {
+		return Exam{}, InvalidTimeRange
+	}
+
+	return Exam{id: id, subjectID: subjectID, termID: termID, title: title, start: start, end: end, room: room}, nil
+}
+
+func (e Exam) ID() int {
+	return e.id
+}
+
+func (e Exam) SubjectID() int {
+	return e.subjectID
+}
+
+// Subject returns the name of the subject, which is empty unless given by WithSubject.
+func (e Exam) Subject() string {
+	return e.subject
+}
+
+func (e Exam) TermID() int {
+	return e.termID
+}
+
+func (e Exam) Title() string {
+	return e.title
+}
+
+func (e Exam) Start() time.Time {
+	return e.start
+}
+
+func (e Exam) End() time.Time {
+	return e.end
+}
+
+func (e Exam) Room() string {
+	return e.room
+}
+
+// WithSubject returns the exam with the name of its subject.
+func (e Exam) WithSubject(name string) Exam {
+	e.subject = name
+	return e
+}
+
+// WithTerm returns the exam belonging to the term of id.
+func (e Exam) WithTerm(id int) Exam {
+	e.termID = id
+	return e
+}
+
+// In returns the exam with its times in loc.
+func (e Exam) In(loc *time.Location) Exam {
+	e.start, e.end = e.start.In(loc), e.end.In(loc)
+	return e
+}
+
+// Overlaps reports whether the exam overlaps the time from start to end.
+func (e Exam) Overlaps(start, end time.Time) bool {
+	return e.start.Before(end) && start.Before(e.end)
+}
+
+// Countdown is the time left until an exam starts.
+type Countdown struct {
+	exam    Exam
+	days    int
+	minutes int
+}
+
+func (c Countdown) Exam() Exam {
+	return c.exam
+}
+
+// Days returns the number of days from today until the day of the exam.
+func (c Countdown) Days() int {
+	return c.days
+}
+
+// Minutes returns the minutes until the exam starts, rounded up,
+// or 0 if it has already started.
+func (c Countdown) Minutes() int {
+	return c.minutes
+}
+
+// Upcoming returns the countdowns of the exams which have not ended at now,
+// sooner first. Days are counted in the time zone of now.
+func Upcoming(exams []Exam, now time.Time) []Countdown {
+	upcoming := make([]Exam, 0)
+	for _, e := range exams {
+		if e.end.After(now) {
+			upcoming = append(upcoming, e)
+		}
+	}
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].start.Before(upcoming[j].start)
+	})
+
+	today := date(now, now.Location())
+	countdowns := make([]Countdown, 0)
+	for _, e := range upcoming {
+		c := Countdown{exam: e}
+		if d := e.start.Sub(now); d > 0 {
+			c.minutes = int((d + time.Minute - 1) / time.Minute)
+		}
+		c.days = int(date(e.start, now.Location()).Sub(today).Hours() / 24)
+		countdowns = append(countdowns, c)
+	}
+
+	return countdowns
+}
+
+// date returns the day of t in loc at midnight in UTC, so that days can be subtracted.
+func date(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,117 @@
+package exam
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var jst = time.FixedZone("JST", 9*60*60)
+
+func at(month time.Month, day, hour, min int) time.Time {
+	return time.Date(2020, month, day, hour, min, 0, 0, jst)
+}
+
+func TestNewExam(t *testing.T) {
+	tests := []struct {
+		name      string
+		subjectID int
+		title     string
+		start     time.Time
+		end       time.Time
+		room      string
+		expected  error
+	}{
+		{"valid", 1, "Final", at(7, 20, 9, 0), at(7, 20, 10, 30), "101", nil},
+		{"untitled", 1, "", at(7, 20, 9, 0), at(7, 20, 10, 30), "", nil},
+		{"no subject", 0, "Final", at(7, 20, 9, 0), at(7, 20, 10, 30), "101", InvalidSubject},
+		{"too long title", 1, string(make([]rune, MaxTitleLength+1)), at(7, 20, 9, 0), at(7, 20, 10, 30), "101", InvalidTitle},
+		{"too long room", 1, "Final", at(7, 20, 9, 0), at(7, 20, 10, 30), string(make([]rune, MaxRoomLength+1)), InvalidRoom},
+		{"empty time range", 1, "Final", at(7, 20, 9, 0), at(7, 20, 9, 0), "101", InvalidTimeRange},
+		{"reversed time range", 1, "Final", at(7, 20, 10, 30), at(7, 20, 9, 0), "101", InvalidTimeRange},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e, err := NewExam(1, test.subjectID, 0, test.title, test.start, test.end, test.room)
+			if test.expected != nil {
+				if !errors.Is(err, test.expected) {
+					t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if e.SubjectID() != test.subjectID || e.Title() != test.title || !e.Start().Equal(test.start) {
+				t.Fatalf("expected: %v; got: %v\n", test, e)
+			}
+		})
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	e, _ := NewExam(1, 1, 0, "Final", at(7, 20, 10, 0), at(7, 20, 11, 0), "101")
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected bool
+	}{
+		{"before", at(7, 20, 9, 0), at(7, 20, 10, 0), false},
+		{"overlapping the start", at(7, 20, 9, 0), at(7, 20, 10, 30), true},
+		{"inside", at(7, 20, 10, 15), at(7, 20, 10, 45), true},
+		{"overlapping the end", at(7, 20, 10, 30), at(7, 20, 12, 0), true},
+		{"after", at(7, 20, 11, 0), at(7, 20, 12, 0), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := e.Overlaps(test.start, test.end); got != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestUpcoming(t *testing.T) {
+	past, _ := NewExam(1, 1, 0, "Midterm", at(6, 1, 9, 0), at(6, 1, 10, 0), "101")
+	later, _ := NewExam(2, 2, 0, "Final", at(7, 21, 9, 0), at(7, 21, 10, 0), "201")
+	ongoing, _ := NewExam(3, 3, 0, "Quiz", at(7, 20, 9, 0), at(7, 20, 10, 0), "301")
+	tonight, _ := NewExam(4, 1, 0, "Final", at(7, 20, 23, 30), at(7, 21, 0, 30), "101")
+
+	now := at(7, 20, 9, 30)
+	cs := Upcoming([]Exam{past, later, ongoing, tonight}, now)
+
+	expected := []struct {
+		id      int
+		days    int
+		minutes int
+	}{
+		{3, 0, 0},
+		{4, 0, 840},
+		{2, 1, 1410},
+	}
+	if len(cs) != len(expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, cs)
+	}
+	for i, e := range expected {
+		c := cs[i]
+		if c.Exam().ID() != e.id || c.Days() != e.days || c.Minutes() != e.minutes {
+			t.Fatalf("expected: %v; got: %v %v %v\n", e, c.Exam().ID(), c.Days(), c.Minutes())
+		}
+	}
+
+	t.Run("counts days in the time zone of now", func(t *testing.T) {
+		early, _ := NewExam(5, 1, 0, "Final", at(7, 21, 8, 0), at(7, 21, 9, 0), "101")
+
+		if cs := Upcoming([]Exam{early}, now); len(cs) != 1 || cs[0].Days() != 1 {
+			t.Fatalf("expected: %v; got: %v\n", 1, cs)
+		}
+		if cs := Upcoming([]Exam{early}, now.UTC()); len(cs) != 1 || cs[0].Days() != 0 {
+			t.Fatalf("expected: %v; got: %v\n", 0, cs)
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package exception
+
+import (
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+type Kind int
+
+const (
+	Cancelled Kind = iota
+	RoomChanged
+	MadeUp
+	Moved
+)
+
+var kindNames = map[Kind]string{
+	Cancelled:   "cancelled",
+	RoomChanged: "room_changed",
+	MadeUp:      "make_up",
+	Moved:       "moved",
+}
+
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+func ParseKind(s string) (Kind, error) {
+	for k, name := range kindNames {
+		if name == s {
+			return k, nil
+		}
+	}
+
+	return Cancelled, InvalidKind
+}
+
+const (
+	Layout = "2006-01-02"
+)
+
+var (
+	InvalidKind       = errs.Invalid("invalid_kind", "invalid kind")
+	InvalidDateFormat = errs.Invalid("invalid_date_format", "invalid date format")
+	InvalidPeriod     = errs.Invalid("invalid_period", "invalid period")
+	MovedToItself     = errs.Invalid("moved_to_itself", "class is moved to the same period")
+)
+
+// Exception changes a class of a period on a date from the regular timetable.
+type Exception struct {
+	id       int
+	kind     Kind
+	date     time.Time
+	period   int
+	class    timetables.Class
+	toDate   time.Time
+	toPeriod int
+}
+
+func newException(id int, kind Kind, date string, period int) (Exception, error) {
+	d, err := time.Parse(Layout, date)
+	if err != nil {
+		return Exception{}, InvalidDateFormat
+	}
+	if period < 1 || period > timetables.Periods {
+		return Exception{}, InvalidPeriod
+	}
+
+	return Exception{id: id, kind: kind, date: d, period: period, class: timetables.NoClass()}, nil
+}
+
+// NewCancellation cancels the class of the period.
+func NewCancellation(id int, date string, period int) (Exception, error) {
+	return newException(id, Cancelled, date, period)
+}
+
+// NewRoomChange holds the class of the period in another room.
+func NewRoomChange(id int, date string, period int, room string) (Exception, error) {
+	e, err := newException(id, RoomChanged, date, period)
+	if err != nil {
+		return Exception{}, err
+	}
+
+	e.class = timetables.NewClass("", room, "")
+	return e, nil
+}
+
+// NewMakeUp holds an extra class, such as a make-up class, in the period.
+func NewMakeUp(id int, date string, period int, class timetables.Class) (Exception, error) {
+	e, err := newException(id, MadeUp, date, period)
+	if err != nil {
+		return Exception{}, err
+	}
+
+	e.class = class
+	return e, nil
+}
+
+// NewMove moves the class of the period to another date and period.
+func NewMove(id int, date string, period int, toDate string, toPeriod int) (Exception, error) {
+	e, err := newException(id, Moved, date, period)
+	if err != nil {
+		return Exception{}, err
+	}
+
+	to, err := newException(id, Moved, toDate, toPeriod)
+	if err != nil {
+		return Exception{}, err
+	}
+	if e.date.Equal(to.date) && e.period == to.period {
+		return Exception{}, MovedToItself
+	}
+
+	e.toDate = to.date
+	e.toPeriod = to.period
+	return e, nil
+}
+
+func (e Exception) ID() int {
+	return e.id
+}
+
+func (e Exception) Kind() Kind {
+	return e.kind
+}
+
+func (e Exception) Date() time.Time {
+	return e.date
+}
+
+func (e Exception) TextDate() string {
+	return e.date.Format(Layout)
+}
+
+func (e Exception) Period() int {
+	return e.period
+}
+
+// Room is the new room of a room change.
+func (e Exception) Room() string {
+	return e.class.Room()
+}
+
+// Class is the class held by a make-up.
+func (e Exception) Class() timetables.Class {
+	return e.class
+}
+
+func (e Exception) ToDate() time.Time {
+	return e.toDate
+}
+
+func (e Exception) TextToDate() string {
+	return e.toDate.Format(Layout)
+}
+
+func (e Exception) ToPeriod() int {
+	return e.toPeriod
+}
+
+// Apply returns the timetable of date d, which must already be resolved to
+// the classes of that day, with the exceptions applied.
+// origin looks up the class originally held in a period on a date,
+// which is moved in by exceptions of kind Moved.
+func Apply(
+	t timetables.Timetable,
+	d time.Time,
+	es []Exception,
+	origin func(time.Time, int) timetables.Class,
+) timetables.Timetable {
+	day := truncate(d)
+	result := t
+
+	for _, e := range es {
+		if !e.date.Equal(day) {
+			continue
+		}
+
+		switch e.kind {
+		case Cancelled, Moved:
+			result = result.WithPeriod(e.period, timetables.EmptySlot())
+		case RoomChanged:
+			s, _ := t.Period(e.period)
+			if s.IsNoClass() {
+				continue
+			}
+			c := s.Classes()[0]
+			changed := timetables.NewClass(c.Subject(), e.Room(), c.Memo()).WithSubject(c.SubjectID())
+			result = result.WithPeriod(e.period, timetables.NewSlot(changed))
+		case MadeUp:
+			result = result.WithPeriod(e.period, timetables.NewSlot(e.class))
+		}
+	}
+
+	for _, e := range es {
+		if e.kind != Moved || !e.toDate.Equal(day) {
+			continue
+		}
+
+		c := origin(e.date, e.period)
+		if c.IsNoClass() {
+			continue
+		}
+		result = result.WithPeriod(e.toPeriod, timetables.NewSlot(c))
+	}
+
+	return result
+}
+
+func truncate(d time.Time) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,95 @@
+package exception
+
+import (
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+func TestNewException(t *testing.T) {
+	tests := []struct {
+		name       string
+		new        func() (Exception, error)
+		shouldFail bool
+	}{
+		{"cancellation", func() (Exception, error) { return NewCancellation(-1, "2020-04-08", 1) }, false},
+		{"room change", func() (Exception, error) { return NewRoomChange(-1, "2020-04-08", 5, "101") }, false},
+		{"move", func() (Exception, error) { return NewMove(-1, "2020-04-08", 1, "2020-04-09", 1) }, false},
+		{"invalid date", func() (Exception, error) { return NewCancellation(-1, "2020/04/08", 1) }, true},
+		{"period 0", func() (Exception, error) { return NewCancellation(-1, "2020-04-08", 0) }, true},
+		{"period 6", func() (Exception, error) { return NewCancellation(-1, "2020-04-08", 6) }, true},
+		{"invalid destination", func() (Exception, error) { return NewMove(-1, "2020-04-08", 1, "2020-04-09", 6) }, true},
+		{"moved to itself", func() (Exception, error) { return NewMove(-1, "2020-04-08", 1, "2020-04-08", 1) }, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, e := test.new()
+			if !test.shouldFail && e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			} else if test.shouldFail && e == nil {
+				t.Fatalf("expected error but got nil")
+			}
+		})
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	for _, k := range []Kind{Cancelled, RoomChanged, MadeUp, Moved} {
+		v, err := ParseKind(k.String())
+		if err != nil || v != k {
+			t.Fatalf("expected: %v; got: %v (%v)\n", k, v, err)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	wed := time.Date(2020, time.April, 8, 0, 0, 0, 0, time.UTC)
+	thu := wed.AddDate(0, 0, 1)
+	base := timetables.NewTimetable(
+		timetables.NewClass("A", "1", "memo"),
+		timetables.NewClass("B", "2", ""),
+		timetables.NoClass(),
+		timetables.NewClass("D", "4", ""),
+		timetables.NoClass(),
+	)
+	origin := func(d time.Time, n int) timetables.Class {
+		s, _ := base.Period(n)
+		return s.Resolve(1, d)
+	}
+
+	cancel, _ := NewCancellation(1, "2020-04-08", 1)
+	room, _ := NewRoomChange(2, "2020-04-08", 2, "202")
+	makeUp, _ := NewMakeUp(3, "2020-04-08", 3, timetables.NewClass("C", "3", ""))
+	move, _ := NewMove(4, "2020-04-08", 4, "2020-04-09", 5)
+	es := []Exception{cancel, room, makeUp, move}
+
+	t.Run("source date", func(t *testing.T) {
+		got := Apply(base, wed, es, origin)
+
+		if !got.First().IsNoClass() {
+			t.Fatal("first period should be cancelled")
+		}
+		if c := got.Second().Classes()[0]; c.Room() != "202" || c.Subject() != "B" {
+			t.Fatalf("expected: %v; got: %v\n", "B in 202", c)
+		}
+		if c := got.Third().Classes()[0]; c.Subject() != "C" {
+			t.Fatalf("expected: %v; got: %v\n", "C", c.Subject())
+		}
+		if !got.Fourth().IsNoClass() {
+			t.Fatal("fourth period should be moved out")
+		}
+	})
+
+	t.Run("destination date", func(t *testing.T) {
+		got := Apply(base, thu, es, origin)
+
+		if got.Fifth().IsNoClass() || got.Fifth().Classes()[0].Subject() != "D" {
+			t.Fatalf("expected: %v; got: %v\n", "D", got.Fifth())
+		}
+		if got.First().IsNoClass() {
+			t.Fatal("first period should not be cancelled on another date")
+		}
+	})
+}
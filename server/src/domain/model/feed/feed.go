@@ -0,0 +1,25 @@
+package feed
+
+import (
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// Feed is the calendar subscription of a user. Its token is a secret
+// embedded in the subscription URL, apart from the tokens used for signing in.
+type Feed struct {
+	username username.Username
+	token    token.Token
+}
+
+func NewFeed(u username.Username, t token.Token) Feed {
+	return Feed{u, t}
+}
+
+func (f Feed) Username() username.Username {
+	return f.username
+}
+
+func (f Feed) Token() token.Token {
+	return f.token
+}
@@ -0,0 +1,125 @@
+package grade
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+)
+
+func TestNewScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		points   float64
+		max      float64
+		weight   float64
+		expected error
+	}{
+		{"valid", "midterm", 72, 100, 40, nil},
+		{"empty title", "", 72, 100, 40, InvalidTitle},
+		{"over max", "midterm", 101, 100, 40, InvalidPoints},
+		{"negative points", "midterm", -1, 100, 40, InvalidPoints},
+		{"zero max", "midterm", 0, 0, 40, InvalidPoints},
+		{"zero weight", "midterm", 72, 100, 0, InvalidWeight},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewScore(-1, 1, test.title, test.points, test.max, test.weight)
+			if test.expected == nil && err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if test.expected != nil && !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestNewScale(t *testing.T) {
+	tests := []struct {
+		name  string
+		bands []Band
+		valid bool
+	}{
+		{"pass or fail", []Band{NewBand("P", 60, 4), NewBand("F", 0, 0)}, true},
+		{"empty", []Band{}, false},
+		{"not from 0", []Band{NewBand("P", 60, 4), NewBand("F", 10, 0)}, false},
+		{"ascending", []Band{NewBand("F", 0, 0), NewBand("P", 60, 4)}, false},
+		{"same minimums", []Band{NewBand("A", 60, 4), NewBand("B", 60, 3), NewBand("F", 0, 0)}, false},
+		{"empty grade", []Band{NewBand("", 0, 0)}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewScale(test.bands)
+			if (err == nil) != test.valid {
+				t.Fatalf("expected: %v; got: %v\n", test.valid, err)
+			}
+		})
+	}
+}
+
+func TestGrade(t *testing.T) {
+	tests := []struct {
+		name       string
+		scale      string
+		percentage float64
+		expected   string
+	}{
+		{"S", "s", 90, "S"},
+		{"just below S", "s", 89.9, "A"},
+		{"failed", "s", 59, "F"},
+		{"letter", "4.0", 65, "D"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if g := Presets[test.scale].Grade(test.percentage).Grade(); g != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, g)
+			}
+		})
+	}
+}
+
+func TestProject(t *testing.T) {
+	algebra, _ := subject.NewSubject(1, "Algebra", "", 2, "", "", "")
+	physics, _ := subject.NewSubject(2, "Physics", "", 1, "", "", "")
+	seminar, _ := subject.NewSubject(3, "Seminar", "", 0, "", "", "")
+	history, _ := subject.NewSubject(4, "History", "", 2, "", "", "")
+
+	score := func(subject int, points, max, weight float64) Score {
+		s, _ := NewScore(-1, subject, "score", points, max, weight)
+		return s
+	}
+	scores := []Score{
+		score(1, 80, 100, 40),
+		score(1, 50, 50, 60),
+		score(2, 30, 50, 1),
+		score(3, 10, 10, 1),
+	}
+
+	r := Project([]subject.Subject{algebra, physics, seminar, history}, scores, DefaultScale())
+
+	ps := r.Projections()
+	if len(ps) != 3 {
+		t.Fatalf("expected: %v; got: %v\n", 3, len(ps))
+	}
+	if p := ps[0]; p.Percentage() != 92 || p.Band().Grade() != "S" {
+		t.Fatalf("expected: %v %v; got: %v %v\n", 92, "S", p.Percentage(), p.Band().Grade())
+	}
+	if p := ps[1]; p.Percentage() != 60 || p.Band().Grade() != "C" {
+		t.Fatalf("expected: %v %v; got: %v %v\n", 60, "C", p.Percentage(), p.Band().Grade())
+	}
+
+	// (4 * 2 + 1 * 1) / 3, without the seminar of no credits
+	gpa, ok := r.GPA()
+	if !ok || gpa != 3 || r.Credits() != 3 {
+		t.Fatalf("expected: %v; got: %v %v\n", 3, gpa, r.Credits())
+	}
+
+	if _, ok := Project([]subject.Subject{history}, scores, DefaultScale()).GPA(); ok {
+		t.Fatalf("expected no GPA without scores\n")
+	}
+}
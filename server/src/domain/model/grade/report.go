@@ -0,0 +1,87 @@
+package grade
+
+import "github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+
+// Projection is the grade of a subject projected from the scores entered so far.
+type Projection struct {
+	subject    subject.Subject
+	scores     []Score
+	percentage float64
+	band       Band
+}
+
+func (p Projection) Subject() subject.Subject {
+	return p.subject
+}
+
+func (p Projection) Scores() []Score {
+	return p.scores
+}
+
+// Percentage returns the weighted average of the percentages of the scores.
+func (p Projection) Percentage() float64 {
+	return p.percentage
+}
+
+func (p Projection) Band() Band {
+	return p.band
+}
+
+// Report is the projected grades of subjects and their GPA.
+type Report struct {
+	projections []Projection
+	gpa         float64
+	credits     int
+}
+
+func (r Report) Projections() []Projection {
+	return r.projections
+}
+
+// GPA returns the average of the grade points weighted by credits.
+// It returns false if no subject with credits has scores.
+func (r Report) GPA() (float64, bool) {
+	return r.gpa, r.credits > 0
+}
+
+// Credits returns the credits of the subjects counted in GPA.
+func (r Report) Credits() int {
+	return r.credits
+}
+
+// Project projects the grades of the subjects having scores by the scale.
+// Subjects without credits are graded but not counted in GPA.
+func Project(subjects []subject.Subject, scores []Score, scale Scale) Report {
+	bySubject := map[int][]Score{}
+	for _, s := range scores {
+		bySubject[s.subjectID] = append(bySubject[s.subjectID], s)
+	}
+
+	r := Report{projections: make([]Projection, 0)}
+	var points float64
+	for _, s := range subjects {
+		ss, ok := bySubject[s.ID()]
+		if !ok {
+			continue
+		}
+
+		var sum, weights float64
+		for _, score := range ss {
+			sum += score.Percentage() * score.weight
+			weights += score.weight
+		}
+
+		p := Projection{subject: s, scores: ss, percentage: sum / weights}
+		p.band = scale.Grade(p.percentage)
+		r.projections = append(r.projections, p)
+
+		points += p.band.points * float64(s.Credits())
+		r.credits += s.Credits()
+	}
+
+	if r.credits > 0 {
+		r.gpa = points / float64(r.credits)
+	}
+
+	return r
+}
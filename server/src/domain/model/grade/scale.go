@@ -0,0 +1,95 @@
+package grade
+
+import (
+	"unicode/utf8"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+var InvalidScale = errs.Invalid("invalid_scale", "invalid grading scale")
+
+// Band is a grade given to percentages of min or more, worth points in GPA.
+type Band struct {
+	grade  string
+	min    float64
+	points float64
+}
+
+func NewBand(grade string, min, points float64) Band {
+	return Band{grade, min, points}
+}
+
+func (b Band) Grade() string {
+	return b.grade
+}
+
+func (b Band) Min() float64 {
+	return b.min
+}
+
+func (b Band) Points() float64 {
+	return b.points
+}
+
+// Scale is a grading scale, whose bands are in descending order of their minimums.
+type Scale struct {
+	bands []Band
+}
+
+// NewScale makes a scale of bands in descending order of their minimums.
+// The last band must start from 0 so that every percentage has a grade.
+func NewScale(bands []Band) (Scale, error) {
+	if len(bands) == 0 || bands[len(bands)-1].min != 0 {
+		return Scale{}, InvalidScale
+	}
+
+	for i, b := range bands {
+		if b.grade == "" || utf8.RuneCountInString(b.grade) > 8 || b.min < 0 || b.min > 100 || b.points < 0 {
+			return Scale{}, InvalidScale
+		}
+		if i > 0 && b.min >= bands[i-1].min {
+			return Scale{}, InvalidScale
+		}
+	}
+
+	return Scale{bands}, nil
+}
+
+// Presets are the common grading scales by name. "s" is the five-level scale
+// of Japanese universities, and "4.0" is the letter grades of four-point GPA.
+var Presets = map[string]Scale{
+	"s": {[]Band{
+		{"S", 90, 4},
+		{"A", 80, 3},
+		{"B", 70, 2},
+		{"C", 60, 1},
+		{"F", 0, 0},
+	}},
+	"4.0": {[]Band{
+		{"A", 90, 4},
+		{"B", 80, 3},
+		{"C", 70, 2},
+		{"D", 60, 1},
+		{"F", 0, 0},
+	}},
+}
+
+// DefaultScale is the scale of users who have not chosen one.
+func DefaultScale() Scale {
+	return Presets["s"]
+}
+
+func (s Scale) Bands() []Band {
+	return s.bands
+}
+
+// Grade returns the band of the percentage.
+func (s Scale) Grade(percentage float64) Band {
+	for _, b := range s.bands {
+		if percentage >= b.min {
+			return b
+		}
+	}
+
+	return s.bands[len(s.bands)-1]
+}
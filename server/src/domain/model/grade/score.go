@@ -0,0 +1,71 @@
+package grade
+
+import (
+	"unicode/utf8"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+const (
+	MaxTitleLength = 85
+)
+
+var (
+	InvalidTitle  = errs.Invalid("invalid_score_title", "invalid score title")
+	InvalidPoints = errs.Invalid("invalid_points", "invalid points")
+	InvalidWeight = errs.Invalid("invalid_weight", "invalid weight")
+)
+
+// Score is the result of an assignment or an exam of a subject in the catalog.
+// Its weight is relative to the other scores of the subject.
+type Score struct {
+	id        int
+	subjectID int
+	title     string
+	points    float64
+	max       float64
+	weight    float64
+}
+
+func NewScore(id, subjectID int, title string, points, max, weight float64) (Score, error) {
+	if title == "" || utf8.RuneCountInString(title) > MaxTitleLength {
+		return Score{}, InvalidTitle
+	}
+	if max <= 0 || points < 0 || points > max {
+		return Score{}, InvalidPoints
+	}
+	if weight <= 0 {
+		return Score{}, InvalidWeight
+	}
+
+	return Score{id, subjectID, title, points, max, weight}, nil
+}
+
+func (s Score) ID() int {
+	return s.id
+}
+
+func (s Score) SubjectID() int {
+	return s.subjectID
+}
+
+func (s Score) Title() string {
+	return s.title
+}
+
+func (s Score) Points() float64 {
+	return s.points
+}
+
+func (s Score) Max() float64 {
+	return s.max
+}
+
+func (s Score) Weight() float64 {
+	return s.weight
+}
+
+// Percentage returns the points out of 100.
+func (s Score) Percentage() float64 {
+	return s.points / s.max * 100
+}
@@ -0,0 +1,94 @@
+package group
+
+import (
+	"unicode/utf8"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+const (
+	MaxNameLength = 85
+)
+
+var InvalidName = errs.Invalid("invalid_group_name", "group name must be 1 to 85 characters")
+
+// Member is a user invited to a group. Timetables of a member are seen by
+// the others only after the member has joined and consented to sharing them.
+type Member struct {
+	username username.Username
+	joined   bool
+	sharing  bool
+}
+
+func NewMember(u username.Username, joined, sharing bool) Member {
+	return Member{u, joined, sharing}
+}
+
+func (m Member) Username() username.Username {
+	return m.username
+}
+
+func (m Member) Joined() bool {
+	return m.joined
+}
+
+func (m Member) Sharing() bool {
+	return m.sharing
+}
+
+// IsSharing reports whether the timetables of the member are shared with the group.
+func (m Member) IsSharing() bool {
+	return m.joined && m.sharing
+}
+
+// Group is a study group, whose owner invites the other members.
+type Group struct {
+	id      int
+	name    string
+	owner   username.Username
+	members []Member
+}
+
+// NewGroup makes a group. The ID of a group not stored yet is -1.
+func NewGroup(id int, name string, owner username.Username, members []Member) (Group, error) {
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return Group{}, InvalidName
+	}
+
+	ms := make([]Member, len(members))
+	copy(ms, members)
+
+	return Group{id, name, owner, ms}, nil
+}
+
+func (g Group) ID() int {
+	return g.id
+}
+
+func (g Group) Name() string {
+	return g.name
+}
+
+func (g Group) Owner() username.Username {
+	return g.owner
+}
+
+func (g Group) Members() []Member {
+	return g.members
+}
+
+func (g Group) IsOwner(u username.Username) bool {
+	return g.owner.Name() == u.Name()
+}
+
+// Member returns the member of the group whose username is u, including invited ones.
+func (g Group) Member(u username.Username) (Member, bool) {
+	for _, m := range g.members {
+		if m.username.Name() == u.Name() {
+			return m, true
+		}
+	}
+
+	return Member{}, false
+}
@@ -0,0 +1,123 @@
+package group
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+func TestNewGroup(t *testing.T) {
+	owner, _ := username.NewUsername("owner")
+
+	tests := []struct {
+		name       string
+		groupName  string
+		shouldFail bool
+	}{
+		{"valid", "study group", false},
+		{"japanese", strings.Repeat("勉", 85), false},
+		{"empty", "", true},
+		{"too long", strings.Repeat("a", 86), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, e := NewGroup(-1, test.groupName, owner, nil)
+
+			if !test.shouldFail && e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			} else if test.shouldFail && e == nil {
+				t.Fatalf("expected error but got nil")
+			}
+		})
+	}
+}
+
+func TestMember(t *testing.T) {
+	owner, _ := username.NewUsername("owner")
+	invited, _ := username.NewUsername("invited")
+	stranger, _ := username.NewUsername("stranger")
+
+	g, _ := NewGroup(1, "group", owner, []Member{
+		NewMember(owner, true, true),
+		NewMember(invited, false, true),
+	})
+
+	if m, ok := g.Member(owner); !ok || !m.IsSharing() {
+		t.Fatalf("owner should be sharing: %v %v\n", m, ok)
+	}
+	if m, ok := g.Member(invited); !ok || m.IsSharing() {
+		t.Fatalf("invited member should not be sharing before joining: %v %v\n", m, ok)
+	}
+	if _, ok := g.Member(stranger); ok {
+		t.Fatalf("stranger should not be a member")
+	}
+	if !g.IsOwner(owner) || g.IsOwner(invited) {
+		t.Fatalf("unexpected owner: %v\n", g.Owner())
+	}
+}
+
+func timetablesOf(first, second timetables.Slot) timetables.Timetables {
+	day := timetables.NewTimetableOfSlots(
+		first,
+		second,
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+	)
+
+	return timetables.NewTimetables(day, day, day, day, day)
+}
+
+func TestOverlay(t *testing.T) {
+	a, _ := username.NewUsername("a")
+	b, _ := username.NewUsername("b")
+	c, _ := username.NewUsername("c")
+
+	class := timetables.NewSlot(timetables.NewClass("A", "101", ""))
+	lab := timetables.NewSlot(timetables.NewClass("Lab", "201", "").WithRule(timetables.Odd()))
+
+	slots := Overlay(
+		[]username.Username{a, b, c},
+		[]timetables.Timetables{
+			timetablesOf(class, timetables.EmptySlot()),
+			timetablesOf(timetables.EmptySlot(), lab),
+			{},
+		},
+	)
+
+	if len(slots) != 25 {
+		t.Fatalf("expected: %v; got: %v\n", 25, len(slots))
+	}
+	if slots[0].Weekday() != time.Monday || slots[24].Weekday() != time.Friday || slots[24].Period() != 5 {
+		t.Fatalf("unexpected order: %v %v\n", slots[0], slots[24])
+	}
+
+	tests := []struct {
+		name     string
+		min      int
+		expected int
+	}{
+		{"everyone", 3, 15},
+		{"at least two", 2, 25},
+		{"at least one", 1, 25},
+		{"more than members", 4, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := len(Free(slots, test.min)); got != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+
+	// the lab on odd weeks keeps b busy in the 2nd period of every week
+	second := slots[1]
+	if len(second.Busy()) != 1 || second.Busy()[0].Name() != "b" {
+		t.Fatalf("expected: %v; got: %v\n", "[b]", second.Busy())
+	}
+}
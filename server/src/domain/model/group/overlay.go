@@ -0,0 +1,76 @@
+package group
+
+import (
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+var weekdays = []time.Weekday{
+	time.Monday,
+	time.Tuesday,
+	time.Wednesday,
+	time.Thursday,
+	time.Friday,
+}
+
+// Slot is a period of the week with the members who are free or busy in it.
+type Slot struct {
+	weekday time.Weekday
+	period  int
+	free    []username.Username
+	busy    []username.Username
+}
+
+func (s Slot) Weekday() time.Weekday {
+	return s.weekday
+}
+
+func (s Slot) Period() int {
+	return s.period
+}
+
+func (s Slot) Free() []username.Username {
+	return s.free
+}
+
+func (s Slot) Busy() []username.Username {
+	return s.busy
+}
+
+// Overlay lays the timetables of members over each other, where ts[i] is the
+// timetables of members[i]. A member is free in a period whose slot has no class
+// on any week. It returns every period from Monday to Friday in order.
+func Overlay(members []username.Username, ts []timetables.Timetables) []Slot {
+	slots := make([]Slot, 0, len(weekdays)*timetables.Periods)
+	for _, w := range weekdays {
+		for n := 1; n <= timetables.Periods; n++ {
+			s := Slot{w, n, []username.Username{}, []username.Username{}}
+			for i, m := range members {
+				day, _ := ts[i].Day(w)
+				slot, _ := day.Period(n)
+				if slot.IsNoClass() {
+					s.free = append(s.free, m)
+				} else {
+					s.busy = append(s.busy, m)
+				}
+			}
+			slots = append(slots, s)
+		}
+	}
+
+	return slots
+}
+
+// Free returns the slots in which at least min members are free.
+func Free(slots []Slot, min int) []Slot {
+	free := make([]Slot, 0)
+	for _, s := range slots {
+		if len(s.free) >= min {
+			free = append(free, s)
+		}
+	}
+
+	return free
+}
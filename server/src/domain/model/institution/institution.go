@@ -0,0 +1,157 @@
+package institution
+
+import (
+	"unicode/utf8"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+const (
+	MaxNameLength = 85
+)
+
+var (
+	InvalidName = errs.Invalid("invalid_institution_name", "institution name must be 1 to 85 characters")
+	InvalidRole = errs.Invalid("invalid_role", "invalid role")
+)
+
+// Role is what a member can do in an institution. Admins manage the members
+// and publish templates, which students can only use.
+type Role int
+
+const (
+	Student Role = iota
+	Admin
+)
+
+var roleNames = map[Role]string{
+	Student: "student",
+	Admin:   "admin",
+}
+
+func (r Role) String() string {
+	return roleNames[r]
+}
+
+func ParseRole(s string) (Role, error) {
+	for r, name := range roleNames {
+		if name == s {
+			return r, nil
+		}
+	}
+
+	return Student, InvalidRole
+}
+
+// Member is a user invited to an institution. The role of a member takes effect
+// only after the member has accepted the invitation and joined the institution.
+type Member struct {
+	username username.Username
+	role     Role
+	joined   bool
+}
+
+func NewMember(u username.Username, r Role, joined bool) Member {
+	return Member{u, r, joined}
+}
+
+func (m Member) Username() username.Username {
+	return m.username
+}
+
+func (m Member) Role() Role {
+	return m.role
+}
+
+func (m Member) Joined() bool {
+	return m.joined
+}
+
+// IsAdmin reports whether the member has joined the institution as an admin.
+func (m Member) IsAdmin() bool {
+	return m.joined && m.role == Admin
+}
+
+// Institution is a school or a department, whose admins publish templates of timetables to its students.
+type Institution struct {
+	id      int
+	name    string
+	members []Member
+}
+
+// NewInstitution makes an institution. The ID of an institution not stored yet is -1.
+func NewInstitution(id int, name string, members []Member) (Institution, error) {
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return Institution{}, InvalidName
+	}
+
+	ms := make([]Member, len(members))
+	copy(ms, members)
+
+	return Institution{id, name, ms}, nil
+}
+
+func (i Institution) ID() int {
+	return i.id
+}
+
+func (i Institution) Name() string {
+	return i.name
+}
+
+func (i Institution) Members() []Member {
+	return i.members
+}
+
+// Member returns the member of the institution whose username is u, including invited ones.
+func (i Institution) Member(u username.Username) (Member, bool) {
+	for _, m := range i.members {
+		if m.username.Name() == u.Name() {
+			return m, true
+		}
+	}
+
+	return Member{}, false
+}
+
+// IsJoined reports whether u has joined the institution.
+func (i Institution) IsJoined(u username.Username) bool {
+	m, ok := i.Member(u)
+	return ok && m.Joined()
+}
+
+// IsLastMember reports whether u is the only member who has joined the institution.
+func (i Institution) IsLastMember(u username.Username) bool {
+	if !i.IsJoined(u) {
+		return false
+	}
+
+	for _, m := range i.members {
+		if m.Joined() && m.username.Name() != u.Name() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (i Institution) IsAdmin(u username.Username) bool {
+	m, ok := i.Member(u)
+	return ok && m.IsAdmin()
+}
+
+// IsLastAdmin reports whether u is the only admin of the institution.
+func (i Institution) IsLastAdmin(u username.Username) bool {
+	if !i.IsAdmin(u) {
+		return false
+	}
+
+	for _, m := range i.members {
+		if m.IsAdmin() && m.username.Name() != u.Name() {
+			return false
+		}
+	}
+
+	return true
+}
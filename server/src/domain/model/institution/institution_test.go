@@ -0,0 +1,105 @@
+package institution
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+func TestNewInstitution(t *testing.T) {
+	tests := []struct {
+		name       string
+		inst       string
+		shouldFail bool
+	}{
+		{"valid", "情報工学科", false},
+		{"japanese", strings.Repeat("学", 85), false},
+		{"empty", "", true},
+		{"too long", strings.Repeat("a", 86), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, e := NewInstitution(-1, test.inst, nil)
+
+			if !test.shouldFail && e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			} else if test.shouldFail && e == nil {
+				t.Fatalf("expected error but got nil")
+			}
+		})
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	for _, r := range []Role{Student, Admin} {
+		if got, err := ParseRole(r.String()); err != nil || got != r {
+			t.Fatalf("expected: %v; got: %v %v\n", r, got, err)
+		}
+	}
+	if _, err := ParseRole("owner"); !errors.Is(err, InvalidRole) {
+		t.Fatalf("expected: %v; got: %v\n", InvalidRole, err)
+	}
+}
+
+func TestIsLastAdmin(t *testing.T) {
+	a, _ := username.NewUsername("a")
+	b, _ := username.NewUsername("b")
+	s, _ := username.NewUsername("s")
+
+	one, _ := NewInstitution(1, "one admin", []Member{NewMember(a, Admin, true), NewMember(s, Student, true)})
+	two, _ := NewInstitution(2, "two admins", []Member{NewMember(a, Admin, true), NewMember(b, Admin, true)})
+	invited, _ := NewInstitution(3, "invited admin", []Member{NewMember(a, Admin, true), NewMember(b, Admin, false)})
+
+	tests := []struct {
+		name     string
+		inst     Institution
+		user     username.Username
+		expected bool
+	}{
+		{"only admin", one, a, true},
+		{"student", one, s, false},
+		{"stranger", one, b, false},
+		{"one of admins", two, a, false},
+		{"admin with an invited admin", invited, a, true},
+		{"invited admin", invited, b, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.inst.IsLastAdmin(test.user); got != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsLastMember(t *testing.T) {
+	a, _ := username.NewUsername("a")
+	s, _ := username.NewUsername("s")
+	i, _ := username.NewUsername("i")
+
+	one, _ := NewInstitution(1, "one member", []Member{NewMember(a, Admin, true), NewMember(i, Student, false)})
+	two, _ := NewInstitution(2, "two members", []Member{NewMember(a, Admin, true), NewMember(s, Student, true)})
+
+	tests := []struct {
+		name     string
+		inst     Institution
+		user     username.Username
+		expected bool
+	}{
+		{"only member with an invited user", one, a, true},
+		{"invited user", one, i, false},
+		{"one of members", two, s, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.inst.IsLastMember(test.user); got != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package session
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+// Session is a class taking place in a period on a date, or an exam.
+type Session struct {
+	date   time.Time
+	period int
+	class  timetables.Class
+	start  time.Time
+	end    time.Time
+	exam   exam.Exam
+	isExam bool
+}
+
+func NewSession(date time.Time, period int, class timetables.Class, start, end time.Time) Session {
+	return Session{date: date, period: period, class: class, start: start, end: end}
+}
+
+// NewExamSession builds the session of an exam, whose class is of its subject
+// and room. Its period is the one it overlaps most, or 0 if it overlaps none.
+func NewExamSession(schedule bell.Schedule, e exam.Exam) Session {
+	start, end := e.Start().In(schedule.Location()), e.End().In(schedule.Location())
+	period, _ := schedule.PeriodOf(start, end)
+
+	return Session{
+		date:   time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC),
+		period: period,
+		class:  timetables.NewClass(e.Subject(), e.Room(), "").WithSubject(e.SubjectID()),
+		start:  start,
+		end:    end,
+		exam:   e,
+		isExam: true,
+	}
+}
+
+// Date returns the date of the session at midnight in UTC, as dates of exceptions and tasks are.
+func (s Session) Date() time.Time {
+	return s.date
+}
+
+func (s Session) Period() int {
+	return s.period
+}
+
+func (s Session) Class() timetables.Class {
+	return s.class
+}
+
+func (s Session) Start() time.Time {
+	return s.start
+}
+
+func (s Session) End() time.Time {
+	return s.end
+}
+
+// Exam returns the exam of the session, if it is one.
+func (s Session) Exam() (exam.Exam, bool) {
+	return s.exam, s.isExam
+}
+
+// Of returns the sessions of the timetable of a date in order of their starts,
+// timed by the bell schedule. Every slot of the timetable must be resolved to
+// a single class. The exams starting on the date take the place of the classes
+// they overlap.
+func Of(schedule bell.Schedule, date time.Time, day timetables.Timetable, exams []exam.Exam) []Session {
+	sessions := make([]Session, 0)
+	for _, e := range exams {
+		s := NewExamSession(schedule, e)
+		if s.date.Equal(date) {
+			sessions = append(sessions, s)
+		}
+	}
+
+	for i, s := range day.Slots() {
+		if s.IsNoClass() {
+			continue
+		}
+
+		n := i + 1
+		start, end := schedule.Start(date, n), schedule.End(date, n)
+		if examined(exams, start, end) {
+			continue
+		}
+		sessions = append(sessions, NewSession(date, n, s.Classes()[0], start, end))
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].start.Before(sessions[j].start)
+	})
+
+	return sessions
+}
+
+func examined(exams []exam.Exam, start, end time.Time) bool {
+	for _, e := range exams {
+		if e.Overlaps(start, end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Now is what a user is doing at a moment: the session taking place,
+// the one coming next and the tasks of their subjects due on that day.
+type Now struct {
+	at         time.Time
+	current    Session
+	hasCurrent bool
+	next       Session
+	hasNext    bool
+	tasks      []task.Task
+}
+
+// NewNow finds the current and the next sessions at the moment among sessions
+// in order, and the tasks due on the day of at whose titles are about their subjects.
+func NewNow(at time.Time, sessions []Session, tasks []task.Task) Now {
+	n := Now{at: at, tasks: make([]task.Task, 0)}
+	for _, s := range sessions {
+		if !n.hasCurrent && !s.start.After(at) && at.Before(s.end) {
+			n.current, n.hasCurrent = s, true
+		}
+		if !n.hasNext && s.start.After(at) {
+			n.next, n.hasNext = s, true
+		}
+	}
+
+	subjects := make([]string, 0)
+	if n.hasCurrent {
+		subjects = append(subjects, n.current.class.Subject())
+	}
+	if n.hasNext {
+		subjects = append(subjects, n.next.class.Subject())
+	}
+
+	today := at.Format(task.Layout)
+	for _, t := range tasks {
+		if t.TextDate() != today {
+			continue
+		}
+		for _, s := range subjects {
+			if isAbout(t.Title(), s) {
+				n.tasks = append(n.tasks, t)
+				break
+			}
+		}
+	}
+
+	return n
+}
+
+// isAbout reports whether the title of a task is about the subject, which is when it is the name
+// of the subject or begins with it as a word, like "Math quiz" but not "Mathematics quiz".
+// Tasks have no subjects of their own, and classes without names are about no tasks.
+func isAbout(title, subject string) bool {
+	if subject == "" || !strings.HasPrefix(title, subject) {
+		return false
+	}
+
+	rest := strings.TrimPrefix(title, subject)
+	if rest == "" {
+		return true
+	}
+
+	r, _ := utf8.DecodeRuneInString(rest)
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+func (n Now) At() time.Time {
+	return n.at
+}
+
+func (n Now) Current() (Session, bool) {
+	return n.current, n.hasCurrent
+}
+
+func (n Now) Next() (Session, bool) {
+	return n.next, n.hasNext
+}
+
+// MinutesUntilNext returns the minutes until the next session starts, rounded up.
+func (n Now) MinutesUntilNext() (int, bool) {
+	if !n.hasNext {
+		return 0, false
+	}
+
+	d := n.next.start.Sub(n.at)
+	return int((d + time.Minute - 1) / time.Minute), true
+}
+
+func (n Now) Tasks() []task.Task {
+	return n.tasks
+}
@@ -0,0 +1,147 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+func TestNewNow(t *testing.T) {
+	date := time.Date(2020, time.April, 8, 0, 0, 0, 0, time.UTC)
+	clock := func(h, m int) time.Time {
+		return time.Date(2020, time.April, 8, h, m, 0, 0, time.UTC)
+	}
+	sessions := []Session{
+		NewSession(date, 1, timetables.NewClass("A", "101", ""), clock(9, 0), clock(10, 30)),
+		NewSession(date, 3, timetables.NewClass("B", "201", ""), clock(13, 0), clock(14, 30)),
+	}
+
+	reportA, _ := task.NewTask(1, "2020-04-08", "A report")
+	reportB, _ := task.NewTask(2, "2020-04-08", "B quiz")
+	other, _ := task.NewTask(3, "2020-04-08", "C report")
+	later, _ := task.NewTask(4, "2020-04-09", "A report")
+	tasks := []task.Task{reportA, reportB, other, later}
+
+	tests := []struct {
+		name    string
+		at      time.Time
+		current string
+		next    string
+		minutes int
+		tasks   int
+	}{
+		{"before school", clock(8, 0), "", "A", 60, 1},
+		{"during a class", clock(9, 0), "A", "B", 240, 2},
+		{"rounded up", clock(12, 58).Add(30 * time.Second), "", "B", 2, 1},
+		{"after school", clock(15, 0), "", "", 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			n := NewNow(test.at, sessions, tasks)
+
+			current, ok := n.Current()
+			if (test.current != "") != ok || current.Class().Subject() != test.current {
+				t.Fatalf("expected: %v; got: %v\n", test.current, current.Class().Subject())
+			}
+
+			next, ok := n.Next()
+			if (test.next != "") != ok || next.Class().Subject() != test.next {
+				t.Fatalf("expected: %v; got: %v\n", test.next, next.Class().Subject())
+			}
+
+			minutes, _ := n.MinutesUntilNext()
+			if minutes != test.minutes {
+				t.Fatalf("expected: %v; got: %v\n", test.minutes, minutes)
+			}
+
+			if len(n.Tasks()) != test.tasks {
+				t.Fatalf("expected: %v; got: %v\n", test.tasks, n.Tasks())
+			}
+		})
+	}
+}
+
+func TestNewNowTasks(t *testing.T) {
+	date := time.Date(2020, time.April, 8, 0, 0, 0, 0, time.UTC)
+	clock := func(h, m int) time.Time {
+		return time.Date(2020, time.April, 8, h, m, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		subject  string
+		title    string
+		expected bool
+	}{
+		{"same title", "Math", "Math", true},
+		{"prefix as a word", "Math", "Math quiz", true},
+		{"prefix with punctuation", "数学", "数学: レポート", true},
+		{"longer word", "Math", "Mathematics II report", false},
+		{"subject in the middle", "Math", "Applied Math quiz", false},
+		{"subject without name", "", "Math quiz", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sessions := []Session{
+				NewSession(date, 1, timetables.NewClass(test.subject, "101", ""), clock(9, 0), clock(10, 30)),
+			}
+			tk, _ := task.NewTask(1, "2020-04-08", test.title)
+
+			n := NewNow(clock(9, 0), sessions, []task.Task{tk})
+			if found := len(n.Tasks()) == 1; found != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, n.Tasks())
+			}
+		})
+	}
+}
+
+func TestOf(t *testing.T) {
+	schedule := bell.Default()
+	date := time.Date(2020, time.April, 8, 0, 0, 0, 0, time.UTC)
+	clock := func(d, h, m int) time.Time {
+		return time.Date(2020, time.April, d, h, m, 0, 0, schedule.Location())
+	}
+	day := timetables.NewTimetable(
+		timetables.NewClass("A", "101", ""),
+		timetables.NewClass("B", "201", ""),
+		timetables.NoClass(),
+		timetables.NewClass("C", "301", ""),
+		timetables.NoClass(),
+	)
+
+	// The first one takes the place of B, and the second one is on another day.
+	final, _ := exam.NewExam(1, 2, 0, "Final", clock(8, 11, 0), clock(8, 12, 0), "Hall")
+	other, _ := exam.NewExam(2, 1, 0, "Final", clock(9, 9, 0), clock(9, 10, 0), "Hall")
+	exams := []exam.Exam{final.WithSubject("B"), other.WithSubject("A")}
+
+	sessions := Of(schedule, date, day, exams)
+
+	expected := []struct {
+		subject string
+		period  int
+		isExam  bool
+	}{
+		{"A", 1, false},
+		{"B", 2, true},
+		{"C", 4, false},
+	}
+	if len(sessions) != len(expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, sessions)
+	}
+	for i, e := range expected {
+		s := sessions[i]
+		_, isExam := s.Exam()
+		if s.Class().Subject() != e.subject || s.Period() != e.period || isExam != e.isExam {
+			t.Fatalf("expected: %v; got: %v\n", e, s)
+		}
+	}
+	if !sessions[1].Start().Equal(clock(8, 11, 0)) || sessions[1].Class().Room() != "Hall" {
+		t.Fatalf("expected: %v; got: %v\n", final, sessions[1])
+	}
+}
@@ -0,0 +1,104 @@
+package share
+
+import (
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// Visibility is what a share link shows of each class besides its subject.
+type Visibility struct {
+	room bool
+	memo bool
+}
+
+func NewVisibility(room, memo bool) Visibility {
+	return Visibility{room, memo}
+}
+
+func (v Visibility) Room() bool {
+	return v.room
+}
+
+func (v Visibility) Memo() bool {
+	return v.memo
+}
+
+// Apply returns the timetables with the hidden fields of every class removed.
+func (v Visibility) Apply(ts timetables.Timetables) timetables.Timetables {
+	return timetables.NewTimetables(
+		v.timetable(ts.Mon()),
+		v.timetable(ts.Tue()),
+		v.timetable(ts.Wed()),
+		v.timetable(ts.Thu()),
+		v.timetable(ts.Fri()),
+	)
+}
+
+func (v Visibility) timetable(t timetables.Timetable) timetables.Timetable {
+	slots := make([]timetables.Slot, 0, timetables.Periods)
+	for _, s := range t.Slots() {
+		classes := make([]timetables.Class, 0, len(s.Classes()))
+		for _, c := range s.Classes() {
+			classes = append(classes, v.class(c))
+		}
+		slots = append(slots, timetables.NewSlot(classes...))
+	}
+
+	return timetables.NewTimetableOfSlots(slots[0], slots[1], slots[2], slots[3], slots[4])
+}
+
+func (v Visibility) class(c timetables.Class) timetables.Class {
+	memo := c.Memo()
+	if !v.memo {
+		memo = ""
+	}
+
+	if c.IsNoRoom() || !v.room {
+		return timetables.NoRoom(c.Subject(), memo).WithRule(c.Rule())
+	}
+	return timetables.NewClass(c.Subject(), c.Room(), memo).WithRule(c.Rule())
+}
+
+// Share is a public link to the timetables of a user. Its token is a secret
+// embedded in the URL, apart from the tokens used for signing in.
+type Share struct {
+	id         int
+	username   username.Username
+	token      token.Token
+	visibility Visibility
+	expires    time.Time
+}
+
+// NewShare makes a share link. A zero expires means the link never expires.
+// The ID of a link not stored yet is -1.
+func NewShare(id int, u username.Username, t token.Token, v Visibility, expires time.Time) Share {
+	return Share{id, u, t, v, expires}
+}
+
+func (s Share) ID() int {
+	return s.id
+}
+
+func (s Share) Username() username.Username {
+	return s.username
+}
+
+func (s Share) Token() token.Token {
+	return s.token
+}
+
+func (s Share) Visibility() Visibility {
+	return s.visibility
+}
+
+// Expires returns the moment the link expires, or false if it never does.
+func (s Share) Expires() (time.Time, bool) {
+	return s.expires, !s.expires.IsZero()
+}
+
+func (s Share) IsExpired(now time.Time) bool {
+	return !s.expires.IsZero() && !now.Before(s.expires)
+}
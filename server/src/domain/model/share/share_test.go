@@ -0,0 +1,84 @@
+package share
+
+import (
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+func TestApply(t *testing.T) {
+	odd := timetables.NewClass("Lab", "201", "bring a coat").WithRule(timetables.Odd())
+	even := timetables.NoRoom("Lecture", "https://zoom.us/j/1").WithRule(timetables.Even())
+	day := timetables.NewTimetableOfSlots(
+		timetables.NewSlot(odd, even),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+	)
+	ts := timetables.NewTimetables(day, day, day, day, day)
+
+	tests := []struct {
+		name       string
+		visibility Visibility
+		room       string
+		noRoom     bool
+		memo       string
+	}{
+		{"everything", NewVisibility(true, true), "201", false, "bring a coat"},
+		{"without memos", NewVisibility(true, false), "201", false, ""},
+		{"without rooms", NewVisibility(false, true), "", true, "bring a coat"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			classes := test.visibility.Apply(ts).Fri().First().Classes()
+			if len(classes) != 2 {
+				t.Fatalf("expected: %v; got: %v\n", 2, len(classes))
+			}
+
+			c := classes[0]
+			if c.Subject() != "Lab" || c.Room() != test.room || c.IsNoRoom() != test.noRoom || c.Memo() != test.memo {
+				t.Fatalf("unexpected class: %v\n", c)
+			}
+			if c.Rule().Weeks() != timetables.OddWeeks {
+				t.Fatalf("expected: %v; got: %v\n", timetables.OddWeeks, c.Rule().Weeks())
+			}
+			if !classes[1].IsNoRoom() {
+				t.Fatalf("expected a class without room; got: %v\n", classes[1])
+			}
+		})
+	}
+
+	if len(NewVisibility(true, true).Apply(ts).Mon().Second().Classes()) != 0 {
+		t.Fatalf("empty slots should stay empty")
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	now := time.Date(2020, time.April, 8, 12, 0, 0, 0, time.UTC)
+	user, _ := username.NewUsername("user")
+
+	tests := []struct {
+		name     string
+		expires  time.Time
+		expected bool
+	}{
+		{"never", time.Time{}, false},
+		{"future", now.Add(time.Hour), false},
+		{"now", now, true},
+		{"past", now.Add(-time.Hour), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewShare(1, user, token.NewToken("secret"), NewVisibility(true, true), test.expires)
+			if got := s.IsExpired(now); got != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+}
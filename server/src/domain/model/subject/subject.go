@@ -0,0 +1,87 @@
+package subject
+
+import (
+	"net/url"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+// Subject is an entry of the catalog of a user. Classes in the slots of
+// timetables refer to it, so that a subject meeting several times a week
+// is edited at one place.
+type Subject struct {
+	id         int
+	name       string
+	instructor string
+	credits    int
+	color      string
+	syllabus   string
+	room       string
+}
+
+const (
+	MaxNameLength = 85
+)
+
+var (
+	InvalidName     = errs.Invalid("invalid_subject_name", "invalid subject name")
+	InvalidCredits  = errs.Invalid("invalid_credits", "invalid credits")
+	InvalidColor    = errs.Invalid("invalid_color", "invalid color")
+	InvalidSyllabus = errs.Invalid("invalid_syllabus", "invalid syllabus URL")
+)
+
+var color = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// NewSubject makes a subject. Instructor, color, syllabus and room are
+// optional and left empty when unknown. Color is given as #rrggbb.
+func NewSubject(id int, name, instructor string, credits int, c, syllabus, room string) (Subject, error) {
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return Subject{}, InvalidName
+	}
+	if credits < 0 {
+		return Subject{}, InvalidCredits
+	}
+	if c != "" && !color.MatchString(c) {
+		return Subject{}, InvalidColor
+	}
+	if syllabus != "" {
+		u, err := url.Parse(syllabus)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return Subject{}, InvalidSyllabus
+		}
+	}
+
+	return Subject{id, name, instructor, credits, c, syllabus, room}, nil
+}
+
+func (s Subject) ID() int {
+	return s.id
+}
+
+func (s Subject) Name() string {
+	return s.name
+}
+
+func (s Subject) Instructor() string {
+	return s.instructor
+}
+
+func (s Subject) Credits() int {
+	return s.credits
+}
+
+func (s Subject) Color() string {
+	return s.color
+}
+
+func (s Subject) Syllabus() string {
+	return s.syllabus
+}
+
+// Room returns the default room, where classes of the subject without
+// their own room take place.
+func (s Subject) Room() string {
+	return s.room
+}
@@ -0,0 +1,46 @@
+package subject
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewSubject(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  string
+		credits  int
+		color    string
+		syllabus string
+		expected error
+	}{
+		{"valid", "Algebra", 2, "#1e90ff", "https://example.com/syllabus/1", nil},
+		{"optional fields", "Algebra", 0, "", "", nil},
+		{"empty name", "", 2, "", "", InvalidName},
+		{"too long name", string(make([]rune, MaxNameLength+1)), 2, "", "", InvalidName},
+		{"negative credits", "Algebra", -1, "", "", InvalidCredits},
+		{"named color", "Algebra", 2, "blue", "", InvalidColor},
+		{"short color", "Algebra", 2, "#fff", "", InvalidColor},
+		{"relative syllabus", "Algebra", 2, "", "/syllabus/1", InvalidSyllabus},
+		{"syllabus of other scheme", "Algebra", 2, "", "javascript:alert(1)", InvalidSyllabus},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := NewSubject(1, test.subject, "Prof. A", test.credits, test.color, test.syllabus, "101")
+			if test.expected != nil {
+				if !errors.Is(err, test.expected) {
+					t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if s.Name() != test.subject || s.Room() != "101" {
+				t.Fatalf("expected: %v; got: %v\n", test.subject, s)
+			}
+		})
+	}
+}
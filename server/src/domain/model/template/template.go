@@ -0,0 +1,89 @@
+package template
+
+import (
+	"unicode/utf8"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+const (
+	MaxNameLength   = 85
+	MaxCourseLength = 85
+	MaxYear         = 10
+)
+
+var (
+	InvalidName   = errs.Invalid("invalid_template_name", "template name must be 1 to 85 characters")
+	InvalidCourse = errs.Invalid("invalid_course", "course must be at most 85 characters")
+	InvalidYear   = errs.Invalid("invalid_year", "year must be 0 to 10")
+)
+
+// Template is a curriculum of an institution, i.e. the classes common to the
+// students of a course in a year, which students copy into their timetables.
+// Year 0 and an empty course mean the template is not limited to them.
+type Template struct {
+	id            int
+	institutionID int
+	name          string
+	course        string
+	year          int
+	timetables    timetables.Timetables
+}
+
+// NewTemplate makes a template. The ID of a template not stored yet is -1.
+// Classes of a template refer to subjects only by name, since the catalog of
+// subjects belongs to each student.
+func NewTemplate(id, institutionID int, name, course string, year int, ts timetables.Timetables) (Template, error) {
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return Template{}, InvalidName
+	}
+	if utf8.RuneCountInString(course) > MaxCourseLength {
+		return Template{}, InvalidCourse
+	}
+	if year < 0 || year > MaxYear {
+		return Template{}, InvalidYear
+	}
+
+	return Template{id, institutionID, name, course, year, detach(ts)}, nil
+}
+
+func detach(ts timetables.Timetables) timetables.Timetables {
+	day := func(t timetables.Timetable) timetables.Timetable {
+		for n := 1; n <= timetables.Periods; n++ {
+			s, _ := t.Period(n)
+			classes := make([]timetables.Class, 0, len(s.Classes()))
+			for _, c := range s.Classes() {
+				classes = append(classes, c.WithSubject(0))
+			}
+			t = t.WithPeriod(n, timetables.NewSlot(classes...))
+		}
+		return t
+	}
+
+	return timetables.NewTimetables(day(ts.Mon()), day(ts.Tue()), day(ts.Wed()), day(ts.Thu()), day(ts.Fri()))
+}
+
+func (t Template) ID() int {
+	return t.id
+}
+
+func (t Template) InstitutionID() int {
+	return t.institutionID
+}
+
+func (t Template) Name() string {
+	return t.name
+}
+
+func (t Template) Course() string {
+	return t.course
+}
+
+func (t Template) Year() int {
+	return t.year
+}
+
+func (t Template) Timetables() timetables.Timetables {
+	return t.timetables
+}
@@ -0,0 +1,66 @@
+package template
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+func TestNewTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		tmpl     string
+		course   string
+		year     int
+		expected error
+	}{
+		{"valid", "2年前期", "情報工学科", 2, nil},
+		{"any course and year", "共通科目", "", 0, nil},
+		{"empty name", "", "", 0, InvalidName},
+		{"too long course", "2年前期", strings.Repeat("a", 86), 2, InvalidCourse},
+		{"negative year", "2年前期", "", -1, InvalidYear},
+		{"too late year", "2年前期", "", 11, InvalidYear},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewTemplate(-1, 1, test.tmpl, test.course, test.year, timetables.Timetables{})
+			if test.expected == nil && err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if test.expected != nil && !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestNewTemplateDetachesSubjects(t *testing.T) {
+	day := timetables.NewTimetableOfSlots(
+		timetables.NewSlot(
+			timetables.NewClass("Lab", "101", "").WithSubject(3).WithRule(timetables.Odd()),
+			timetables.NewClass("Lecture", "202", "").WithSubject(4).WithRule(timetables.Even()),
+		),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+		timetables.EmptySlot(),
+	)
+
+	tmpl, _ := NewTemplate(-1, 1, "2年前期", "", 2, timetables.NewTimetables(day, day, day, day, day))
+
+	classes := tmpl.Timetables().Fri().First().Classes()
+	if len(classes) != 2 {
+		t.Fatalf("expected: %v; got: %v\n", 2, classes)
+	}
+	for _, c := range classes {
+		if c.SubjectID() != 0 {
+			t.Fatalf("expected: %v; got: %v\n", 0, c.SubjectID())
+		}
+	}
+	if classes[1].Subject() != "Lecture" || classes[1].Rule().Weeks() != timetables.EvenWeeks {
+		t.Fatalf("expected: %v; got: %v\n", "Lecture", classes[1])
+	}
+}
@@ -0,0 +1,103 @@
+package term
+
+import (
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+type Term struct {
+	id    int
+	name  string
+	start time.Time
+	end   time.Time
+}
+
+const (
+	Layout = "2006-01-02"
+)
+
+var (
+	InvalidDateFormat = errs.Invalid("invalid_date_format", "invalid date format")
+	InvalidName       = errs.Invalid("invalid_term_name", "invalid empty name")
+	InvalidDateRange  = errs.Invalid("invalid_date_range", "start date is after end date")
+)
+
+func NewTerm(id int, name, start, end string) (Term, error) {
+	if name == "" {
+		return Term{}, InvalidName
+	}
+
+	s, err := time.Parse(Layout, start)
+	if err != nil {
+		return Term{}, InvalidDateFormat
+	}
+	e, err := time.Parse(Layout, end)
+	if err != nil {
+		return Term{}, InvalidDateFormat
+	}
+	if s.After(e) {
+		return Term{}, InvalidDateRange
+	}
+
+	return Term{id, name, s, e}, nil
+}
+
+func (t Term) ID() int {
+	return t.id
+}
+
+func (t Term) Name() string {
+	return t.name
+}
+
+func (t Term) Start() time.Time {
+	return t.start
+}
+
+func (t Term) End() time.Time {
+	return t.end
+}
+
+func (t Term) TextStart() string {
+	return t.start.Format(Layout)
+}
+
+func (t Term) TextEnd() string {
+	return t.end.Format(Layout)
+}
+
+// Contains reports whether the day of d is within the term, both ends inclusive.
+func (t Term) Contains(d time.Time) bool {
+	day := truncate(d)
+	return !day.Before(t.start) && !day.After(t.end)
+}
+
+// IsPast reports whether the term has already ended on the day of d.
+func (t Term) IsPast(d time.Time) bool {
+	return truncate(d).After(t.end)
+}
+
+// Active picks the term containing d. When terms overlap, the one that
+// started last wins.
+func Active(terms []Term, d time.Time) (Term, bool) {
+	var (
+		active Term
+		found  bool
+	)
+	for _, t := range terms {
+		if !t.Contains(d) {
+			continue
+		}
+		if !found || t.start.After(active.start) {
+			active = t
+			found = true
+		}
+	}
+
+	return active, found
+}
+
+func truncate(d time.Time) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+}
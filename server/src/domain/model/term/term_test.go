@@ -0,0 +1,123 @@
+package term
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewTerm(t *testing.T) {
+	tests := []struct {
+		name       string
+		termName   string
+		start      string
+		end        string
+		shouldFail bool
+	}{
+		{"valid", "2020 spring", "2020-04-01", "2020-09-30", false},
+		{"same day", "intensive", "2020-08-01", "2020-08-01", false},
+		{"empty name", "", "2020-04-01", "2020-09-30", true},
+		{"invalid start", "2020 spring", "2020/04/01", "2020-09-30", true},
+		{"invalid end", "2020 spring", "2020-04-01", "2020-9-30", true},
+		{"reversed", "2020 spring", "2020-09-30", "2020-04-01", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, e := NewTerm(0, test.termName, test.start, test.end)
+
+			if !test.shouldFail && e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			} else if test.shouldFail && e == nil {
+				t.Fatalf("expected error but got nil")
+			}
+		})
+	}
+}
+
+func TestTermGetters(t *testing.T) {
+	term, _ := NewTerm(1, "2020 spring", "2020-04-01", "2020-09-30")
+	tests := []struct {
+		expected interface{}
+		got      interface{}
+	}{
+		{term.id, term.ID()},
+		{term.name, term.Name()},
+		{term.start, term.Start()},
+		{term.end, term.End()},
+		{"2020-04-01", term.TextStart()},
+		{"2020-09-30", term.TextEnd()},
+	}
+
+	for _, test := range tests {
+		if test.expected != test.got {
+			t.Fatalf(
+				"expected: %v; got: %v\n",
+				test.expected,
+				test.got,
+			)
+		}
+	}
+}
+
+func TestContains(t *testing.T) {
+	term, _ := NewTerm(1, "2020 spring", "2020-04-01", "2020-09-30")
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected bool
+	}{
+		{"before", time.Date(2020, time.March, 31, 23, 59, 0, 0, time.UTC), false},
+		{"first day", time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC), true},
+		{"last day evening", time.Date(2020, time.September, 30, 20, 0, 0, 0, time.UTC), true},
+		{"after", time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := term.Contains(test.date); got != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsPast(t *testing.T) {
+	term, _ := NewTerm(1, "2020 spring", "2020-04-01", "2020-09-30")
+	if term.IsPast(time.Date(2020, time.September, 30, 12, 0, 0, 0, time.UTC)) {
+		t.Fatal("should not be past on the last day")
+	}
+	if !term.IsPast(time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatal("should be past after the last day")
+	}
+}
+
+func TestActive(t *testing.T) {
+	spring, _ := NewTerm(1, "spring", "2020-04-01", "2020-09-30")
+	fall, _ := NewTerm(2, "fall", "2020-10-01", "2021-03-31")
+	intensive, _ := NewTerm(3, "intensive", "2020-08-01", "2020-08-31")
+	terms := []Term{spring, fall, intensive}
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected int
+		found    bool
+	}{
+		{"spring", time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC), 1, true},
+		{"fall", time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC), 2, true},
+		{"overlapped", time.Date(2020, time.August, 10, 0, 0, 0, 0, time.UTC), 3, true},
+		{"no term", time.Date(2021, time.April, 10, 0, 0, 0, 0, time.UTC), 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			term, found := Active(terms, test.date)
+			if found != test.found {
+				t.Fatalf("expected: %v; got: %v\n", test.found, found)
+			}
+			if term.ID() != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, term.ID())
+			}
+		})
+	}
+}
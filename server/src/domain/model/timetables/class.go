@@ -1,11 +1,13 @@
 package timetables
 
 type Class struct {
-	subject string
-	room    string
-	memo    string
-	noRoom  bool
-	noClass bool
+	subject   string
+	room      string
+	memo      string
+	noRoom    bool
+	noClass   bool
+	rule      Rule
+	subjectID int
 }
 
 func NewClass(s, r, m string) Class {
@@ -30,6 +32,27 @@ func NoRoom(s, m string) Class {
 	}
 }
 
+// WithRule returns a copy of the class taking place according to r.
+func (c Class) WithRule(r Rule) Class {
+	c.rule = r
+	return c
+}
+
+func (c Class) Rule() Rule {
+	return c.rule
+}
+
+// WithSubject returns a copy of the class referring to the subject of id in the catalog.
+func (c Class) WithSubject(id int) Class {
+	c.subjectID = id
+	return c
+}
+
+// SubjectID returns the ID of the subject in the catalog, or 0 if the class refers to none.
+func (c Class) SubjectID() int {
+	return c.subjectID
+}
+
 func (c Class) IsNoClass() bool {
 	return c.noClass
 }
@@ -49,3 +72,12 @@ func (c Class) Room() string {
 func (c Class) Memo() string {
 	return c.memo
 }
+
+// same reports whether c and o are the same class regardless of their rules.
+func (c Class) same(o Class) bool {
+	return c.subject == o.subject &&
+		c.room == o.room &&
+		c.memo == o.memo &&
+		c.noRoom == o.noRoom &&
+		c.noClass == o.noClass
+}
@@ -0,0 +1,161 @@
+package timetables
+
+import "time"
+
+type ChangeKind int
+
+const (
+	SlotAdded ChangeKind = iota
+	SlotRemoved
+	SlotChanged
+)
+
+var changeKindNames = map[ChangeKind]string{
+	SlotAdded:   "added",
+	SlotRemoved: "removed",
+	SlotChanged: "changed",
+}
+
+func (k ChangeKind) String() string {
+	return changeKindNames[k]
+}
+
+// Fields of a class a change is reported by. The fields of the first class
+// of a slot are compared one by one, and the rest of the classes as a whole.
+const (
+	SubjectField    = "subject"
+	RoomField       = "room"
+	MemoField       = "memo"
+	WeeksField      = "weeks"
+	DatesField      = "dates"
+	AlternatesField = "alternates"
+)
+
+// Change is a slot which differs between two timetables.
+type Change struct {
+	kind   ChangeKind
+	day    time.Weekday
+	period int
+	before Slot
+	after  Slot
+	fields []string
+}
+
+func (c Change) Kind() ChangeKind {
+	return c.kind
+}
+
+func (c Change) Day() time.Weekday {
+	return c.day
+}
+
+func (c Change) Period() int {
+	return c.period
+}
+
+// Before returns the slot in the old timetables, which is empty for SlotAdded.
+func (c Change) Before() Slot {
+	return c.before
+}
+
+// After returns the slot in the new timetables, which is empty for SlotRemoved.
+func (c Change) After() Slot {
+	return c.after
+}
+
+// Fields returns the fields changed for SlotChanged, in the order they are declared.
+func (c Change) Fields() []string {
+	return c.fields
+}
+
+// Diff lists the slots changed from the timetables from to the timetables to,
+// in order of days and periods.
+func Diff(from, to Timetables) []Change {
+	changes := make([]Change, 0)
+	for _, w := range []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday} {
+		before, _ := from.Day(w)
+		after, _ := to.Day(w)
+		for n := 1; n <= Periods; n++ {
+			b, _ := before.Period(n)
+			a, _ := after.Period(n)
+
+			switch {
+			case b.IsNoClass() && a.IsNoClass():
+				continue
+			case b.IsNoClass():
+				changes = append(changes, Change{kind: SlotAdded, day: w, period: n, after: a})
+			case a.IsNoClass():
+				changes = append(changes, Change{kind: SlotRemoved, day: w, period: n, before: b})
+			default:
+				if fields := changedFields(b, a); len(fields) > 0 {
+					changes = append(changes, Change{SlotChanged, w, n, b, a, fields})
+				}
+			}
+		}
+	}
+
+	return changes
+}
+
+func changedFields(before, after Slot) []string {
+	b, a := before.Classes()[0], after.Classes()[0]
+
+	fields := make([]string, 0)
+	if !sameSubject(b, a) {
+		fields = append(fields, SubjectField)
+	}
+	if b.room != a.room || b.noRoom != a.noRoom {
+		fields = append(fields, RoomField)
+	}
+	if b.memo != a.memo {
+		fields = append(fields, MemoField)
+	}
+	if b.Rule().Weeks() != a.Rule().Weeks() {
+		fields = append(fields, WeeksField)
+	}
+	if !sameDates(b.Rule(), a.Rule()) {
+		fields = append(fields, DatesField)
+	}
+	if !sameAlternates(before.Classes()[1:], after.Classes()[1:]) {
+		fields = append(fields, AlternatesField)
+	}
+
+	return fields
+}
+
+// sameSubject compares classes by their subjects of the catalog if both have one, or by name otherwise.
+func sameSubject(c, o Class) bool {
+	if c.SubjectID() > 0 && o.SubjectID() > 0 {
+		return c.SubjectID() == o.SubjectID()
+	}
+
+	return c.subject == o.subject
+}
+
+func sameDates(r, o Rule) bool {
+	if len(r.Dates()) != len(o.Dates()) {
+		return false
+	}
+	for i, d := range r.Dates() {
+		if !d.Equal(o.Dates()[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameAlternates(cs, os []Class) bool {
+	if len(cs) != len(os) {
+		return false
+	}
+	for i, c := range cs {
+		o := os[i]
+		if !sameSubject(c, o) || c.room != o.room || c.noRoom != o.noRoom || c.memo != o.memo ||
+			c.Rule().Weeks() != o.Rule().Weeks() || !sameDates(c.Rule(), o.Rule()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,100 @@
+package timetables
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	empty := NewTimetable(NoClass(), NoClass(), NoClass(), NoClass(), NoClass())
+	lab := NewClass("Lab", "101", "").WithRule(Odd())
+
+	from := NewTimetables(
+		NewTimetable(
+			NewClass("A", "100", "").WithSubject(1),
+			NewClass("B", "200", "memo"),
+			NoRoom("C", ""),
+			NoClass(),
+			NoClass(),
+		),
+		empty,
+		NewTimetableOfSlots(
+			NewSlot(lab, NewClass("Lab", "202", "").WithRule(Even())),
+			EmptySlot(),
+			EmptySlot(),
+			EmptySlot(),
+			EmptySlot(),
+		),
+		empty,
+		empty,
+	)
+	to := NewTimetables(
+		NewTimetable(
+			// The subject is renamed in the catalog.
+			NewClass("A'", "100", "").WithSubject(1),
+			NewClass("D", "300", "memo"),
+			NoClass(),
+			NoRoom("E", ""),
+			NoClass(),
+		),
+		empty,
+		NewTimetableOfSlots(
+			NewSlot(lab, NewClass("Lab", "303", "").WithRule(Even())),
+			EmptySlot(),
+			EmptySlot(),
+			EmptySlot(),
+			EmptySlot(),
+		),
+		empty,
+		NewTimetable(NewClass("F", "100", "").WithRule(Even()), NoClass(), NoClass(), NoClass(), NoClass()),
+	)
+
+	expected := []struct {
+		kind   ChangeKind
+		day    time.Weekday
+		period int
+		fields []string
+	}{
+		{SlotChanged, time.Monday, 2, []string{SubjectField, RoomField}},
+		{SlotRemoved, time.Monday, 3, nil},
+		{SlotAdded, time.Monday, 4, nil},
+		{SlotChanged, time.Wednesday, 1, []string{AlternatesField}},
+		{SlotAdded, time.Friday, 1, nil},
+	}
+
+	changes := Diff(from, to)
+	if len(changes) != len(expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, changes)
+	}
+	for i, e := range expected {
+		c := changes[i]
+		if c.Kind() != e.kind || c.Day() != e.day || c.Period() != e.period {
+			t.Fatalf("expected: %v; got: %v\n", e, c)
+		}
+		if e.fields != nil && !reflect.DeepEqual(c.Fields(), e.fields) {
+			t.Fatalf("expected: %v; got: %v\n", e.fields, c.Fields())
+		}
+	}
+
+	if c := changes[1]; c.Before().Classes()[0].Subject() != "C" || !c.After().IsNoClass() {
+		t.Fatalf("expected: %v; got: %v\n", "C", c)
+	}
+
+	t.Run("rules", func(t *testing.T) {
+		dates, _ := Only([]time.Time{time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)})
+		other, _ := Only([]time.Time{time.Date(2020, 4, 8, 0, 0, 0, 0, time.UTC)})
+		day := func(c Class) Timetables {
+			return NewTimetables(NewTimetable(c, NoClass(), NoClass(), NoClass(), NoClass()), empty, empty, empty, empty)
+		}
+
+		changes := Diff(day(NewClass("A", "100", "").WithRule(dates)), day(NewClass("A", "100", "x").WithRule(other)))
+		if len(changes) != 1 || !reflect.DeepEqual(changes[0].Fields(), []string{MemoField, DatesField}) {
+			t.Fatalf("expected: %v; got: %v\n", []string{MemoField, DatesField}, changes)
+		}
+
+		if changes := Diff(day(lab), day(lab)); len(changes) != 0 {
+			t.Fatalf("expected no changes; got: %v\n", changes)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package timetables
+
+import (
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+var (
+	InvalidWeekday = errs.Invalid("invalid_weekday", "invalid weekday")
+	InvalidPeriod  = errs.Invalid("invalid_period", "invalid period")
+)
+
+// Entry is a class found in an imported file, such as a row of CSV.
+// Its source tells where it was found to the user.
+type Entry struct {
+	weekday time.Weekday
+	period  int
+	class   Class
+	source  string
+}
+
+func NewEntry(weekday time.Weekday, period int, class Class, source string) (Entry, error) {
+	if weekday < time.Monday || weekday > time.Friday {
+		return Entry{}, InvalidWeekday
+	}
+	if period < 1 || period > Periods {
+		return Entry{}, InvalidPeriod
+	}
+
+	return Entry{weekday, period, class, source}, nil
+}
+
+func (e Entry) Weekday() time.Weekday {
+	return e.weekday
+}
+
+func (e Entry) Period() int {
+	return e.period
+}
+
+func (e Entry) Class() Class {
+	return e.class
+}
+
+func (e Entry) Source() string {
+	return e.source
+}
+
+// Conflict is a period to which entries of different classes are imported.
+type Conflict struct {
+	weekday time.Weekday
+	period  int
+	entries []Entry
+}
+
+func (c Conflict) Weekday() time.Weekday {
+	return c.weekday
+}
+
+func (c Conflict) Period() int {
+	return c.period
+}
+
+func (c Conflict) Entries() []Entry {
+	return c.entries
+}
+
+// Merge builds timetables of the entries. Entries of the same class in the
+// same period, such as the occurrences of a class in every week, are merged.
+// If entries of different classes fall on a period, the first one is taken
+// and the period is reported as a conflict.
+func Merge(entries []Entry) (Timetables, []Conflict) {
+	days := map[time.Weekday][]Slot{}
+	for w := time.Monday; w <= time.Friday; w++ {
+		days[w] = make([]Slot, Periods)
+	}
+
+	conflicts := make([]Conflict, 0)
+	index := map[[2]int]int{}
+	taken := map[[2]int]Entry{}
+
+	for _, e := range entries {
+		key := [2]int{int(e.weekday), e.period}
+
+		first, ok := taken[key]
+		if !ok {
+			taken[key] = e
+			days[e.weekday][e.period-1] = NewSlot(e.class)
+			continue
+		}
+		if first.class.same(e.class) {
+			continue
+		}
+
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(conflicts)
+			conflicts = append(conflicts, Conflict{e.weekday, e.period, []Entry{first, e}})
+			continue
+		}
+		conflicts[i].entries = append(conflicts[i].entries, e)
+	}
+
+	timetable := func(w time.Weekday) Timetable {
+		s := days[w]
+		return NewTimetableOfSlots(s[0], s[1], s[2], s[3], s[4])
+	}
+
+	return NewTimetables(
+		timetable(time.Monday),
+		timetable(time.Tuesday),
+		timetable(time.Wednesday),
+		timetable(time.Thursday),
+		timetable(time.Friday),
+	), conflicts
+}
@@ -0,0 +1,87 @@
+package timetables
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestNewEntry(t *testing.T) {
+	tests := []struct {
+		name       string
+		weekday    time.Weekday
+		period     int
+		shouldFail bool
+	}{
+		{"monday", time.Monday, 1, false},
+		{"friday", time.Friday, 5, false},
+		{"sunday", time.Sunday, 1, true},
+		{"saturday", time.Saturday, 1, true},
+		{"period 0", time.Monday, 0, true},
+		{"period 6", time.Monday, 6, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, e := NewEntry(test.weekday, test.period, NewClass("A", "101", ""), "line 1")
+
+			if !test.shouldFail && e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			} else if test.shouldFail && e == nil {
+				t.Fatalf("expected error but got nil")
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	entry := func(w time.Weekday, period int, c Class, source string) Entry {
+		e, _ := NewEntry(w, period, c, source)
+		return e
+	}
+
+	a := NewClass("A", "101", "")
+	b := NewClass("B", "202", "")
+	c := NoRoom("C", "")
+
+	ts, conflicts := Merge([]Entry{
+		entry(time.Monday, 1, a, "line 1"),
+		entry(time.Monday, 1, a, "line 2"),
+		entry(time.Wednesday, 3, c, "line 3"),
+		entry(time.Monday, 1, b, "line 4"),
+		entry(time.Monday, 1, c, "line 5"),
+		entry(time.Friday, 5, b, "line 6"),
+	})
+
+	tests := []struct {
+		name     string
+		expected string
+		got      string
+	}{
+		{"monday 1st", "A", ts.Mon()._1.classes[0].subject},
+		{"wednesday 3rd", "C", ts.Wed()._3.classes[0].subject},
+		{"friday 5th", "B", ts.Fri()._5.classes[0].subject},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expected != test.got {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, test.got)
+			}
+		})
+	}
+
+	if !ts.Tue()._1.IsNoClass() {
+		t.Fatalf("tuesday 1st should be no class")
+	}
+
+	if len(conflicts) != 1 {
+		t.Fatalf("expected: %v; got: %v\n", 1, len(conflicts))
+	}
+	sources := make([]string, 0)
+	for _, e := range conflicts[0].Entries() {
+		sources = append(sources, e.Source())
+	}
+	if expected := "[line 1 line 4 line 5]"; fmt.Sprint(sources) != expected {
+		t.Fatalf("expected: %v; got: %v\n", expected, sources)
+	}
+}
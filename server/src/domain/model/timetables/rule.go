@@ -0,0 +1,142 @@
+package timetables
+
+import (
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+type Weeks int
+
+const (
+	EveryWeek Weeks = iota
+	OddWeeks
+	EvenWeeks
+	OnDates
+)
+
+var (
+	InvalidWeeks = errs.Invalid("invalid_weeks", "invalid weeks")
+	NoDates      = errs.Invalid("no_dates", "dates are required")
+	TooManyDates = errs.Invalid("too_many_dates", "too many dates")
+)
+
+// MaxDates is the most dates a rule takes place on, as many as the 510 characters
+// stored for the dates of a class hold as "2006-01-02" joined by commas.
+const MaxDates = 46
+
+var weeksNames = map[Weeks]string{
+	EveryWeek: "every",
+	OddWeeks:  "odd",
+	EvenWeeks: "even",
+	OnDates:   "dates",
+}
+
+func (w Weeks) String() string {
+	return weeksNames[w]
+}
+
+func ParseWeeks(s string) (Weeks, error) {
+	for w, name := range weeksNames {
+		if name == s {
+			return w, nil
+		}
+	}
+
+	return EveryWeek, InvalidWeeks
+}
+
+// Rule decides on which weeks a class takes place.
+// Weeks are counted from the week containing the start of the term, which is week 1.
+type Rule struct {
+	weeks Weeks
+	dates []time.Time
+}
+
+func Every() Rule {
+	return Rule{weeks: EveryWeek}
+}
+
+func Odd() Rule {
+	return Rule{weeks: OddWeeks}
+}
+
+func Even() Rule {
+	return Rule{weeks: EvenWeeks}
+}
+
+// Only returns the rule of a class taking place on the dates, counting the same day once.
+func Only(dates []time.Time) (Rule, error) {
+	if len(dates) == 0 {
+		return Rule{}, NoDates
+	}
+
+	ds := make([]time.Time, 0, len(dates))
+	seen := map[time.Time]bool{}
+	for _, d := range dates {
+		day := truncate(d)
+		if seen[day] {
+			continue
+		}
+		seen[day] = true
+		ds = append(ds, day)
+	}
+	if len(ds) > MaxDates {
+		return Rule{}, TooManyDates
+	}
+
+	return Rule{OnDates, ds}, nil
+}
+
+func (r Rule) Weeks() Weeks {
+	return r.weeks
+}
+
+func (r Rule) Dates() []time.Time {
+	return r.dates
+}
+
+// Applies reports whether the rule holds on date d in the given week of the term.
+func (r Rule) Applies(week int, d time.Time) bool {
+	switch r.weeks {
+	case OddWeeks:
+		return week%2 != 0
+	case EvenWeeks:
+		return week%2 == 0
+	case OnDates:
+		day := truncate(d)
+		for _, date := range r.dates {
+			if date.Equal(day) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+// WeekOf numbers the Monday-based week containing d, where the week containing start is 1.
+// Dates before the term fall in week 0 or below.
+func WeekOf(start, d time.Time) int {
+	days := int(Monday(d).Sub(Monday(start)).Hours() / 24)
+	return days/7 + 1
+}
+
+// FirstISOWeek returns a day of the first ISO week of the year containing d,
+// so that weeks without terms are counted by ISO week numbers.
+func FirstISOWeek(d time.Time) time.Time {
+	year, _ := d.ISOWeek()
+	return time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+}
+
+// Monday returns the Monday of the week containing d.
+func Monday(d time.Time) time.Time {
+	day := truncate(d)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+func truncate(d time.Time) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+}
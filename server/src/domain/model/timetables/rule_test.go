@@ -0,0 +1,114 @@
+package timetables
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseWeeks(t *testing.T) {
+	for _, w := range []Weeks{EveryWeek, OddWeeks, EvenWeeks, OnDates} {
+		t.Run(w.String(), func(t *testing.T) {
+			v, err := ParseWeeks(w.String())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v != w {
+				t.Fatalf("expected: %v; got: %v\n", w, v)
+			}
+		})
+	}
+
+	if _, err := ParseWeeks("monthly"); err == nil {
+		t.Fatal("expected error but got nil")
+	}
+}
+
+func TestWeekOf(t *testing.T) {
+	// 2020-04-01 is a Wednesday.
+	start := date(2020, time.April, 1)
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected int
+	}{
+		{"monday of the first week", date(2020, time.March, 30), 1},
+		{"start", start, 1},
+		{"sunday of the first week", date(2020, time.April, 5), 1},
+		{"second week", date(2020, time.April, 6), 2},
+		{"fifth week", date(2020, time.May, 1), 5},
+		{"before the term", date(2020, time.March, 29), 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := WeekOf(start, test.date); got != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestApplies(t *testing.T) {
+	only, _ := Only([]time.Time{date(2020, time.April, 8)})
+	d := date(2020, time.April, 8)
+	tests := []struct {
+		name     string
+		rule     Rule
+		week     int
+		date     time.Time
+		expected bool
+	}{
+		{"every week", Every(), 2, d, true},
+		{"odd weeks on an odd week", Odd(), 3, d, true},
+		{"odd weeks on an even week", Odd(), 2, d, false},
+		{"even weeks on an even week", Even(), 2, d, true},
+		{"even weeks on an odd week", Even(), 1, d, false},
+		{"only on the date", only, 2, d.Add(10 * time.Hour), true},
+		{"only on another date", only, 2, d.AddDate(0, 0, 7), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.rule.Applies(test.week, test.date); got != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestOnly(t *testing.T) {
+	days := func(n int) []time.Time {
+		ds := make([]time.Time, 0, n)
+		for i := 0; i < n; i++ {
+			ds = append(ds, date(2020, time.April, 1).AddDate(0, 0, i))
+		}
+		return ds
+	}
+	tests := []struct {
+		name     string
+		dates    []time.Time
+		expected int
+		err      error
+	}{
+		{"no dates", nil, 0, NoDates},
+		{"most dates", days(MaxDates), MaxDates, nil},
+		{"too many dates", days(MaxDates + 1), 0, TooManyDates},
+		{"same days", append(days(MaxDates), date(2020, time.April, 1).Add(10*time.Hour)), MaxDates, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := Only(test.dates)
+			if err != test.err {
+				t.Fatalf("expected: %v; got: %v\n", test.err, err)
+			}
+			if got := len(r.Dates()); got != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package timetables
+
+import "time"
+
+// Slot is a period of a day. It usually holds a single class taking place
+// every week, but may hold several classes with their own rules,
+// e.g. a lab on odd weeks and a lecture on even weeks.
+type Slot struct {
+	classes []Class
+}
+
+func NewSlot(classes ...Class) Slot {
+	cs := make([]Class, 0, len(classes))
+	for _, c := range classes {
+		if !c.IsNoClass() {
+			cs = append(cs, c)
+		}
+	}
+
+	return Slot{cs}
+}
+
+func EmptySlot() Slot {
+	return NewSlot()
+}
+
+func (s Slot) Classes() []Class {
+	return s.classes
+}
+
+func (s Slot) IsNoClass() bool {
+	return len(s.classes) == 0
+}
+
+// Resolve returns the first class whose rule holds on date d in the given week of the term.
+func (s Slot) Resolve(week int, d time.Time) Class {
+	for _, c := range s.classes {
+		if c.Rule().Applies(week, d) {
+			return c
+		}
+	}
+
+	return NoClass()
+}
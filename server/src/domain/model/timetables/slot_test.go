@@ -0,0 +1,65 @@
+package timetables
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewSlot(t *testing.T) {
+	slot := NewSlot(NoClass())
+	if !slot.IsNoClass() {
+		t.Error("slot of no class should be no class")
+	}
+
+	slot = NewSlot(NewClass("A", "1", ""))
+	if slot.IsNoClass() {
+		t.Error("slot of a class should not be no class")
+	}
+}
+
+func TestSlotResolve(t *testing.T) {
+	slot := NewSlot(
+		NewClass("Lab", "101", "").WithRule(Odd()),
+		NewClass("Lab", "202", "").WithRule(Even()),
+	)
+	d := date(2020, time.April, 8)
+
+	if got := slot.Resolve(1, d).Room(); got != "101" {
+		t.Fatalf("expected: %v; got: %v\n", "101", got)
+	}
+	if got := slot.Resolve(2, d).Room(); got != "202" {
+		t.Fatalf("expected: %v; got: %v\n", "202", got)
+	}
+
+	biweekly := NewSlot(NewClass("Lab", "101", "").WithRule(Odd()))
+	if !biweekly.Resolve(2, d).IsNoClass() {
+		t.Fatal("should be no class on even weeks")
+	}
+}
+
+func TestTimetablesResolve(t *testing.T) {
+	timetables := NewTimetables(
+		NewTimetableOfSlots(
+			NewSlot(NewClass("Lab", "101", "").WithRule(Odd())),
+			EmptySlot(),
+			EmptySlot(),
+			EmptySlot(),
+			EmptySlot(),
+		),
+		Timetable{},
+		Timetable{},
+		Timetable{},
+		Timetable{},
+	)
+	start := date(2020, time.April, 1)
+
+	odd := timetables.Resolve(start, date(2020, time.April, 1))
+	if odd.Mon().First().IsNoClass() {
+		t.Fatal("lab should take place on the first week")
+	}
+
+	even := timetables.Resolve(start, date(2020, time.April, 10))
+	if !even.Mon().First().IsNoClass() {
+		t.Fatal("lab should not take place on the second week")
+	}
+}
@@ -1,33 +1,99 @@
 package timetables
 
+import "time"
+
+const (
+	// Periods is the number of periods of a day.
+	Periods = 5
+)
+
 type Timetable struct {
-	_1 Class
-	_2 Class
-	_3 Class
-	_4 Class
-	_5 Class
+	_1 Slot
+	_2 Slot
+	_3 Slot
+	_4 Slot
+	_5 Slot
 }
 
+// NewTimetable builds a timetable whose classes take place every week.
 func NewTimetable(_1, _2, _3, _4, _5 Class) Timetable {
+	return Timetable{
+		NewSlot(_1),
+		NewSlot(_2),
+		NewSlot(_3),
+		NewSlot(_4),
+		NewSlot(_5),
+	}
+}
+
+func NewTimetableOfSlots(_1, _2, _3, _4, _5 Slot) Timetable {
 	return Timetable{_1, _2, _3, _4, _5}
 }
 
-func (t Timetable) First() Class {
+// EmptyTimetable returns a day without classes.
+func EmptyTimetable() Timetable {
+	return NewTimetableOfSlots(EmptySlot(), EmptySlot(), EmptySlot(), EmptySlot(), EmptySlot())
+}
+
+func (t Timetable) First() Slot {
 	return t._1
 }
 
-func (t Timetable) Second() Class {
+func (t Timetable) Second() Slot {
 	return t._2
 }
 
-func (t Timetable) Third() Class {
+func (t Timetable) Third() Slot {
 	return t._3
 }
 
-func (t Timetable) Fourth() Class {
+func (t Timetable) Fourth() Slot {
 	return t._4
 }
 
-func (t Timetable) Fifth() Class {
+func (t Timetable) Fifth() Slot {
 	return t._5
 }
+
+func (t Timetable) Slots() []Slot {
+	return []Slot{t._1, t._2, t._3, t._4, t._5}
+}
+
+// Period returns the slot of the nth period, counted from 1.
+func (t Timetable) Period(n int) (Slot, bool) {
+	if n < 1 || n > Periods {
+		return Slot{}, false
+	}
+
+	return t.Slots()[n-1], true
+}
+
+// WithPeriod returns a copy of the timetable whose nth period is replaced by s.
+func (t Timetable) WithPeriod(n int, s Slot) Timetable {
+	switch n {
+	case 1:
+		t._1 = s
+	case 2:
+		t._2 = s
+	case 3:
+		t._3 = s
+	case 4:
+		t._4 = s
+	case 5:
+		t._5 = s
+	}
+
+	return t
+}
+
+// Resolve returns the timetable of date d, in the given week of the term,
+// in which every slot holds the concrete class taking place that day.
+func (t Timetable) Resolve(week int, d time.Time) Timetable {
+	return NewTimetable(
+		t._1.Resolve(week, d),
+		t._2.Resolve(week, d),
+		t._3.Resolve(week, d),
+		t._4.Resolve(week, d),
+		t._5.Resolve(week, d),
+	)
+}
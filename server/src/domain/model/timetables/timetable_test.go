@@ -25,11 +25,11 @@ func TestNewTimetable(t *testing.T) {
 		got      string
 		expected string
 	}{
-		{timetable._1.subject, _1.subject},
-		{timetable._2.subject, _2.subject},
-		{timetable._3.subject, _3.subject},
-		{timetable._4.subject, _4.subject},
-		{timetable._5.subject, _5.subject},
+		{timetable._1.classes[0].subject, _1.subject},
+		{timetable._2.classes[0].subject, _2.subject},
+		{timetable._3.classes[0].subject, _3.subject},
+		{timetable._4.classes[0].subject, _4.subject},
+		{timetable._5.classes[0].subject, _5.subject},
 	}
 
 	for _, test := range tests {
@@ -44,23 +44,23 @@ func TestNewTimetable(t *testing.T) {
 }
 
 func TestTimetableGetters(t *testing.T) {
-	timetable := Timetable{
+	timetable := NewTimetable(
 		_1,
 		_2,
 		_3,
 		_4,
 		_5,
-	}
+	)
 
 	tests := []struct {
 		got      string
 		expected string
 	}{
-		{timetable.First().subject, _1.subject},
-		{timetable.Second().subject, _2.subject},
-		{timetable.Third().subject, _3.subject},
-		{timetable.Fourth().subject, _4.subject},
-		{timetable.Fifth().subject, _5.subject},
+		{timetable.First().classes[0].subject, _1.subject},
+		{timetable.Second().classes[0].subject, _2.subject},
+		{timetable.Third().classes[0].subject, _3.subject},
+		{timetable.Fourth().classes[0].subject, _4.subject},
+		{timetable.Fifth().classes[0].subject, _5.subject},
 	}
 
 	for _, test := range tests {
@@ -73,3 +73,29 @@ func TestTimetableGetters(t *testing.T) {
 		}
 	}
 }
+
+func TestPeriod(t *testing.T) {
+	timetable := NewTimetable(_1, _2, _3, _4, _5)
+
+	s, ok := timetable.Period(3)
+	if !ok || s.classes[0].subject != _3.subject {
+		t.Fatalf("expected: %v; got: %v\n", _3, s)
+	}
+
+	for _, n := range []int{0, 6} {
+		if _, ok := timetable.Period(n); ok {
+			t.Fatalf("period %v should not exist", n)
+		}
+	}
+}
+
+func TestWithPeriod(t *testing.T) {
+	timetable := NewTimetable(_1, _2, _3, _4, _5).WithPeriod(2, EmptySlot())
+
+	if !timetable.Second().IsNoClass() {
+		t.Fatal("second period should be replaced")
+	}
+	if timetable.First().IsNoClass() {
+		t.Fatal("first period should not be replaced")
+	}
+}
@@ -1,5 +1,7 @@
 package timetables
 
+import "time"
+
 type Timetables struct {
 	mon Timetable
 	tue Timetable
@@ -31,3 +33,36 @@ func (t Timetables) Thu() Timetable {
 func (t Timetables) Fri() Timetable {
 	return t.fri
 }
+
+// Day returns the timetable of a weekday. It returns false on weekends.
+func (t Timetables) Day(w time.Weekday) (Timetable, bool) {
+	switch w {
+	case time.Monday:
+		return t.mon, true
+	case time.Tuesday:
+		return t.tue, true
+	case time.Wednesday:
+		return t.wed, true
+	case time.Thursday:
+		return t.thu, true
+	case time.Friday:
+		return t.fri, true
+	default:
+		return Timetable{}, false
+	}
+}
+
+// Resolve returns the timetables of the week containing d, where start is
+// the first day of the term, with every slot resolved to its concrete class.
+func (t Timetables) Resolve(start, d time.Time) Timetables {
+	week := WeekOf(start, d)
+	mon := Monday(d)
+
+	return NewTimetables(
+		t.mon.Resolve(week, mon),
+		t.tue.Resolve(week, mon.AddDate(0, 0, 1)),
+		t.wed.Resolve(week, mon.AddDate(0, 0, 2)),
+		t.thu.Resolve(week, mon.AddDate(0, 0, 3)),
+		t.fri.Resolve(week, mon.AddDate(0, 0, 4)),
+	)
+}
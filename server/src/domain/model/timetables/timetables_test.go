@@ -5,41 +5,41 @@ import (
 )
 
 var (
-	mon = Timetable{
+	mon = NewTimetable(
 		NoRoom("1", ""),
 		NoClass(),
 		NoClass(),
 		NoClass(),
 		NoClass(),
-	}
-	tue = Timetable{
+	)
+	tue = NewTimetable(
 		NoRoom("2", ""),
 		NoClass(),
 		NoClass(),
 		NoClass(),
 		NoClass(),
-	}
-	wed = Timetable{
+	)
+	wed = NewTimetable(
 		NoRoom("3", ""),
 		NoClass(),
 		NoClass(),
 		NoClass(),
 		NoClass(),
-	}
-	thu = Timetable{
+	)
+	thu = NewTimetable(
 		NoRoom("4", ""),
 		NoClass(),
 		NoClass(),
 		NoClass(),
 		NoClass(),
-	}
-	fri = Timetable{
+	)
+	fri = NewTimetable(
 		NoRoom("5", ""),
 		NoClass(),
 		NoClass(),
 		NoClass(),
 		NoClass(),
-	}
+	)
 )
 
 func TestNewTimetables(t *testing.T) {
@@ -55,11 +55,11 @@ func TestNewTimetables(t *testing.T) {
 		expected string
 		got      string
 	}{
-		{mon._1.subject, timetables.mon._1.subject},
-		{tue._1.subject, timetables.tue._1.subject},
-		{wed._1.subject, timetables.wed._1.subject},
-		{thu._1.subject, timetables.thu._1.subject},
-		{fri._1.subject, timetables.fri._1.subject},
+		{mon._1.classes[0].subject, timetables.mon._1.classes[0].subject},
+		{tue._1.classes[0].subject, timetables.tue._1.classes[0].subject},
+		{wed._1.classes[0].subject, timetables.wed._1.classes[0].subject},
+		{thu._1.classes[0].subject, timetables.thu._1.classes[0].subject},
+		{fri._1.classes[0].subject, timetables.fri._1.classes[0].subject},
 	}
 
 	for _, test := range tests {
@@ -86,11 +86,11 @@ func TestTimetablesGetters(t *testing.T) {
 		got      string
 		expected string
 	}{
-		{timetables.Mon()._1.subject, mon._1.subject},
-		{timetables.Tue()._1.subject, tue._1.subject},
-		{timetables.Wed()._1.subject, wed._1.subject},
-		{timetables.Thu()._1.subject, thu._1.subject},
-		{timetables.Fri()._1.subject, fri._1.subject},
+		{timetables.Mon()._1.classes[0].subject, mon._1.classes[0].subject},
+		{timetables.Tue()._1.classes[0].subject, tue._1.classes[0].subject},
+		{timetables.Wed()._1.classes[0].subject, wed._1.classes[0].subject},
+		{timetables.Thu()._1.classes[0].subject, thu._1.classes[0].subject},
+		{timetables.Fri()._1.classes[0].subject, fri._1.classes[0].subject},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,27 @@
+package timetables
+
+import "time"
+
+// Version is the timetables of a term as they were saved at a time.
+// Versions are numbered from 1 in the order they were saved.
+type Version struct {
+	number     int
+	savedAt    time.Time
+	timetables Timetables
+}
+
+func NewVersion(number int, savedAt time.Time, timetables Timetables) Version {
+	return Version{number, savedAt, timetables}
+}
+
+func (v Version) Number() int {
+	return v.number
+}
+
+func (v Version) SavedAt() time.Time {
+	return v.savedAt
+}
+
+func (v Version) Timetables() Timetables {
+	return v.timetables
+}
@@ -1,6 +1,8 @@
 package credential
 
 import (
+	"time"
+
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
 )
@@ -8,10 +10,17 @@ import (
 type Auth struct {
 	username username.Username
 	token    token.Token
+	expires  time.Time
 }
 
+// NewAuth makes a credential which never expires.
 func NewAuth(u username.Username, t token.Token) Auth {
-	return Auth{u, t}
+	return Auth{u, t, time.Time{}}
+}
+
+// NewExpiringAuth makes a credential which expires at expires. A zero expires means it never expires.
+func NewExpiringAuth(u username.Username, t token.Token, expires time.Time) Auth {
+	return Auth{u, t, expires}
 }
 
 func (a Auth) Username() username.Username {
@@ -21,3 +30,12 @@ func (a Auth) Username() username.Username {
 func (a Auth) Token() token.Token {
 	return a.token
 }
+
+// Expires returns the moment the credential expires, or false if it never does.
+func (a Auth) Expires() (time.Time, bool) {
+	return a.expires, !a.expires.IsZero()
+}
+
+func (a Auth) IsExpired(now time.Time) bool {
+	return !a.expires.IsZero() && !now.Before(a.expires)
+}
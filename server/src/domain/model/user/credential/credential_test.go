@@ -0,0 +1,34 @@
+package credential
+
+import (
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+func TestIsExpired(t *testing.T) {
+	u, _ := username.NewUsername("user")
+	expires := time.Date(2020, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		auth     Auth
+		now      time.Time
+		expected bool
+	}{
+		{"never expires", NewAuth(u, token.NewToken("123")), expires.AddDate(10, 0, 0), false},
+		{"before expiry", NewExpiringAuth(u, token.NewToken("123"), expires), expires.Add(-time.Second), false},
+		{"at expiry", NewExpiringAuth(u, token.NewToken("123"), expires), expires, true},
+		{"after expiry", NewExpiringAuth(u, token.NewToken("123"), expires), expires.Add(time.Second), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if e := test.auth.IsExpired(test.now); e != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, e)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package language
+
+import (
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+var InvalidLanguage = errs.Invalid("invalid_language", "language must be en or ja")
+
+// Language is a language which messages are shown in, identified by its ISO 639-1 code.
+type Language struct {
+	code string
+}
+
+var (
+	English  = Language{"en"}
+	Japanese = Language{"ja"}
+)
+
+// Supported are the languages in order of preference when none is requested.
+var Supported = []Language{English, Japanese}
+
+func NewLanguage(code string) (Language, error) {
+	for _, l := range Supported {
+		if l.code == code {
+			return l, nil
+		}
+	}
+
+	return Language{}, InvalidLanguage
+}
+
+func (l Language) Code() string {
+	return l.code
+}
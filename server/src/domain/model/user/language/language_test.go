@@ -0,0 +1,27 @@
+package language
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewLanguage(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected Language
+		err      error
+	}{
+		{"en", English, nil},
+		{"ja", Japanese, nil},
+		{"JA", Language{}, InvalidLanguage},
+		{"fr", Language{}, InvalidLanguage},
+		{"", Language{}, InvalidLanguage},
+	}
+
+	for _, test := range tests {
+		l, err := NewLanguage(test.code)
+		if l != test.expected || !errors.Is(err, test.err) {
+			t.Fatalf("expected: %v %v; got: %v %v\n", test.expected, test.err, l, err)
+		}
+	}
+}
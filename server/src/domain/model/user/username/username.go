@@ -1,18 +1,18 @@
 package username
 
-import "fmt"
-
-const (
-	InvalidUsername = "invalid empty string"
+import (
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 )
 
+var InvalidUsername = errs.Invalid("invalid_username", "invalid empty string")
+
 type Username struct {
 	name string
 }
 
 func NewUsername(u string) (Username, error) {
 	if u == "" {
-		return Username{}, fmt.Errorf(InvalidUsername)
+		return Username{}, InvalidUsername
 	}
 	return Username{u}, nil
 }
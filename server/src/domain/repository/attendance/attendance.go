@@ -0,0 +1,21 @@
+package attendance
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/attendance"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type IAttendanceRepository interface {
+	Set(context.Context, username.Username, attendance.Record) error
+	Exists(context.Context, username.Username, time.Time, int) (bool, error)
+	GetAll(context.Context, username.Username) ([]attendance.Record, error)
+	Remove(context.Context, username.Username, time.Time, int) error
+	RemoveAll(context.Context, username.Username) error
+	SetPolicy(context.Context, username.Username, attendance.Policy) error
+	PolicyExists(context.Context, username.Username) (bool, error)
+	GetPolicy(context.Context, username.Username) (attendance.Policy, error)
+	RemovePolicy(context.Context, username.Username) error
+}
@@ -0,0 +1,15 @@
+package bell
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type IBellRepository interface {
+	Create(context.Context, username.Username, bell.Schedule) error
+	Delete(context.Context, username.Username) error
+	Exists(context.Context, username.Username) (bool, error)
+	Get(context.Context, username.Username) (bell.Schedule, error)
+}
@@ -0,0 +1,15 @@
+package check
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/check"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type ICheckRepository interface {
+	SetLimits(context.Context, username.Username, check.Limits) error
+	LimitsExist(context.Context, username.Username) (bool, error)
+	GetLimits(context.Context, username.Username) (check.Limits, error)
+	RemoveLimits(context.Context, username.Username) error
+}
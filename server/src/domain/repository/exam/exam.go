@@ -0,0 +1,17 @@
+package exam
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type IExamRepository interface {
+	Create(context.Context, username.Username, exam.Exam) error
+	Exists(context.Context, username.Username, int) (bool, error)
+	GetAll(context.Context, username.Username) ([]exam.Exam, error)
+	Update(context.Context, username.Username, exam.Exam) error
+	Remove(context.Context, username.Username, int) error
+	RemoveAll(context.Context, username.Username) error
+}
@@ -0,0 +1,15 @@
+package exception
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type IExceptionRepository interface {
+	Create(context.Context, username.Username, exception.Exception) error
+	GetAll(context.Context, username.Username) ([]exception.Exception, error)
+	Remove(context.Context, username.Username, int) error
+	RemoveAll(context.Context, username.Username) error
+}
@@ -0,0 +1,17 @@
+package feed
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/feed"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type IFeedRepository interface {
+	Append(context.Context, feed.Feed) error
+	Remove(context.Context, username.Username) error
+	Exists(context.Context, token.Token) (bool, error)
+	GetByToken(context.Context, token.Token) (feed.Feed, error)
+	GetByUsername(context.Context, username.Username) (feed.Feed, error)
+}
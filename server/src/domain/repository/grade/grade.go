@@ -0,0 +1,20 @@
+package grade
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/grade"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type IGradeRepository interface {
+	Create(context.Context, username.Username, grade.Score) error
+	Exists(context.Context, username.Username, int) (bool, error)
+	GetAll(context.Context, username.Username) ([]grade.Score, error)
+	Remove(context.Context, username.Username, int) error
+	RemoveAll(context.Context, username.Username) error
+	SetScale(context.Context, username.Username, grade.Scale) error
+	ScaleExists(context.Context, username.Username) (bool, error)
+	GetScale(context.Context, username.Username) (grade.Scale, error)
+	RemoveScale(context.Context, username.Username) error
+}
@@ -0,0 +1,19 @@
+package group
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/group"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type IGroupRepository interface {
+	Create(context.Context, group.Group) error
+	Exists(context.Context, int) (bool, error)
+	Get(context.Context, int) (group.Group, error)
+	GetAll(context.Context, username.Username) ([]group.Group, error)
+	SetMember(context.Context, int, group.Member) error
+	RemoveMember(context.Context, int, username.Username) error
+	Remove(context.Context, int) error
+	RemoveAll(context.Context, username.Username) error
+}
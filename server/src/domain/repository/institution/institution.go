@@ -0,0 +1,18 @@
+package institution
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/institution"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type IInstitutionRepository interface {
+	Create(context.Context, institution.Institution) error
+	Exists(context.Context, int) (bool, error)
+	Get(context.Context, int) (institution.Institution, error)
+	GetAll(context.Context, username.Username) ([]institution.Institution, error)
+	SetMember(context.Context, int, institution.Member) error
+	RemoveMember(context.Context, int, username.Username) error
+	Remove(context.Context, int) error
+}
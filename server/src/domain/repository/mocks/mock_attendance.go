@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attendance\attendance.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	attendance "github.com/team-gleam/kiwi-basket/server/src/domain/model/attendance"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockIAttendanceRepository is a mock of IAttendanceRepository interface.
+type MockIAttendanceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAttendanceRepositoryMockRecorder
+}
+
+// MockIAttendanceRepositoryMockRecorder is the mock recorder for MockIAttendanceRepository.
+type MockIAttendanceRepositoryMockRecorder struct {
+	mock *MockIAttendanceRepository
+}
+
+// NewMockIAttendanceRepository creates a new mock instance.
+func NewMockIAttendanceRepository(ctrl *gomock.Controller) *MockIAttendanceRepository {
+	mock := &MockIAttendanceRepository{ctrl: ctrl}
+	mock.recorder = &MockIAttendanceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAttendanceRepository) EXPECT() *MockIAttendanceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockIAttendanceRepository) Exists(arg0 context.Context, arg1 username.Username, arg2 time.Time, arg3 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIAttendanceRepositoryMockRecorder) Exists(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIAttendanceRepository)(nil).Exists), arg0, arg1, arg2, arg3)
+}
+
+// GetAll mocks base method.
+func (m *MockIAttendanceRepository) GetAll(arg0 context.Context, arg1 username.Username) ([]attendance.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]attendance.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIAttendanceRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIAttendanceRepository)(nil).GetAll), arg0, arg1)
+}
+
+// GetPolicy mocks base method.
+func (m *MockIAttendanceRepository) GetPolicy(arg0 context.Context, arg1 username.Username) (attendance.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicy", arg0, arg1)
+	ret0, _ := ret[0].(attendance.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicy indicates an expected call of GetPolicy.
+func (mr *MockIAttendanceRepositoryMockRecorder) GetPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockIAttendanceRepository)(nil).GetPolicy), arg0, arg1)
+}
+
+// PolicyExists mocks base method.
+func (m *MockIAttendanceRepository) PolicyExists(arg0 context.Context, arg1 username.Username) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PolicyExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PolicyExists indicates an expected call of PolicyExists.
+func (mr *MockIAttendanceRepositoryMockRecorder) PolicyExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PolicyExists", reflect.TypeOf((*MockIAttendanceRepository)(nil).PolicyExists), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockIAttendanceRepository) Remove(arg0 context.Context, arg1 username.Username, arg2 time.Time, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIAttendanceRepositoryMockRecorder) Remove(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIAttendanceRepository)(nil).Remove), arg0, arg1, arg2, arg3)
+}
+
+// RemoveAll mocks base method.
+func (m *MockIAttendanceRepository) RemoveAll(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAll indicates an expected call of RemoveAll.
+func (mr *MockIAttendanceRepositoryMockRecorder) RemoveAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAll", reflect.TypeOf((*MockIAttendanceRepository)(nil).RemoveAll), arg0, arg1)
+}
+
+// RemovePolicy mocks base method.
+func (m *MockIAttendanceRepository) RemovePolicy(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePolicy indicates an expected call of RemovePolicy.
+func (mr *MockIAttendanceRepositoryMockRecorder) RemovePolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePolicy", reflect.TypeOf((*MockIAttendanceRepository)(nil).RemovePolicy), arg0, arg1)
+}
+
+// Set mocks base method.
+func (m *MockIAttendanceRepository) Set(arg0 context.Context, arg1 username.Username, arg2 attendance.Record) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockIAttendanceRepositoryMockRecorder) Set(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockIAttendanceRepository)(nil).Set), arg0, arg1, arg2)
+}
+
+// SetPolicy mocks base method.
+func (m *MockIAttendanceRepository) SetPolicy(arg0 context.Context, arg1 username.Username, arg2 attendance.Policy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPolicy indicates an expected call of SetPolicy.
+func (mr *MockIAttendanceRepositoryMockRecorder) SetPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPolicy", reflect.TypeOf((*MockIAttendanceRepository)(nil).SetPolicy), arg0, arg1, arg2)
+}
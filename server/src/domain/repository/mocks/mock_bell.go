@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: bell\bell.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	bell "github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockIBellRepository is a mock of IBellRepository interface.
+type MockIBellRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBellRepositoryMockRecorder
+}
+
+// MockIBellRepositoryMockRecorder is the mock recorder for MockIBellRepository.
+type MockIBellRepositoryMockRecorder struct {
+	mock *MockIBellRepository
+}
+
+// NewMockIBellRepository creates a new mock instance.
+func NewMockIBellRepository(ctrl *gomock.Controller) *MockIBellRepository {
+	mock := &MockIBellRepository{ctrl: ctrl}
+	mock.recorder = &MockIBellRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBellRepository) EXPECT() *MockIBellRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIBellRepository) Create(arg0 context.Context, arg1 username.Username, arg2 bell.Schedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIBellRepositoryMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIBellRepository)(nil).Create), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockIBellRepository) Delete(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIBellRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIBellRepository)(nil).Delete), arg0, arg1)
+}
+
+// Exists mocks base method.
+func (m *MockIBellRepository) Exists(arg0 context.Context, arg1 username.Username) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIBellRepositoryMockRecorder) Exists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIBellRepository)(nil).Exists), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockIBellRepository) Get(arg0 context.Context, arg1 username.Username) (bell.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(bell.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIBellRepositoryMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIBellRepository)(nil).Get), arg0, arg1)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: check\check.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	check "github.com/team-gleam/kiwi-basket/server/src/domain/model/check"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockICheckRepository is a mock of ICheckRepository interface.
+type MockICheckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICheckRepositoryMockRecorder
+}
+
+// MockICheckRepositoryMockRecorder is the mock recorder for MockICheckRepository.
+type MockICheckRepositoryMockRecorder struct {
+	mock *MockICheckRepository
+}
+
+// NewMockICheckRepository creates a new mock instance.
+func NewMockICheckRepository(ctrl *gomock.Controller) *MockICheckRepository {
+	mock := &MockICheckRepository{ctrl: ctrl}
+	mock.recorder = &MockICheckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICheckRepository) EXPECT() *MockICheckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetLimits mocks base method.
+func (m *MockICheckRepository) GetLimits(arg0 context.Context, arg1 username.Username) (check.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimits", arg0, arg1)
+	ret0, _ := ret[0].(check.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLimits indicates an expected call of GetLimits.
+func (mr *MockICheckRepositoryMockRecorder) GetLimits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimits", reflect.TypeOf((*MockICheckRepository)(nil).GetLimits), arg0, arg1)
+}
+
+// LimitsExist mocks base method.
+func (m *MockICheckRepository) LimitsExist(arg0 context.Context, arg1 username.Username) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitsExist", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LimitsExist indicates an expected call of LimitsExist.
+func (mr *MockICheckRepositoryMockRecorder) LimitsExist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitsExist", reflect.TypeOf((*MockICheckRepository)(nil).LimitsExist), arg0, arg1)
+}
+
+// RemoveLimits mocks base method.
+func (m *MockICheckRepository) RemoveLimits(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLimits", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveLimits indicates an expected call of RemoveLimits.
+func (mr *MockICheckRepositoryMockRecorder) RemoveLimits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLimits", reflect.TypeOf((*MockICheckRepository)(nil).RemoveLimits), arg0, arg1)
+}
+
+// SetLimits mocks base method.
+func (m *MockICheckRepository) SetLimits(arg0 context.Context, arg1 username.Username, arg2 check.Limits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLimits", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLimits indicates an expected call of SetLimits.
+func (mr *MockICheckRepositoryMockRecorder) SetLimits(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLimits", reflect.TypeOf((*MockICheckRepository)(nil).SetLimits), arg0, arg1, arg2)
+}
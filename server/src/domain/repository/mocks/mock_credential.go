@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -37,74 +38,74 @@ func (m *MockICredentialRepository) EXPECT() *MockICredentialRepositoryMockRecor
 }
 
 // Append mocks base method.
-func (m *MockICredentialRepository) Append(arg0 credential.Auth) error {
+func (m *MockICredentialRepository) Append(arg0 context.Context, arg1 credential.Auth) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Append", arg0)
+	ret := m.ctrl.Call(m, "Append", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Append indicates an expected call of Append.
-func (mr *MockICredentialRepositoryMockRecorder) Append(arg0 interface{}) *gomock.Call {
+func (mr *MockICredentialRepositoryMockRecorder) Append(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockICredentialRepository)(nil).Append), arg0)
-}
-
-// Remove mocks base method.
-func (m *MockICredentialRepository) Remove(arg0 username.Username) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Remove", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Remove indicates an expected call of Remove.
-func (mr *MockICredentialRepositoryMockRecorder) Remove(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockICredentialRepository)(nil).Remove), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockICredentialRepository)(nil).Append), arg0, arg1)
 }
 
 // Exists mocks base method.
-func (m *MockICredentialRepository) Exists(arg0 token.Token) (bool, error) {
+func (m *MockICredentialRepository) Exists(arg0 context.Context, arg1 token.Token) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Exists", arg0)
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Exists indicates an expected call of Exists.
-func (mr *MockICredentialRepositoryMockRecorder) Exists(arg0 interface{}) *gomock.Call {
+func (mr *MockICredentialRepositoryMockRecorder) Exists(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockICredentialRepository)(nil).Exists), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockICredentialRepository)(nil).Exists), arg0, arg1)
 }
 
 // GetByToken mocks base method.
-func (m *MockICredentialRepository) GetByToken(arg0 token.Token) (credential.Auth, error) {
+func (m *MockICredentialRepository) GetByToken(arg0 context.Context, arg1 token.Token) (credential.Auth, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByToken", arg0)
+	ret := m.ctrl.Call(m, "GetByToken", arg0, arg1)
 	ret0, _ := ret[0].(credential.Auth)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByToken indicates an expected call of GetByToken.
-func (mr *MockICredentialRepositoryMockRecorder) GetByToken(arg0 interface{}) *gomock.Call {
+func (mr *MockICredentialRepositoryMockRecorder) GetByToken(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockICredentialRepository)(nil).GetByToken), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockICredentialRepository)(nil).GetByToken), arg0, arg1)
 }
 
 // GetByUsername mocks base method.
-func (m *MockICredentialRepository) GetByUsername(arg0 username.Username) (credential.Auth, error) {
+func (m *MockICredentialRepository) GetByUsername(arg0 context.Context, arg1 username.Username) (credential.Auth, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUsername", arg0)
+	ret := m.ctrl.Call(m, "GetByUsername", arg0, arg1)
 	ret0, _ := ret[0].(credential.Auth)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByUsername indicates an expected call of GetByUsername.
-func (mr *MockICredentialRepositoryMockRecorder) GetByUsername(arg0 interface{}) *gomock.Call {
+func (mr *MockICredentialRepositoryMockRecorder) GetByUsername(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockICredentialRepository)(nil).GetByUsername), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockICredentialRepository) Remove(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockICredentialRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockICredentialRepository)(nil).GetByUsername), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockICredentialRepository)(nil).Remove), arg0, arg1)
 }
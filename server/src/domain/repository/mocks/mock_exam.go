@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: exam\exam.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	exam "github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockIExamRepository is a mock of IExamRepository interface.
+type MockIExamRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExamRepositoryMockRecorder
+}
+
+// MockIExamRepositoryMockRecorder is the mock recorder for MockIExamRepository.
+type MockIExamRepositoryMockRecorder struct {
+	mock *MockIExamRepository
+}
+
+// NewMockIExamRepository creates a new mock instance.
+func NewMockIExamRepository(ctrl *gomock.Controller) *MockIExamRepository {
+	mock := &MockIExamRepository{ctrl: ctrl}
+	mock.recorder = &MockIExamRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExamRepository) EXPECT() *MockIExamRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIExamRepository) Create(arg0 context.Context, arg1 username.Username, arg2 exam.Exam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIExamRepositoryMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIExamRepository)(nil).Create), arg0, arg1, arg2)
+}
+
+// Exists mocks base method.
+func (m *MockIExamRepository) Exists(arg0 context.Context, arg1 username.Username, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIExamRepositoryMockRecorder) Exists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIExamRepository)(nil).Exists), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockIExamRepository) GetAll(arg0 context.Context, arg1 username.Username) ([]exam.Exam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]exam.Exam)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIExamRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIExamRepository)(nil).GetAll), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockIExamRepository) Remove(arg0 context.Context, arg1 username.Username, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIExamRepositoryMockRecorder) Remove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIExamRepository)(nil).Remove), arg0, arg1, arg2)
+}
+
+// RemoveAll mocks base method.
+func (m *MockIExamRepository) RemoveAll(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAll indicates an expected call of RemoveAll.
+func (mr *MockIExamRepositoryMockRecorder) RemoveAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAll", reflect.TypeOf((*MockIExamRepository)(nil).RemoveAll), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockIExamRepository) Update(arg0 context.Context, arg1 username.Username, arg2 exam.Exam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIExamRepositoryMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIExamRepository)(nil).Update), arg0, arg1, arg2)
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: exception\exception.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	exception "github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockIExceptionRepository is a mock of IExceptionRepository interface.
+type MockIExceptionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExceptionRepositoryMockRecorder
+}
+
+// MockIExceptionRepositoryMockRecorder is the mock recorder for MockIExceptionRepository.
+type MockIExceptionRepositoryMockRecorder struct {
+	mock *MockIExceptionRepository
+}
+
+// NewMockIExceptionRepository creates a new mock instance.
+func NewMockIExceptionRepository(ctrl *gomock.Controller) *MockIExceptionRepository {
+	mock := &MockIExceptionRepository{ctrl: ctrl}
+	mock.recorder = &MockIExceptionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExceptionRepository) EXPECT() *MockIExceptionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIExceptionRepository) Create(arg0 context.Context, arg1 username.Username, arg2 exception.Exception) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIExceptionRepositoryMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIExceptionRepository)(nil).Create), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockIExceptionRepository) GetAll(arg0 context.Context, arg1 username.Username) ([]exception.Exception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]exception.Exception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIExceptionRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIExceptionRepository)(nil).GetAll), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockIExceptionRepository) Remove(arg0 context.Context, arg1 username.Username, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIExceptionRepositoryMockRecorder) Remove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIExceptionRepository)(nil).Remove), arg0, arg1, arg2)
+}
+
+// RemoveAll mocks base method.
+func (m *MockIExceptionRepository) RemoveAll(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAll indicates an expected call of RemoveAll.
+func (mr *MockIExceptionRepositoryMockRecorder) RemoveAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAll", reflect.TypeOf((*MockIExceptionRepository)(nil).RemoveAll), arg0, arg1)
+}
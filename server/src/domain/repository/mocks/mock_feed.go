@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: feed\feed.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	feed "github.com/team-gleam/kiwi-basket/server/src/domain/model/feed"
+	token "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockIFeedRepository is a mock of IFeedRepository interface.
+type MockIFeedRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFeedRepositoryMockRecorder
+}
+
+// MockIFeedRepositoryMockRecorder is the mock recorder for MockIFeedRepository.
+type MockIFeedRepositoryMockRecorder struct {
+	mock *MockIFeedRepository
+}
+
+// NewMockIFeedRepository creates a new mock instance.
+func NewMockIFeedRepository(ctrl *gomock.Controller) *MockIFeedRepository {
+	mock := &MockIFeedRepository{ctrl: ctrl}
+	mock.recorder = &MockIFeedRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFeedRepository) EXPECT() *MockIFeedRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockIFeedRepository) Append(arg0 context.Context, arg1 feed.Feed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockIFeedRepositoryMockRecorder) Append(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockIFeedRepository)(nil).Append), arg0, arg1)
+}
+
+// Exists mocks base method.
+func (m *MockIFeedRepository) Exists(arg0 context.Context, arg1 token.Token) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIFeedRepositoryMockRecorder) Exists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIFeedRepository)(nil).Exists), arg0, arg1)
+}
+
+// GetByToken mocks base method.
+func (m *MockIFeedRepository) GetByToken(arg0 context.Context, arg1 token.Token) (feed.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByToken", arg0, arg1)
+	ret0, _ := ret[0].(feed.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByToken indicates an expected call of GetByToken.
+func (mr *MockIFeedRepositoryMockRecorder) GetByToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockIFeedRepository)(nil).GetByToken), arg0, arg1)
+}
+
+// GetByUsername mocks base method.
+func (m *MockIFeedRepository) GetByUsername(arg0 context.Context, arg1 username.Username) (feed.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUsername", arg0, arg1)
+	ret0, _ := ret[0].(feed.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUsername indicates an expected call of GetByUsername.
+func (mr *MockIFeedRepositoryMockRecorder) GetByUsername(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockIFeedRepository)(nil).GetByUsername), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockIFeedRepository) Remove(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIFeedRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIFeedRepository)(nil).Remove), arg0, arg1)
+}
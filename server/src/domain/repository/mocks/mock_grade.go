@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: grade\grade.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grade "github.com/team-gleam/kiwi-basket/server/src/domain/model/grade"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockIGradeRepository is a mock of IGradeRepository interface.
+type MockIGradeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGradeRepositoryMockRecorder
+}
+
+// MockIGradeRepositoryMockRecorder is the mock recorder for MockIGradeRepository.
+type MockIGradeRepositoryMockRecorder struct {
+	mock *MockIGradeRepository
+}
+
+// NewMockIGradeRepository creates a new mock instance.
+func NewMockIGradeRepository(ctrl *gomock.Controller) *MockIGradeRepository {
+	mock := &MockIGradeRepository{ctrl: ctrl}
+	mock.recorder = &MockIGradeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGradeRepository) EXPECT() *MockIGradeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIGradeRepository) Create(arg0 context.Context, arg1 username.Username, arg2 grade.Score) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIGradeRepositoryMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIGradeRepository)(nil).Create), arg0, arg1, arg2)
+}
+
+// Exists mocks base method.
+func (m *MockIGradeRepository) Exists(arg0 context.Context, arg1 username.Username, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIGradeRepositoryMockRecorder) Exists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIGradeRepository)(nil).Exists), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockIGradeRepository) GetAll(arg0 context.Context, arg1 username.Username) ([]grade.Score, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]grade.Score)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIGradeRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIGradeRepository)(nil).GetAll), arg0, arg1)
+}
+
+// GetScale mocks base method.
+func (m *MockIGradeRepository) GetScale(arg0 context.Context, arg1 username.Username) (grade.Scale, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScale", arg0, arg1)
+	ret0, _ := ret[0].(grade.Scale)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScale indicates an expected call of GetScale.
+func (mr *MockIGradeRepositoryMockRecorder) GetScale(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScale", reflect.TypeOf((*MockIGradeRepository)(nil).GetScale), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockIGradeRepository) Remove(arg0 context.Context, arg1 username.Username, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIGradeRepositoryMockRecorder) Remove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIGradeRepository)(nil).Remove), arg0, arg1, arg2)
+}
+
+// RemoveAll mocks base method.
+func (m *MockIGradeRepository) RemoveAll(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAll indicates an expected call of RemoveAll.
+func (mr *MockIGradeRepositoryMockRecorder) RemoveAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAll", reflect.TypeOf((*MockIGradeRepository)(nil).RemoveAll), arg0, arg1)
+}
+
+// RemoveScale mocks base method.
+func (m *MockIGradeRepository) RemoveScale(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveScale", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveScale indicates an expected call of RemoveScale.
+func (mr *MockIGradeRepositoryMockRecorder) RemoveScale(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveScale", reflect.TypeOf((*MockIGradeRepository)(nil).RemoveScale), arg0, arg1)
+}
+
+// ScaleExists mocks base method.
+func (m *MockIGradeRepository) ScaleExists(arg0 context.Context, arg1 username.Username) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleExists indicates an expected call of ScaleExists.
+func (mr *MockIGradeRepositoryMockRecorder) ScaleExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleExists", reflect.TypeOf((*MockIGradeRepository)(nil).ScaleExists), arg0, arg1)
+}
+
+// SetScale mocks base method.
+func (m *MockIGradeRepository) SetScale(arg0 context.Context, arg1 username.Username, arg2 grade.Scale) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScale", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetScale indicates an expected call of SetScale.
+func (mr *MockIGradeRepositoryMockRecorder) SetScale(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScale", reflect.TypeOf((*MockIGradeRepository)(nil).SetScale), arg0, arg1, arg2)
+}
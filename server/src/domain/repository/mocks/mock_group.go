@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: group\group.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	group "github.com/team-gleam/kiwi-basket/server/src/domain/model/group"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockIGroupRepository is a mock of IGroupRepository interface.
+type MockIGroupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGroupRepositoryMockRecorder
+}
+
+// MockIGroupRepositoryMockRecorder is the mock recorder for MockIGroupRepository.
+type MockIGroupRepositoryMockRecorder struct {
+	mock *MockIGroupRepository
+}
+
+// NewMockIGroupRepository creates a new mock instance.
+func NewMockIGroupRepository(ctrl *gomock.Controller) *MockIGroupRepository {
+	mock := &MockIGroupRepository{ctrl: ctrl}
+	mock.recorder = &MockIGroupRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGroupRepository) EXPECT() *MockIGroupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIGroupRepository) Create(arg0 context.Context, arg1 group.Group) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIGroupRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIGroupRepository)(nil).Create), arg0, arg1)
+}
+
+// Exists mocks base method.
+func (m *MockIGroupRepository) Exists(arg0 context.Context, arg1 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIGroupRepositoryMockRecorder) Exists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIGroupRepository)(nil).Exists), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockIGroupRepository) Get(arg0 context.Context, arg1 int) (group.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(group.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIGroupRepositoryMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIGroupRepository)(nil).Get), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockIGroupRepository) GetAll(arg0 context.Context, arg1 username.Username) ([]group.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]group.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIGroupRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIGroupRepository)(nil).GetAll), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockIGroupRepository) Remove(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIGroupRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIGroupRepository)(nil).Remove), arg0, arg1)
+}
+
+// RemoveAll mocks base method.
+func (m *MockIGroupRepository) RemoveAll(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAll indicates an expected call of RemoveAll.
+func (mr *MockIGroupRepositoryMockRecorder) RemoveAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAll", reflect.TypeOf((*MockIGroupRepository)(nil).RemoveAll), arg0, arg1)
+}
+
+// RemoveMember mocks base method.
+func (m *MockIGroupRepository) RemoveMember(arg0 context.Context, arg1 int, arg2 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockIGroupRepositoryMockRecorder) RemoveMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockIGroupRepository)(nil).RemoveMember), arg0, arg1, arg2)
+}
+
+// SetMember mocks base method.
+func (m *MockIGroupRepository) SetMember(arg0 context.Context, arg1 int, arg2 group.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMember indicates an expected call of SetMember.
+func (mr *MockIGroupRepositoryMockRecorder) SetMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMember", reflect.TypeOf((*MockIGroupRepository)(nil).SetMember), arg0, arg1, arg2)
+}
@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: institution\institution.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	institution "github.com/team-gleam/kiwi-basket/server/src/domain/model/institution"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockIInstitutionRepository is a mock of IInstitutionRepository interface.
+type MockIInstitutionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIInstitutionRepositoryMockRecorder
+}
+
+// MockIInstitutionRepositoryMockRecorder is the mock recorder for MockIInstitutionRepository.
+type MockIInstitutionRepositoryMockRecorder struct {
+	mock *MockIInstitutionRepository
+}
+
+// NewMockIInstitutionRepository creates a new mock instance.
+func NewMockIInstitutionRepository(ctrl *gomock.Controller) *MockIInstitutionRepository {
+	mock := &MockIInstitutionRepository{ctrl: ctrl}
+	mock.recorder = &MockIInstitutionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIInstitutionRepository) EXPECT() *MockIInstitutionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIInstitutionRepository) Create(arg0 context.Context, arg1 institution.Institution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIInstitutionRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIInstitutionRepository)(nil).Create), arg0, arg1)
+}
+
+// Exists mocks base method.
+func (m *MockIInstitutionRepository) Exists(arg0 context.Context, arg1 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIInstitutionRepositoryMockRecorder) Exists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIInstitutionRepository)(nil).Exists), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockIInstitutionRepository) Get(arg0 context.Context, arg1 int) (institution.Institution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(institution.Institution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIInstitutionRepositoryMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIInstitutionRepository)(nil).Get), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockIInstitutionRepository) GetAll(arg0 context.Context, arg1 username.Username) ([]institution.Institution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]institution.Institution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIInstitutionRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIInstitutionRepository)(nil).GetAll), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockIInstitutionRepository) Remove(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIInstitutionRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIInstitutionRepository)(nil).Remove), arg0, arg1)
+}
+
+// RemoveMember mocks base method.
+func (m *MockIInstitutionRepository) RemoveMember(arg0 context.Context, arg1 int, arg2 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockIInstitutionRepositoryMockRecorder) RemoveMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockIInstitutionRepository)(nil).RemoveMember), arg0, arg1, arg2)
+}
+
+// SetMember mocks base method.
+func (m *MockIInstitutionRepository) SetMember(arg0 context.Context, arg1 int, arg2 institution.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMember indicates an expected call of SetMember.
+func (mr *MockIInstitutionRepositoryMockRecorder) SetMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMember", reflect.TypeOf((*MockIInstitutionRepository)(nil).SetMember), arg0, arg1, arg2)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user\language\language.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	language "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockILanguageRepository is a mock of ILanguageRepository interface.
+type MockILanguageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockILanguageRepositoryMockRecorder
+}
+
+// MockILanguageRepositoryMockRecorder is the mock recorder for MockILanguageRepository.
+type MockILanguageRepositoryMockRecorder struct {
+	mock *MockILanguageRepository
+}
+
+// NewMockILanguageRepository creates a new mock instance.
+func NewMockILanguageRepository(ctrl *gomock.Controller) *MockILanguageRepository {
+	mock := &MockILanguageRepository{ctrl: ctrl}
+	mock.recorder = &MockILanguageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILanguageRepository) EXPECT() *MockILanguageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockILanguageRepository) Exists(arg0 context.Context, arg1 username.Username) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockILanguageRepositoryMockRecorder) Exists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockILanguageRepository)(nil).Exists), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockILanguageRepository) Get(arg0 context.Context, arg1 username.Username) (language.Language, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(language.Language)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockILanguageRepositoryMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockILanguageRepository)(nil).Get), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockILanguageRepository) Remove(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockILanguageRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockILanguageRepository)(nil).Remove), arg0, arg1)
+}
+
+// Set mocks base method.
+func (m *MockILanguageRepository) Set(arg0 context.Context, arg1 username.Username, arg2 language.Language) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockILanguageRepositoryMockRecorder) Set(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockILanguageRepository)(nil).Set), arg0, arg1, arg2)
+}
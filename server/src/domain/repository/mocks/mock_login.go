@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -36,59 +37,59 @@ func (m *MockILoginRepository) EXPECT() *MockILoginRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockILoginRepository) Create(arg0 login.Login) error {
+func (m *MockILoginRepository) Create(arg0 context.Context, arg1 login.Login) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockILoginRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockILoginRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockILoginRepository)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockILoginRepository)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method.
-func (m *MockILoginRepository) Delete(arg0 login.Login) error {
+func (m *MockILoginRepository) Delete(arg0 context.Context, arg1 login.Login) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockILoginRepositoryMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+func (mr *MockILoginRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockILoginRepository)(nil).Delete), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockILoginRepository)(nil).Delete), arg0, arg1)
 }
 
 // Exists mocks base method.
-func (m *MockILoginRepository) Exists(arg0 username.Username) (bool, error) {
+func (m *MockILoginRepository) Exists(arg0 context.Context, arg1 username.Username) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Exists", arg0)
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Exists indicates an expected call of Exists.
-func (mr *MockILoginRepositoryMockRecorder) Exists(arg0 interface{}) *gomock.Call {
+func (mr *MockILoginRepositoryMockRecorder) Exists(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockILoginRepository)(nil).Exists), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockILoginRepository)(nil).Exists), arg0, arg1)
 }
 
 // Get mocks base method.
-func (m *MockILoginRepository) Get(arg0 username.Username) (login.Login, error) {
+func (m *MockILoginRepository) Get(arg0 context.Context, arg1 username.Username) (login.Login, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
 	ret0, _ := ret[0].(login.Login)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockILoginRepositoryMockRecorder) Get(arg0 interface{}) *gomock.Call {
+func (mr *MockILoginRepositoryMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockILoginRepository)(nil).Get), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockILoginRepository)(nil).Get), arg0, arg1)
 }
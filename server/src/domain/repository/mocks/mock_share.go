@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: share\share.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	share "github.com/team-gleam/kiwi-basket/server/src/domain/model/share"
+	token "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockIShareRepository is a mock of IShareRepository interface.
+type MockIShareRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIShareRepositoryMockRecorder
+}
+
+// MockIShareRepositoryMockRecorder is the mock recorder for MockIShareRepository.
+type MockIShareRepositoryMockRecorder struct {
+	mock *MockIShareRepository
+}
+
+// NewMockIShareRepository creates a new mock instance.
+func NewMockIShareRepository(ctrl *gomock.Controller) *MockIShareRepository {
+	mock := &MockIShareRepository{ctrl: ctrl}
+	mock.recorder = &MockIShareRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIShareRepository) EXPECT() *MockIShareRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIShareRepository) Create(arg0 context.Context, arg1 share.Share) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIShareRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIShareRepository)(nil).Create), arg0, arg1)
+}
+
+// Exists mocks base method.
+func (m *MockIShareRepository) Exists(arg0 context.Context, arg1 token.Token) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIShareRepositoryMockRecorder) Exists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIShareRepository)(nil).Exists), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockIShareRepository) GetAll(arg0 context.Context, arg1 username.Username) ([]share.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]share.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIShareRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIShareRepository)(nil).GetAll), arg0, arg1)
+}
+
+// GetByToken mocks base method.
+func (m *MockIShareRepository) GetByToken(arg0 context.Context, arg1 token.Token) (share.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByToken", arg0, arg1)
+	ret0, _ := ret[0].(share.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByToken indicates an expected call of GetByToken.
+func (mr *MockIShareRepositoryMockRecorder) GetByToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockIShareRepository)(nil).GetByToken), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockIShareRepository) Remove(arg0 context.Context, arg1 username.Username, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIShareRepositoryMockRecorder) Remove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIShareRepository)(nil).Remove), arg0, arg1, arg2)
+}
+
+// RemoveAll mocks base method.
+func (m *MockIShareRepository) RemoveAll(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAll indicates an expected call of RemoveAll.
+func (mr *MockIShareRepositoryMockRecorder) RemoveAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAll", reflect.TypeOf((*MockIShareRepository)(nil).RemoveAll), arg0, arg1)
+}
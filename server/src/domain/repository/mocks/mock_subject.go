@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: subject\subject.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	subject "github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	username "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// MockISubjectRepository is a mock of ISubjectRepository interface.
+type MockISubjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISubjectRepositoryMockRecorder
+}
+
+// MockISubjectRepositoryMockRecorder is the mock recorder for MockISubjectRepository.
+type MockISubjectRepositoryMockRecorder struct {
+	mock *MockISubjectRepository
+}
+
+// NewMockISubjectRepository creates a new mock instance.
+func NewMockISubjectRepository(ctrl *gomock.Controller) *MockISubjectRepository {
+	mock := &MockISubjectRepository{ctrl: ctrl}
+	mock.recorder = &MockISubjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISubjectRepository) EXPECT() *MockISubjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockISubjectRepository) Create(arg0 context.Context, arg1 username.Username, arg2 subject.Subject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockISubjectRepositoryMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockISubjectRepository)(nil).Create), arg0, arg1, arg2)
+}
+
+// Exists mocks base method.
+func (m *MockISubjectRepository) Exists(arg0 context.Context, arg1 username.Username, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockISubjectRepositoryMockRecorder) Exists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockISubjectRepository)(nil).Exists), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockISubjectRepository) GetAll(arg0 context.Context, arg1 username.Username) ([]subject.Subject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]subject.Subject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockISubjectRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockISubjectRepository)(nil).GetAll), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockISubjectRepository) Remove(arg0 context.Context, arg1 username.Username, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockISubjectRepositoryMockRecorder) Remove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockISubjectRepository)(nil).Remove), arg0, arg1, arg2)
+}
+
+// RemoveAll mocks base method.
+func (m *MockISubjectRepository) RemoveAll(arg0 context.Context, arg1 username.Username) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAll indicates an expected call of RemoveAll.
+func (mr *MockISubjectRepositoryMockRecorder) RemoveAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAll", reflect.TypeOf((*MockISubjectRepository)(nil).RemoveAll), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockISubjectRepository) Update(arg0 context.Context, arg1 username.Username, arg2 subject.Subject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockISubjectRepositoryMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockISubjectRepository)(nil).Update), arg0, arg1, arg2)
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -36,58 +37,58 @@ func (m *MockITaskRepository) EXPECT() *MockITaskRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockITaskRepository) Create(arg0 username.Username, arg1 task.Task) error {
+func (m *MockITaskRepository) Create(arg0 context.Context, arg1 username.Username, arg2 task.Task) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockITaskRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockITaskRepositoryMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITaskRepository)(nil).Create), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITaskRepository)(nil).Create), arg0, arg1, arg2)
 }
 
 // GetAll mocks base method.
-func (m *MockITaskRepository) GetAll(arg0 username.Username) ([]task.Task, error) {
+func (m *MockITaskRepository) GetAll(arg0 context.Context, arg1 username.Username) ([]task.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", arg0)
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
 	ret0, _ := ret[0].([]task.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockITaskRepositoryMockRecorder) GetAll(arg0 interface{}) *gomock.Call {
+func (mr *MockITaskRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockITaskRepository)(nil).GetAll), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockITaskRepository)(nil).GetAll), arg0, arg1)
 }
 
 // Remove mocks base method.
-func (m *MockITaskRepository) Remove(arg0 username.Username, arg1 int) error {
+func (m *MockITaskRepository) Remove(arg0 context.Context, arg1 username.Username, arg2 int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Remove indicates an expected call of Remove.
-func (mr *MockITaskRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockITaskRepositoryMockRecorder) Remove(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockITaskRepository)(nil).Remove), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockITaskRepository)(nil).Remove), arg0, arg1, arg2)
 }
 
 // RemoveAll mocks base method.
-func (m *MockITaskRepository) RemoveAll(arg0 username.Username) error {
+func (m *MockITaskRepository) RemoveAll(arg0 context.Context, arg1 username.Username) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveAll", arg0)
+	ret := m.ctrl.Call(m, "RemoveAll", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveAll indicates an expected call of RemoveAll.
-func (mr *MockITaskRepositoryMockRecorder) RemoveAll(arg0 interface{}) *gomock.Call {
+func (mr *MockITaskRepositoryMockRecorder) RemoveAll(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAll", reflect.TypeOf((*MockITaskRepository)(nil).RemoveAll), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAll", reflect.TypeOf((*MockITaskRepository)(nil).RemoveAll), arg0, arg1)
 }
@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: template\template.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	template "github.com/team-gleam/kiwi-basket/server/src/domain/model/template"
+)
+
+// MockITemplateRepository is a mock of ITemplateRepository interface.
+type MockITemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITemplateRepositoryMockRecorder
+}
+
+// MockITemplateRepositoryMockRecorder is the mock recorder for MockITemplateRepository.
+type MockITemplateRepositoryMockRecorder struct {
+	mock *MockITemplateRepository
+}
+
+// NewMockITemplateRepository creates a new mock instance.
+func NewMockITemplateRepository(ctrl *gomock.Controller) *MockITemplateRepository {
+	mock := &MockITemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockITemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITemplateRepository) EXPECT() *MockITemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITemplateRepository) Create(arg0 context.Context, arg1 template.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITemplateRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITemplateRepository)(nil).Create), arg0, arg1)
+}
+
+// Exists mocks base method.
+func (m *MockITemplateRepository) Exists(arg0 context.Context, arg1 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockITemplateRepositoryMockRecorder) Exists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockITemplateRepository)(nil).Exists), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockITemplateRepository) Get(arg0 context.Context, arg1 int) (template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(template.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockITemplateRepositoryMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockITemplateRepository)(nil).Get), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockITemplateRepository) GetAll(arg0 context.Context, arg1 int) ([]template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]template.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockITemplateRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockITemplateRepository)(nil).GetAll), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockITemplateRepository) Remove(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockITemplateRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockITemplateRepository)(nil).Remove), arg0, arg1)
+}
+
+// RemoveAll mocks base method.
+func (m *MockITemplateRepository) RemoveAll(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAll indicates an expected call of RemoveAll.
+func (mr *MockITemplateRepositoryMockRecorder) RemoveAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAll", reflect.TypeOf((*MockITemplateRepository)(nil).RemoveAll), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockITemplateRepository) Update(arg0 context.Context, arg1 template.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITemplateRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITemplateRepository)(nil).Update), arg0, arg1)
+}
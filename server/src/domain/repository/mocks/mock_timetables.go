@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -36,59 +37,132 @@ func (m *MockITimetablesRepository) EXPECT() *MockITimetablesRepositoryMockRecor
 }
 
 // Create mocks base method.
-func (m *MockITimetablesRepository) Create(arg0 username.Username, arg1 timetables.Timetables) error {
+func (m *MockITimetablesRepository) Create(arg0 context.Context, arg1 username.Username, arg2 int, arg3 timetables.Timetables) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockITimetablesRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockITimetablesRepositoryMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITimetablesRepository)(nil).Create), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITimetablesRepository)(nil).Create), arg0, arg1, arg2, arg3)
 }
 
 // Delete mocks base method.
-func (m *MockITimetablesRepository) Delete(arg0 username.Username) error {
+func (m *MockITimetablesRepository) Delete(arg0 context.Context, arg1 username.Username, arg2 int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockITimetablesRepositoryMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+func (mr *MockITimetablesRepositoryMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITimetablesRepository)(nil).Delete), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITimetablesRepository)(nil).Delete), arg0, arg1, arg2)
+}
+
+// DeleteWithVersions mocks base method.
+func (m *MockITimetablesRepository) DeleteWithVersions(arg0 context.Context, arg1 username.Username, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithVersions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWithVersions indicates an expected call of DeleteWithVersions.
+func (mr *MockITimetablesRepositoryMockRecorder) DeleteWithVersions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithVersions", reflect.TypeOf((*MockITimetablesRepository)(nil).DeleteWithVersions), arg0, arg1, arg2)
 }
 
 // Exists mocks base method.
-func (m *MockITimetablesRepository) Exists(arg0 username.Username) (bool, error) {
+func (m *MockITimetablesRepository) Exists(arg0 context.Context, arg1 username.Username, arg2 int) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Exists", arg0)
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1, arg2)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Exists indicates an expected call of Exists.
-func (mr *MockITimetablesRepositoryMockRecorder) Exists(arg0 interface{}) *gomock.Call {
+func (mr *MockITimetablesRepositoryMockRecorder) Exists(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockITimetablesRepository)(nil).Exists), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockITimetablesRepository)(nil).Exists), arg0, arg1, arg2)
 }
 
 // Get mocks base method.
-func (m *MockITimetablesRepository) Get(arg0 username.Username) (timetables.Timetables, error) {
+func (m *MockITimetablesRepository) Get(arg0 context.Context, arg1 username.Username, arg2 int) (timetables.Timetables, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
 	ret0, _ := ret[0].(timetables.Timetables)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockITimetablesRepositoryMockRecorder) Get(arg0 interface{}) *gomock.Call {
+func (mr *MockITimetablesRepositoryMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockITimetablesRepository)(nil).Get), arg0, arg1, arg2)
+}
+
+// GetVersion mocks base method.
+func (m *MockITimetablesRepository) GetVersion(arg0 context.Context, arg1 username.Username, arg2, arg3 int) (timetables.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(timetables.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockITimetablesRepositoryMockRecorder) GetVersion(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockITimetablesRepository)(nil).GetVersion), arg0, arg1, arg2, arg3)
+}
+
+// GetVersions mocks base method.
+func (m *MockITimetablesRepository) GetVersions(arg0 context.Context, arg1 username.Username, arg2 int) ([]timetables.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]timetables.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersions indicates an expected call of GetVersions.
+func (mr *MockITimetablesRepositoryMockRecorder) GetVersions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockITimetablesRepository)(nil).GetVersions), arg0, arg1, arg2)
+}
+
+// MoveTerm mocks base method.
+func (m *MockITimetablesRepository) MoveTerm(arg0 context.Context, arg1 username.Username, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTerm", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveTerm indicates an expected call of MoveTerm.
+func (mr *MockITimetablesRepositoryMockRecorder) MoveTerm(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTerm", reflect.TypeOf((*MockITimetablesRepository)(nil).MoveTerm), arg0, arg1, arg2, arg3)
+}
+
+// VersionExists mocks base method.
+func (m *MockITimetablesRepository) VersionExists(arg0 context.Context, arg1 username.Username, arg2, arg3 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VersionExists", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VersionExists indicates an expected call of VersionExists.
+func (mr *MockITimetablesRepositoryMockRecorder) VersionExists(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockITimetablesRepository)(nil).Get), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VersionExists", reflect.TypeOf((*MockITimetablesRepository)(nil).VersionExists), arg0, arg1, arg2, arg3)
 }
@@ -0,0 +1,18 @@
+package share
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/share"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type IShareRepository interface {
+	Create(context.Context, share.Share) error
+	GetAll(context.Context, username.Username) ([]share.Share, error)
+	Exists(context.Context, token.Token) (bool, error)
+	GetByToken(context.Context, token.Token) (share.Share, error)
+	Remove(context.Context, username.Username, int) error
+	RemoveAll(context.Context, username.Username) error
+}
@@ -0,0 +1,17 @@
+package subject
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type ISubjectRepository interface {
+	Create(context.Context, username.Username, subject.Subject) error
+	Exists(context.Context, username.Username, int) (bool, error)
+	GetAll(context.Context, username.Username) ([]subject.Subject, error)
+	Update(context.Context, username.Username, subject.Subject) error
+	Remove(context.Context, username.Username, int) error
+	RemoveAll(context.Context, username.Username) error
+}
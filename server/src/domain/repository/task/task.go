@@ -1,13 +1,15 @@
 package task
 
 import (
+	"context"
+
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
 )
 
 type ITaskRepository interface {
-	Create(username.Username, task.Task) error
-	GetAll(username.Username) ([]task.Task, error)
-	Remove(username.Username, int) error
-	RemoveAll(username.Username) error
+	Create(context.Context, username.Username, task.Task) error
+	GetAll(context.Context, username.Username) ([]task.Task, error)
+	Remove(context.Context, username.Username, int) error
+	RemoveAll(context.Context, username.Username) error
 }
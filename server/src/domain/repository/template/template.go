@@ -0,0 +1,17 @@
+package template
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/template"
+)
+
+type ITemplateRepository interface {
+	Create(context.Context, template.Template) error
+	Exists(context.Context, int) (bool, error)
+	Get(context.Context, int) (template.Template, error)
+	GetAll(context.Context, int) ([]template.Template, error)
+	Update(context.Context, template.Template) error
+	Remove(context.Context, int) error
+	RemoveAll(context.Context, int) error
+}
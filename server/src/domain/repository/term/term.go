@@ -0,0 +1,15 @@
+package term
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type ITermRepository interface {
+	Create(context.Context, username.Username, term.Term) error
+	GetAll(context.Context, username.Username) ([]term.Term, error)
+	Remove(context.Context, username.Username, int) error
+	RemoveAll(context.Context, username.Username) error
+}
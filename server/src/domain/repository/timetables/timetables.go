@@ -1,13 +1,25 @@
 package timetables
 
 import (
+	"context"
+
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
 )
 
+// Every method takes the ID of the term the timetables belong to.
+// Timetables registered before a user has any terms use the ID 0.
+// Create also saves the timetables as a new version, which Delete keeps
+// and DeleteWithVersions removes together with the timetables, if any.
+// MoveTerm moves the timetables and their versions from a term to another which has none.
 type ITimetablesRepository interface {
-	Create(username.Username, timetables.Timetables) error
-	Delete(username.Username) error
-	Exists(username.Username) (bool, error)
-	Get(username.Username) (timetables.Timetables, error)
+	Create(context.Context, username.Username, int, timetables.Timetables) error
+	Delete(context.Context, username.Username, int) error
+	Exists(context.Context, username.Username, int) (bool, error)
+	Get(context.Context, username.Username, int) (timetables.Timetables, error)
+	GetVersions(context.Context, username.Username, int) ([]timetables.Version, error)
+	VersionExists(context.Context, username.Username, int, int) (bool, error)
+	GetVersion(context.Context, username.Username, int, int) (timetables.Version, error)
+	DeleteWithVersions(context.Context, username.Username, int) error
+	MoveTerm(context.Context, username.Username, int, int) error
 }
@@ -1,15 +1,17 @@
 package credential
 
 import (
+	"context"
+
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
 )
 
 type ICredentialRepository interface {
-	Append(credential.Auth) error
-	Remove(username.Username) error
-	Exists(token.Token) (bool, error)
-	GetByToken(token.Token) (credential.Auth, error)
-	GetByUsername(username.Username) (credential.Auth, error)
+	Append(context.Context, credential.Auth) error
+	Remove(context.Context, username.Username) error
+	Exists(context.Context, token.Token) (bool, error)
+	GetByToken(context.Context, token.Token) (credential.Auth, error)
+	GetByUsername(context.Context, username.Username) (credential.Auth, error)
 }
@@ -0,0 +1,15 @@
+package language
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+type ILanguageRepository interface {
+	Set(context.Context, username.Username, language.Language) error
+	Exists(context.Context, username.Username) (bool, error)
+	Get(context.Context, username.Username) (language.Language, error)
+	Remove(context.Context, username.Username) error
+}
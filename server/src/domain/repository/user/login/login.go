@@ -1,13 +1,15 @@
 package login
 
 import (
+	"context"
+
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/login"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
 )
 
 type ILoginRepository interface {
-	Create(login.Login) error
-	Delete(login.Login) error
-	Exists(username.Username) (bool, error)
-	Get(username.Username) (login.Login, error)
+	Create(context.Context, login.Login) error
+	Delete(context.Context, login.Login) error
+	Exists(context.Context, username.Username) (bool, error)
+	Get(context.Context, username.Username) (login.Login, error)
 }
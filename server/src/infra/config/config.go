@@ -0,0 +1,204 @@
+// Package config reads the configuration of the server in layers, each of which overrides
+// the settings of the one before: the defaults, the YAML file, the environment variables
+// and the flags of the command line.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultPath is the YAML file read if none is specified. Unlike a file specified, it may be missing.
+	DefaultPath = "./config.yaml"
+	// PathEnv is the environment variable of the YAML file, which the flag -config overrides.
+	PathEnv = "KIWI_CONFIG"
+
+	Redacted = "REDACTED"
+)
+
+// Config is the configuration of the server. The settings of the database are at the top level
+// of the YAML file, as they were before the other settings were added.
+type Config struct {
+	DB       handler.Config `yaml:",inline"`
+	Listen   string         `yaml:"listen"`
+	TLS      TLS            `yaml:"tls"`
+	TokenTTL time.Duration  `yaml:"token_ttl"`
+	LogLevel string         `yaml:"log_level"`
+	// ShutdownTimeout is how long the requests in flight may take to finish after SIGTERM.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// TLS is the certificate and its private key the server listens with over HTTPS, both PEM files.
+type TLS struct {
+	Cert string `yaml:"cert"`
+	Key  string `yaml:"key"`
+}
+
+func (t TLS) Enabled() bool {
+	return t.Cert != "" && t.Key != ""
+}
+
+// LogLevels are the levels of the logs, from the most verbose.
+var LogLevels = []string{"debug", "info", "warn", "error", "off"}
+
+// DefaultShutdownTimeout is shorter than the grace period of 30 seconds, which Kubernetes
+// and Docker give before killing the server.
+const DefaultShutdownTimeout = 20 * time.Second
+
+// Default listens over HTTP on port 80 and issues tokens which never expire.
+func Default() Config {
+	return Config{
+		DB:              handler.Config{Timeout: handler.DefaultTimeout},
+		Listen:          ":80",
+		TokenTTL:        0,
+		LogLevel:        "info",
+		ShutdownTimeout: DefaultShutdownTimeout,
+	}
+}
+
+// Load reads the configuration for the command line args, and returns the args left after the flags,
+// which are the command. getenv looks up the environment variables.
+func Load(args []string, getenv func(string) string) (Config, []string, error) {
+	fs := flag.NewFlagSet("kiwi-basket", flag.ContinueOnError)
+	path := fs.String("config", "", "YAML file of the configuration (env "+PathEnv+", default "+DefaultPath+")")
+
+	// The flags are recorded first, to be applied over the YAML file and the environment variables.
+	flags := make(map[string]string)
+	for _, s := range settings {
+		fs.Var(recorded{flags, s.flag}, s.flag, s.usage+" (env "+s.env+")")
+	}
+	if err := fs.Parse(args); err != nil {
+		return Config{}, nil, err
+	}
+
+	c := Default()
+	if err := c.readFile(*path, getenv(PathEnv)); err != nil {
+		return Config{}, nil, err
+	}
+
+	for _, s := range settings {
+		if v := getenv(s.env); v != "" {
+			if err := s.field(&c).Set(v); err != nil {
+				return Config{}, nil, fmt.Errorf("%s: %v", s.env, err)
+			}
+		}
+	}
+	for _, s := range settings {
+		if v, ok := flags[s.flag]; ok {
+			if err := s.field(&c).Set(v); err != nil {
+				return Config{}, nil, fmt.Errorf("-%s: %v", s.flag, err)
+			}
+		}
+	}
+
+	return c, fs.Args(), nil
+}
+
+func (c *Config) readFile(flagged, env string) error {
+	path := flagged
+	if path == "" {
+		path = env
+	}
+
+	b, err := ioutil.ReadFile(orDefault(path))
+	if os.IsNotExist(err) && path == "" {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = yaml.Unmarshal(b, c); err != nil {
+		return fmt.Errorf("%s: %v", orDefault(path), err)
+	}
+
+	return nil
+}
+
+func orDefault(path string) string {
+	if path == "" {
+		return DefaultPath
+	}
+	return path
+}
+
+// Validate checks the settings, and tells all of the invalid ones.
+func (c Config) Validate() error {
+	problems := make([]string, 0)
+	add := func(key, problem string) {
+		problems = append(problems, key+": "+problem)
+	}
+
+	if _, err := c.DB.DSN(); err != nil {
+		add("dbms", err.Error())
+	}
+	if c.DB.Timeout < 0 {
+		add("timeout", "must not be negative")
+	}
+
+	if _, _, err := net.SplitHostPort(c.Listen); err != nil {
+		add("listen", "must be an address like :80 or 127.0.0.1:8080")
+	}
+
+	if (c.TLS.Cert == "") != (c.TLS.Key == "") {
+		add("tls", "cert and key must be set together")
+	}
+	for _, f := range [][2]string{{"tls.cert", c.TLS.Cert}, {"tls.key", c.TLS.Key}} {
+		if f[1] == "" {
+			continue
+		}
+		if _, err := os.Stat(f[1]); err != nil {
+			add(f[0], "cannot read "+f[1])
+		}
+	}
+
+	if c.TokenTTL < 0 {
+		add("token_ttl", "must not be negative")
+	}
+	if c.ShutdownTimeout <= 0 {
+		add("shutdown_timeout", "must be positive")
+	}
+
+	valid := false
+	for _, l := range LogLevels {
+		valid = valid || c.LogLevel == l
+	}
+	if !valid {
+		add("log_level", "must be one of "+strings.Join(LogLevels, ", "))
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return errors.New("invalid configuration:\n  " + strings.Join(problems, "\n  "))
+}
+
+// Redact returns the configuration whose secrets are replaced with Redacted.
+func (c Config) Redact() Config {
+	if c.DB.Password != "" {
+		c.DB.Password = Redacted
+	}
+
+	return c
+}
+
+// Print writes the configuration as YAML with its secrets redacted.
+func (c Config) Print() (string, error) {
+	b, err := yaml.Marshal(c.Redact())
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+const yamlConfig = `dbms: mysql
+user: root
+password: password
+protocol: tcp(db:3306)
+dbname: kiwi_basket
+listen: :8080
+token_ttl: 24h
+`
+
+func writeConfig(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	path := filepath.Join(dir, "config.yaml")
+	if err = ioutil.WriteFile(path, []byte(yamlConfig), 0600); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func env(vars map[string]string) func(string) string {
+	return func(key string) string {
+		return vars[key]
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path, cleanup := writeConfig(t)
+	defer cleanup()
+
+	t.Run("defaults", func(t *testing.T) {
+		c, args, err := Load(nil, env(nil))
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if c != Default() || len(args) != 0 {
+			t.Fatalf("expected: %v; got: %v %v\n", Default(), c, args)
+		}
+	})
+
+	t.Run("layers", func(t *testing.T) {
+		c, args, err := Load(
+			[]string{"-config", path, "-listen", ":9090", "-db-port", "3307", "migrate", "up"},
+			env(map[string]string{"KIWI_LISTEN": ":8081", "KIWI_DB_PASSWORD": "secret", "KIWI_LOG_LEVEL": "debug", "KIWI_SHUTDOWN_TIMEOUT": "5s"}),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+
+		expected := Config{
+			DB: handler.Config{
+				DBMS:     "mysql",
+				User:     "root",
+				Password: "secret",
+				Protocol: "tcp(db:3306)",
+				Port:     3307,
+				DBName:   "kiwi_basket",
+				Timeout:  handler.DefaultTimeout,
+			},
+			Listen:          ":9090",
+			TokenTTL:        24 * time.Hour,
+			LogLevel:        "debug",
+			ShutdownTimeout: 5 * time.Second,
+		}
+		if c != expected {
+			t.Fatalf("expected: %v; got: %v\n", expected, c)
+		}
+		if strings.Join(args, " ") != "migrate up" {
+			t.Fatalf("expected: %v; got: %v\n", "migrate up", args)
+		}
+	})
+
+	t.Run("file by env", func(t *testing.T) {
+		c, _, err := Load(nil, env(map[string]string{PathEnv: path}))
+		if err != nil || c.DB.DBMS != "mysql" {
+			t.Fatalf("expected: %v; got: %v %v\n", "mysql", c.DB.DBMS, err)
+		}
+	})
+
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+	}{
+		{"missing file", []string{"-config", path + ".missing"}, nil},
+		{"invalid env", nil, map[string]string{"KIWI_DB_TIMEOUT": "five seconds"}},
+		{"invalid flag", []string{"-db-port", "db"}, nil},
+		{"unknown flag", []string{"-port", "80"}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, _, err := Load(test.args, env(test.env)); err == nil {
+				t.Fatalf("expected an error\n")
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := Default()
+	valid.DB = handler.Config{DBMS: handler.SQLite, Path: handler.InMemory}
+
+	tests := []struct {
+		name     string
+		modify   func(*Config)
+		problems []string
+	}{
+		{"valid", func(c *Config) {}, nil},
+		{"dbms", func(c *Config) { c.DB.DBMS = "oracle" }, []string{"dbms: " + handler.UnsupportedDBMS}},
+		{"listen", func(c *Config) { c.Listen = "80" }, []string{"listen:"}},
+		{"tls", func(c *Config) { c.TLS.Cert = "cert.pem" }, []string{"tls: cert and key", "tls.cert: cannot read cert.pem"}},
+		{"durations", func(c *Config) { c.DB.Timeout, c.TokenTTL, c.ShutdownTimeout = -time.Second, -time.Hour, 0 }, []string{"timeout:", "token_ttl:", "shutdown_timeout:"}},
+		{"log level", func(c *Config) { c.LogLevel = "verbose" }, []string{"log_level: must be one of debug, info, warn, error, off"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := valid
+			test.modify(&c)
+
+			err := c.Validate()
+			if test.problems == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v\n", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected: %v; got: nil\n", test.problems)
+			}
+			for _, p := range test.problems {
+				if !strings.Contains(err.Error(), "\n  "+p) {
+					t.Fatalf("expected: %v; got: %v\n", p, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPrint(t *testing.T) {
+	c := Default()
+	c.DB.Password = "secret"
+
+	s, err := c.Print()
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if strings.Contains(s, "secret") || !strings.Contains(s, "password: "+Redacted) || !strings.Contains(s, "timeout: 10s") {
+		t.Fatalf("expected the password to be redacted; got: %v\n", s)
+	}
+	if c.DB.Password != "secret" {
+		t.Fatalf("expected the configuration not to be changed\n")
+	}
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"flag"
+	"strconv"
+	"time"
+)
+
+// setting is a setting which is read from an environment variable and a flag as well as the YAML file.
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	field func(*Config) flag.Value
+}
+
+var settings = []setting{
+	{"KIWI_DBMS", "dbms", "DBMS: mysql, postgres or sqlite3", func(c *Config) flag.Value { return (*stringValue)(&c.DB.DBMS) }},
+	{"KIWI_DB_USER", "db-user", "user of the database", func(c *Config) flag.Value { return (*stringValue)(&c.DB.User) }},
+	{"KIWI_DB_PASSWORD", "db-password", "password of the database", func(c *Config) flag.Value { return (*stringValue)(&c.DB.Password) }},
+	{"KIWI_DB_PROTOCOL", "db-protocol", "protocol of MySQL, like tcp(db:3306)", func(c *Config) flag.Value { return (*stringValue)(&c.DB.Protocol) }},
+	{"KIWI_DB_HOST", "db-host", "host of the database", func(c *Config) flag.Value { return (*stringValue)(&c.DB.Host) }},
+	{"KIWI_DB_PORT", "db-port", "port of the database", func(c *Config) flag.Value { return (*intValue)(&c.DB.Port) }},
+	{"KIWI_DB_NAME", "db-name", "name of the database", func(c *Config) flag.Value { return (*stringValue)(&c.DB.DBName) }},
+	{"KIWI_DB_SSLMODE", "db-sslmode", "sslmode of PostgreSQL", func(c *Config) flag.Value { return (*stringValue)(&c.DB.SSLMode) }},
+	{"KIWI_DB_PATH", "db-path", "database file of SQLite, or :memory:", func(c *Config) flag.Value { return (*stringValue)(&c.DB.Path) }},
+	{"KIWI_DB_TIMEOUT", "db-timeout", "time the queries of a request may take, like 5s", func(c *Config) flag.Value { return (*durationValue)(&c.DB.Timeout) }},
+	{"KIWI_LISTEN", "listen", "address to listen on, like :80", func(c *Config) flag.Value { return (*stringValue)(&c.Listen) }},
+	{"KIWI_TLS_CERT", "tls-cert", "PEM file of the certificate for HTTPS", func(c *Config) flag.Value { return (*stringValue)(&c.TLS.Cert) }},
+	{"KIWI_TLS_KEY", "tls-key", "PEM file of the private key for HTTPS", func(c *Config) flag.Value { return (*stringValue)(&c.TLS.Key) }},
+	{"KIWI_TOKEN_TTL", "token-ttl", "time tokens are valid for, like 720h, or 0 for ever", func(c *Config) flag.Value { return (*durationValue)(&c.TokenTTL) }},
+	{"KIWI_LOG_LEVEL", "log-level", "level of the logs: debug, info, warn, error or off", func(c *Config) flag.Value { return (*stringValue)(&c.LogLevel) }},
+	{"KIWI_SHUTDOWN_TIMEOUT", "shutdown-timeout", "time the requests in flight may take to finish after SIGTERM, like 20s", func(c *Config) flag.Value { return (*durationValue)(&c.ShutdownTimeout) }},
+}
+
+type stringValue string
+
+func (v *stringValue) Set(s string) error {
+	*v = stringValue(s)
+	return nil
+}
+
+func (v *stringValue) String() string {
+	return string(*v)
+}
+
+type intValue int
+
+func (v *intValue) Set(s string) error {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+
+	*v = intValue(i)
+	return nil
+}
+
+func (v *intValue) String() string {
+	return strconv.Itoa(int(*v))
+}
+
+type durationValue time.Duration
+
+func (v *durationValue) Set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*v = durationValue(d)
+	return nil
+}
+
+func (v *durationValue) String() string {
+	return time.Duration(*v).String()
+}
+
+// recorded is a flag whose value is recorded by its name, to be set after the other layers are read.
+type recorded struct {
+	values map[string]string
+	name   string
+}
+
+func (r recorded) Set(s string) error {
+	r.values[r.name] = s
+	return nil
+}
+
+func (r recorded) String() string {
+	return ""
+}
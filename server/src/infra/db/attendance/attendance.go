@@ -0,0 +1,145 @@
+package attendance
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	attendanceModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/attendance"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	attendanceRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/attendance"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type AttendanceRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewAttendanceRepository(h *handler.DbHandler) attendanceRepository.IAttendanceRepository {
+	return &AttendanceRepository{h}
+}
+
+// Attendance is a row of the attendance of a user, unique to a period on a date.
+type Attendance struct {
+	Username  string    `gorm:"primary_key"`
+	Date      time.Time `gorm:"primary_key"`
+	Period    int       `gorm:"primary_key;auto_increment:false"`
+	Status    string
+	SubjectID uint
+	Subject   string
+}
+
+type Policy struct {
+	Username    string `gorm:"primary_key"`
+	Weeks       int
+	Numerator   int
+	Denominator int
+	Lates       int
+	Margin      int
+}
+
+func (p Policy) TableName() string {
+	return "attendance_policies"
+}
+
+func toRecord(r attendanceModel.Record, u username.Username) Attendance {
+	return Attendance{
+		Username:  u.Name(),
+		Date:      r.Date(),
+		Period:    r.Period(),
+		Status:    r.Status().String(),
+		SubjectID: uint(r.SubjectID()),
+		Subject:   r.Subject(),
+	}
+}
+
+func fromRecord(a Attendance) (attendanceModel.Record, error) {
+	status, err := attendanceModel.ParseStatus(a.Status)
+	if err != nil {
+		return attendanceModel.Record{}, err
+	}
+
+	r, err := attendanceModel.NewRecord(a.Date.Format(attendanceModel.Layout), a.Period, status)
+	if err != nil {
+		return attendanceModel.Record{}, err
+	}
+
+	return r.WithSubject(int(a.SubjectID), a.Subject), nil
+}
+
+func (r *AttendanceRepository) Set(ctx context.Context, u username.Username, a attendanceModel.Record) error {
+	d := toRecord(a, u)
+	return r.dbHandler.WithContext(ctx).Save(&d).Error
+}
+
+func (r *AttendanceRepository) Exists(ctx context.Context, u username.Username, date time.Time, period int) (bool, error) {
+	a := Attendance{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ? AND date = ? AND period = ?", u.Name(), date, period).Take(&a).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *AttendanceRepository) GetAll(ctx context.Context, u username.Username) ([]attendanceModel.Record, error) {
+	ds := make([]Attendance, 0)
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Order("date").Order("period").Find(&ds).Error
+	if err != nil {
+		return []attendanceModel.Record{}, err
+	}
+
+	records := make([]attendanceModel.Record, 0)
+	for _, d := range ds {
+		a, err := fromRecord(d)
+		if err != nil {
+			return records, err
+		}
+		records = append(records, a)
+	}
+
+	return records, nil
+}
+
+func (r *AttendanceRepository) Remove(ctx context.Context, u username.Username, date time.Time, period int) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ? AND date = ? AND period = ?", u.Name(), date, period).Delete(Attendance{}).Error
+}
+
+func (r *AttendanceRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Attendance{}).Error
+}
+
+func (r *AttendanceRepository) SetPolicy(ctx context.Context, u username.Username, p attendanceModel.Policy) error {
+	d := Policy{u.Name(), p.Weeks(), p.Numerator(), p.Denominator(), p.Lates(), p.Margin()}
+	return r.dbHandler.WithContext(ctx).Save(&d).Error
+}
+
+func (r *AttendanceRepository) PolicyExists(ctx context.Context, u username.Username) (bool, error) {
+	p := Policy{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(&p).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *AttendanceRepository) GetPolicy(ctx context.Context, u username.Username) (attendanceModel.Policy, error) {
+	p := Policy{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(&p).Error
+	if err != nil {
+		return attendanceModel.Policy{}, err
+	}
+
+	return attendanceModel.NewPolicy(p.Weeks, p.Numerator, p.Denominator, p.Lates, p.Margin)
+}
+
+func (r *AttendanceRepository) RemovePolicy(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Policy{}).Error
+}
@@ -0,0 +1,86 @@
+package bell
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	bellModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type BellRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewBellRepository(h *handler.DbHandler) bellRepository.IBellRepository {
+	return &BellRepository{h}
+}
+
+// Bell stores the periods as comma separated "15:04-15:04" ranges.
+type Bell struct {
+	Username string `gorm:"primary_key"`
+	TimeZone string
+	Periods  string
+}
+
+func toRecord(s bellModel.Schedule, u username.Username) Bell {
+	ps := make([]string, 0, len(s.Periods()))
+	for _, p := range s.Periods() {
+		ps = append(ps, p.TextStart()+"-"+p.TextEnd())
+	}
+
+	return Bell{u.Name(), s.TimeZone(), strings.Join(ps, ",")}
+}
+
+func fromRecord(b Bell) (bellModel.Schedule, error) {
+	ps := make([]bellModel.Period, 0)
+	for _, s := range strings.Split(b.Periods, ",") {
+		times := strings.Split(s, "-")
+		if len(times) != 2 {
+			return bellModel.Schedule{}, bellModel.InvalidTimeFormat
+		}
+
+		p, err := bellModel.NewPeriod(times[0], times[1])
+		if err != nil {
+			return bellModel.Schedule{}, err
+		}
+		ps = append(ps, p)
+	}
+
+	return bellModel.NewSchedule(b.TimeZone, ps)
+}
+
+func (r *BellRepository) Create(ctx context.Context, u username.Username, s bellModel.Schedule) error {
+	d := toRecord(s, u)
+	return r.dbHandler.WithContext(ctx).Create(&d).Error
+}
+
+func (r *BellRepository) Delete(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Bell{}).Error
+}
+
+func (r *BellRepository) Exists(ctx context.Context, u username.Username) (bool, error) {
+	b := Bell{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(&b).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return b != Bell{}, nil
+}
+
+func (r *BellRepository) Get(ctx context.Context, u username.Username) (bellModel.Schedule, error) {
+	b := Bell{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(&b).Error
+	if err != nil {
+		return bellModel.Schedule{}, err
+	}
+
+	return fromRecord(b)
+}
@@ -0,0 +1,61 @@
+package check
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	checkModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/check"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	checkRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/check"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type CheckRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewCheckRepository(h *handler.DbHandler) checkRepository.ICheckRepository {
+	return &CheckRepository{h}
+}
+
+type Limits struct {
+	Username string `gorm:"primary_key"`
+	Slots    int
+	Credits  int
+}
+
+func (l Limits) TableName() string {
+	return "timetable_limits"
+}
+
+func (r *CheckRepository) SetLimits(ctx context.Context, u username.Username, l checkModel.Limits) error {
+	d := Limits{u.Name(), l.Slots(), l.Credits()}
+	return r.dbHandler.WithContext(ctx).Save(&d).Error
+}
+
+func (r *CheckRepository) LimitsExist(ctx context.Context, u username.Username) (bool, error) {
+	l := Limits{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(&l).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *CheckRepository) GetLimits(ctx context.Context, u username.Username) (checkModel.Limits, error) {
+	l := Limits{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(&l).Error
+	if err != nil {
+		return checkModel.Limits{}, err
+	}
+
+	return checkModel.NewLimits(l.Slots, l.Credits)
+}
+
+func (r *CheckRepository) RemoveLimits(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Limits{}).Error
+}
@@ -0,0 +1,168 @@
+package exam
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	examModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+	subjectDb "github.com/team-gleam/kiwi-basket/server/src/infra/db/subject"
+)
+
+type ExamRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewExamRepository(h *handler.DbHandler) examRepository.IExamRepository {
+	return &ExamRepository{h}
+}
+
+// Exam is an exam of a subject. Subject keeps the name of the subject
+// as of the last save, which is shown once the subject is removed.
+type Exam struct {
+	ID        uint   `gorm:"primary_key;auto_increment"`
+	Username  string `gorm:"index"`
+	SubjectID uint
+	Subject   string
+	TermID    uint
+	Title     string
+	Start     time.Time
+	End       time.Time
+	Room      string
+}
+
+func toRecord(e examModel.Exam, u username.Username) Exam {
+	id := uint(e.ID())
+	if e.ID() == -1 {
+		id = 0
+	}
+
+	return Exam{
+		id,
+		u.Name(),
+		uint(e.SubjectID()),
+		e.Subject(),
+		uint(e.TermID()),
+		e.Title(),
+		e.Start().UTC(),
+		e.End().UTC(),
+		e.Room(),
+	}
+}
+
+func fromRecord(e Exam) (examModel.Exam, error) {
+	exam, err := examModel.NewExam(int(e.ID), int(e.SubjectID), int(e.TermID), e.Title, e.Start, e.End, e.Room)
+	if err != nil {
+		return examModel.Exam{}, err
+	}
+
+	return exam.WithSubject(e.Subject), nil
+}
+
+// named sets the name of the subject of the record from the catalog.
+func named(db *gorm.DB, d *Exam) error {
+	s := subjectDb.Subject{}
+	err := db.Where("id = ? AND username = ?", d.SubjectID, d.Username).Take(&s).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	d.Subject = s.Name
+	return nil
+}
+
+func (r *ExamRepository) Create(ctx context.Context, u username.Username, e examModel.Exam) error {
+	d := toRecord(e, u)
+	if err := named(r.dbHandler.WithContext(ctx), &d); err != nil {
+		return err
+	}
+
+	return r.dbHandler.WithContext(ctx).Create(&d).Error
+}
+
+func (r *ExamRepository) Exists(ctx context.Context, u username.Username, id int) (bool, error) {
+	if id < 1 {
+		return false, nil
+	}
+
+	e := Exam{}
+	err := r.dbHandler.WithContext(ctx).Where("id = ? AND username = ?", uint(id), u.Name()).Take(&e).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetAll returns the exams of the user in order of their starts,
+// named after their subjects in the catalog.
+func (r *ExamRepository) GetAll(ctx context.Context, u username.Username) ([]examModel.Exam, error) {
+	ds := make([]Exam, 0)
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Order("start").Find(&ds).Error
+	if err != nil {
+		return []examModel.Exam{}, err
+	}
+
+	ss := make([]subjectDb.Subject, 0)
+	err = r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Find(&ss).Error
+	if err != nil {
+		return []examModel.Exam{}, err
+	}
+	names := make(map[uint]string)
+	for _, s := range ss {
+		names[s.ID] = s.Name
+	}
+
+	exams := make([]examModel.Exam, 0)
+	for _, d := range ds {
+		if name, ok := names[d.SubjectID]; ok {
+			d.Subject = name
+		}
+		e, err := fromRecord(d)
+		if err != nil {
+			return exams, err
+		}
+		exams = append(exams, e)
+	}
+
+	return exams, nil
+}
+
+func (r *ExamRepository) Update(ctx context.Context, u username.Username, e examModel.Exam) error {
+	d := toRecord(e, u)
+	if err := named(r.dbHandler.WithContext(ctx), &d); err != nil {
+		return err
+	}
+
+	return r.dbHandler.WithContext(ctx).Model(Exam{}).Where("id = ? AND username = ?", d.ID, d.Username).Updates(map[string]interface{}{
+		"subject_id": d.SubjectID,
+		"subject":    d.Subject,
+		"term_id":    d.TermID,
+		"title":      d.Title,
+		"start":      d.Start,
+		"end":        d.End,
+		"room":       d.Room,
+	}).Error
+}
+
+func (r *ExamRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	if id < 1 {
+		return errs.Invalid("invalid_id", "invalid id")
+	}
+
+	return r.dbHandler.WithContext(ctx).Where("id = ? AND username = ?", uint(id), u.Name()).Delete(Exam{}).Error
+}
+
+func (r *ExamRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Exam{}).Error
+}
@@ -0,0 +1,127 @@
+package exception
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	exceptionModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type ExceptionRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewExceptionRepository(h *handler.DbHandler) exceptionRepository.IExceptionRepository {
+	return &ExceptionRepository{h}
+}
+
+type Exception struct {
+	ID       uint `gorm:"primary_key;auto_increment"`
+	Username string
+	Kind     string
+	Date     time.Time
+	Period   int
+	Subject  string
+	Room     sql.NullString
+	Memo     string `gorm:"size:510"`
+	ToDate   *time.Time
+	ToPeriod int
+}
+
+func toRecord(e exceptionModel.Exception, u username.Username) Exception {
+	d := Exception{
+		Username: u.Name(),
+		Kind:     e.Kind().String(),
+		Date:     e.Date(),
+		Period:   e.Period(),
+	}
+	if e.ID() != -1 {
+		d.ID = uint(e.ID())
+	}
+
+	switch e.Kind() {
+	case exceptionModel.RoomChanged:
+		d.Room = sql.NullString{String: e.Room(), Valid: true}
+	case exceptionModel.MadeUp:
+		c := e.Class()
+		d.Subject = c.Subject()
+		d.Room = sql.NullString{String: c.Room(), Valid: !c.IsNoRoom()}
+		d.Memo = c.Memo()
+	case exceptionModel.Moved:
+		to := e.ToDate()
+		d.ToDate = &to
+		d.ToPeriod = e.ToPeriod()
+	}
+
+	return d
+}
+
+func fromRecord(e Exception) (exceptionModel.Exception, error) {
+	kind, err := exceptionModel.ParseKind(e.Kind)
+	if err != nil {
+		return exceptionModel.Exception{}, err
+	}
+
+	id := int(e.ID)
+	date := e.Date.Format(exceptionModel.Layout)
+
+	switch kind {
+	case exceptionModel.RoomChanged:
+		return exceptionModel.NewRoomChange(id, date, e.Period, e.Room.String)
+	case exceptionModel.MadeUp:
+		if !e.Room.Valid {
+			return exceptionModel.NewMakeUp(id, date, e.Period, timetablesModel.NoRoom(e.Subject, e.Memo))
+		}
+		return exceptionModel.NewMakeUp(id, date, e.Period, timetablesModel.NewClass(e.Subject, e.Room.String, e.Memo))
+	case exceptionModel.Moved:
+		if e.ToDate == nil {
+			return exceptionModel.Exception{}, fmt.Errorf("moved exception without destination")
+		}
+		return exceptionModel.NewMove(id, date, e.Period, e.ToDate.Format(exceptionModel.Layout), e.ToPeriod)
+	default:
+		return exceptionModel.NewCancellation(id, date, e.Period)
+	}
+}
+
+func (r *ExceptionRepository) Create(ctx context.Context, u username.Username, e exceptionModel.Exception) error {
+	d := toRecord(e, u)
+	return r.dbHandler.WithContext(ctx).Create(&d).Error
+}
+
+func (r *ExceptionRepository) GetAll(ctx context.Context, u username.Username) ([]exceptionModel.Exception, error) {
+	ds := make([]Exception, 0)
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Order("id").Find(&ds).Error
+	if err != nil {
+		return []exceptionModel.Exception{}, err
+	}
+
+	exceptions := make([]exceptionModel.Exception, 0)
+	for _, d := range ds {
+		e, err := fromRecord(d)
+		if err != nil {
+			return exceptions, err
+		}
+		exceptions = append(exceptions, e)
+	}
+
+	return exceptions, nil
+}
+
+func (r *ExceptionRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	if id < 1 {
+		return errs.Invalid("invalid_id", "invalid id")
+	}
+
+	return r.dbHandler.WithContext(ctx).Where("id = ? AND username = ?", uint(id), u.Name()).Delete(Exception{}).Error
+}
+
+func (r *ExceptionRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Exception{}).Error
+}
@@ -0,0 +1,91 @@
+package feed
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	feedModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/feed"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	feedRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/feed"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type FeedRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewFeedRepository(h *handler.DbHandler) feedRepository.IFeedRepository {
+	return &FeedRepository{h}
+}
+
+type Feed struct {
+	Username string `gorm:"primary_key"`
+	Token    string `gorm:"unique_index"`
+}
+
+func toRecord(f feedModel.Feed) Feed {
+	return Feed{f.Username().Name(), f.Token().Token()}
+}
+
+func fromRecord(f Feed) (feedModel.Feed, error) {
+	u, err := username.NewUsername(f.Username)
+	return feedModel.NewFeed(u, token.NewToken(f.Token)), err
+}
+
+func (r *FeedRepository) Append(ctx context.Context, f feedModel.Feed) error {
+	d := toRecord(f)
+	return r.dbHandler.WithContext(ctx).Create(&d).Error
+}
+
+func (r *FeedRepository) Remove(ctx context.Context, u username.Username) error {
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Feed{}).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil
+	}
+	return err
+}
+
+func (r *FeedRepository) Exists(ctx context.Context, t token.Token) (bool, error) {
+	f := new(Feed)
+	err := r.dbHandler.WithContext(ctx).Where("token = ?", t.Token()).Take(f).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return f.Token != "", nil
+}
+
+func (r *FeedRepository) GetByToken(ctx context.Context, t token.Token) (feedModel.Feed, error) {
+	feed := new(Feed)
+	err := r.dbHandler.WithContext(ctx).Where("token = ?", t.Token()).Take(feed).Error
+	if err != nil {
+		return feedModel.Feed{}, err
+	}
+
+	f, err := fromRecord(*feed)
+	if errors.Is(err, username.InvalidUsername) {
+		return feedModel.Feed{}, errs.NotFound("user_not_found", "user not found")
+	}
+
+	return f, nil
+}
+
+func (r *FeedRepository) GetByUsername(ctx context.Context, u username.Username) (feedModel.Feed, error) {
+	feed := new(Feed)
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(feed).Error
+	if err != nil {
+		return feedModel.Feed{}, err
+	}
+
+	f, err := fromRecord(*feed)
+	if errors.Is(err, username.InvalidUsername) {
+		return feedModel.Feed{}, errs.NotFound("user_not_found", "user not found")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,165 @@
+package grade
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	gradeModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/grade"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	gradeRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/grade"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type GradeRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewGradeRepository(h *handler.DbHandler) gradeRepository.IGradeRepository {
+	return &GradeRepository{h}
+}
+
+type Score struct {
+	ID        uint   `gorm:"primary_key;auto_increment"`
+	Username  string `gorm:"index"`
+	SubjectID uint
+	Title     string
+	Points    float64
+	Max       float64
+	Weight    float64
+}
+
+// Scale is the grading scale of a user, whose bands are stored as JSON.
+type Scale struct {
+	Username string `gorm:"primary_key"`
+	Bands    string `gorm:"size:1020"`
+}
+
+func (s Scale) TableName() string {
+	return "grading_scales"
+}
+
+type band struct {
+	Grade  string  `json:"grade"`
+	Min    float64 `json:"min"`
+	Points float64 `json:"points"`
+}
+
+func toRecord(s gradeModel.Score, u username.Username) Score {
+	id := uint(s.ID())
+	if s.ID() == -1 {
+		id = 0
+	}
+
+	return Score{id, u.Name(), uint(s.SubjectID()), s.Title(), s.Points(), s.Max(), s.Weight()}
+}
+
+func fromRecord(s Score) (gradeModel.Score, error) {
+	return gradeModel.NewScore(int(s.ID), int(s.SubjectID), s.Title, s.Points, s.Max, s.Weight)
+}
+
+func (r *GradeRepository) Create(ctx context.Context, u username.Username, s gradeModel.Score) error {
+	d := toRecord(s, u)
+	return r.dbHandler.WithContext(ctx).Create(&d).Error
+}
+
+func (r *GradeRepository) Exists(ctx context.Context, u username.Username, id int) (bool, error) {
+	if id < 1 {
+		return false, nil
+	}
+
+	s := Score{}
+	err := r.dbHandler.WithContext(ctx).Where("id = ? AND username = ?", uint(id), u.Name()).Take(&s).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *GradeRepository) GetAll(ctx context.Context, u username.Username) ([]gradeModel.Score, error) {
+	ds := make([]Score, 0)
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Order("id").Find(&ds).Error
+	if err != nil {
+		return []gradeModel.Score{}, err
+	}
+
+	scores := make([]gradeModel.Score, 0)
+	for _, d := range ds {
+		s, err := fromRecord(d)
+		if err != nil {
+			return scores, err
+		}
+		scores = append(scores, s)
+	}
+
+	return scores, nil
+}
+
+func (r *GradeRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	if id < 1 {
+		return errs.Invalid("invalid_id", "invalid id")
+	}
+
+	return r.dbHandler.WithContext(ctx).Where("id = ? AND username = ?", uint(id), u.Name()).Delete(Score{}).Error
+}
+
+func (r *GradeRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Score{}).Error
+}
+
+func (r *GradeRepository) SetScale(ctx context.Context, u username.Username, s gradeModel.Scale) error {
+	bs := make([]band, 0)
+	for _, b := range s.Bands() {
+		bs = append(bs, band{b.Grade(), b.Min(), b.Points()})
+	}
+
+	j, err := json.Marshal(bs)
+	if err != nil {
+		return err
+	}
+
+	d := Scale{u.Name(), string(j)}
+	return r.dbHandler.WithContext(ctx).Save(&d).Error
+}
+
+func (r *GradeRepository) ScaleExists(ctx context.Context, u username.Username) (bool, error) {
+	s := Scale{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(&s).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *GradeRepository) GetScale(ctx context.Context, u username.Username) (gradeModel.Scale, error) {
+	s := Scale{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(&s).Error
+	if err != nil {
+		return gradeModel.Scale{}, err
+	}
+
+	bs := make([]band, 0)
+	if err = json.Unmarshal([]byte(s.Bands), &bs); err != nil {
+		return gradeModel.Scale{}, err
+	}
+
+	bands := make([]gradeModel.Band, 0)
+	for _, b := range bs {
+		bands = append(bands, gradeModel.NewBand(b.Grade, b.Min, b.Points))
+	}
+
+	return gradeModel.NewScale(bands)
+}
+
+func (r *GradeRepository) RemoveScale(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Scale{}).Error
+}
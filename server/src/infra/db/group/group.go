@@ -0,0 +1,172 @@
+package group
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	groupModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/group"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	groupRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/group"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type GroupRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewGroupRepository(h *handler.DbHandler) groupRepository.IGroupRepository {
+	return &GroupRepository{h}
+}
+
+type Group struct {
+	ID    uint `gorm:"primary_key;auto_increment"`
+	Name  string
+	Owner string `gorm:"index"`
+}
+
+type GroupMember struct {
+	GroupID  uint   `gorm:"primary_key;auto_increment:false"`
+	Username string `gorm:"primary_key"`
+	Joined   bool
+	Sharing  bool
+}
+
+func toRecord(g groupModel.Group) Group {
+	d := Group{Name: g.Name(), Owner: g.Owner().Name()}
+	if g.ID() != -1 {
+		d.ID = uint(g.ID())
+	}
+
+	return d
+}
+
+func toMemberRecord(id uint, m groupModel.Member) GroupMember {
+	return GroupMember{id, m.Username().Name(), m.Joined(), m.Sharing()}
+}
+
+func fromRecord(g Group, ms []GroupMember) (groupModel.Group, error) {
+	owner, err := username.NewUsername(g.Owner)
+	if err != nil {
+		return groupModel.Group{}, err
+	}
+
+	members := make([]groupModel.Member, 0, len(ms))
+	for _, m := range ms {
+		u, err := username.NewUsername(m.Username)
+		if err != nil {
+			return groupModel.Group{}, err
+		}
+		members = append(members, groupModel.NewMember(u, m.Joined, m.Sharing))
+	}
+
+	return groupModel.NewGroup(int(g.ID), g.Name, owner, members)
+}
+
+func (r *GroupRepository) Create(ctx context.Context, g groupModel.Group) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		d := toRecord(g)
+		if err := tx.Create(&d).Error; err != nil {
+			return err
+		}
+
+		for _, m := range g.Members() {
+			md := toMemberRecord(d.ID, m)
+			if err := tx.Create(&md).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *GroupRepository) Exists(ctx context.Context, id int) (bool, error) {
+	g := new(Group)
+	err := r.dbHandler.WithContext(ctx).Where("id = ?", uint(id)).Take(g).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return g.ID != 0, nil
+}
+
+func (r *GroupRepository) Get(ctx context.Context, id int) (groupModel.Group, error) {
+	g := new(Group)
+	err := r.dbHandler.WithContext(ctx).Where("id = ?", uint(id)).Take(g).Error
+	if err != nil {
+		return groupModel.Group{}, err
+	}
+
+	ms := make([]GroupMember, 0)
+	err = r.dbHandler.WithContext(ctx).Where("group_id = ?", g.ID).Order("username").Find(&ms).Error
+	if err != nil {
+		return groupModel.Group{}, err
+	}
+
+	return fromRecord(*g, ms)
+}
+
+func (r *GroupRepository) GetAll(ctx context.Context, u username.Username) ([]groupModel.Group, error) {
+	ms := make([]GroupMember, 0)
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Order("group_id").Find(&ms).Error
+	if err != nil {
+		return []groupModel.Group{}, err
+	}
+
+	groups := make([]groupModel.Group, 0)
+	for _, m := range ms {
+		g, err := r.Get(ctx, int(m.GroupID))
+		if err != nil {
+			return groups, err
+		}
+		groups = append(groups, g)
+	}
+
+	return groups, nil
+}
+
+func (r *GroupRepository) SetMember(ctx context.Context, id int, m groupModel.Member) error {
+	d := toMemberRecord(uint(id), m)
+	return r.dbHandler.WithContext(ctx).Save(&d).Error
+}
+
+func (r *GroupRepository) RemoveMember(ctx context.Context, id int, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("group_id = ? AND username = ?", uint(id), u.Name()).Delete(GroupMember{}).Error
+}
+
+func (r *GroupRepository) Remove(ctx context.Context, id int) error {
+	if id < 1 {
+		return errs.Invalid("invalid_id", "invalid id")
+	}
+
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("group_id = ?", uint(id)).Delete(GroupMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", uint(id)).Delete(Group{}).Error
+	})
+}
+
+// RemoveAll removes the groups owned by the user and the memberships of the user.
+func (r *GroupRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		owned := make([]Group, 0)
+		if err := tx.Where("owner = ?", u.Name()).Find(&owned).Error; err != nil {
+			return err
+		}
+
+		for _, g := range owned {
+			if err := tx.Where("group_id = ?", g.ID).Delete(GroupMember{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("id = ?", g.ID).Delete(Group{}).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Where("username = ?", u.Name()).Delete(GroupMember{}).Error
+	})
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jinzhu/gorm"
+)
+
+// conn is the connection of a database whose queries are bound to a context, so that they are
+// canceled when the request they serve is, or its timeout expires. Transactions begun on it are
+// bound to the context as well.
+type conn struct {
+	db  *sql.DB
+	ctx context.Context
+}
+
+func (c conn) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return c.db.ExecContext(c.ctx, query, args...)
+}
+
+func (c conn) Prepare(query string) (*sql.Stmt, error) {
+	return c.db.PrepareContext(c.ctx, query)
+}
+
+func (c conn) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return c.db.QueryContext(c.ctx, query, args...)
+}
+
+func (c conn) QueryRow(query string, args ...interface{}) *sql.Row {
+	return c.db.QueryRowContext(c.ctx, query, args...)
+}
+
+func (c conn) Begin() (*sql.Tx, error) {
+	return c.db.BeginTx(c.ctx, nil)
+}
+
+func (c conn) BeginTx(_ context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return c.db.BeginTx(c.ctx, opts)
+}
+
+// WithContext returns the database whose queries are bound to ctx, with the settings of Db.
+// gorm v1 takes no context, so it is given the connection which does. If it cannot be given,
+// the database returned fails every query with the error, instead of the queries being unbound.
+func (h *DbHandler) WithContext(ctx context.Context) *gorm.DB {
+	db, err := gorm.Open(h.driver, conn{h.Db.DB(), ctx})
+	if err != nil {
+		db = h.Db.New()
+		db.AddError(err)
+		return db
+	}
+
+	return configure(db)
+}
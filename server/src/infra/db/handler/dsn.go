@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const (
+	MySQL    = "mysql"
+	Postgres = "postgres"
+	SQLite   = "sqlite3"
+
+	InMemory = ":memory:"
+
+	UnsupportedDBMS = "unsupported DBMS"
+	MissingDBName   = "database name is required"
+	MissingPath     = "database path is required"
+	InvalidProtocol = "invalid protocol"
+	InvalidPort     = "invalid port"
+)
+
+// dialect is a DBMS by the name of its driver and how to connect to it.
+type dialect struct {
+	driver string
+	dsn    func(Config) (string, error)
+}
+
+var dialects = map[string]dialect{
+	MySQL:    {MySQL, mysqlDSN},
+	Postgres: {Postgres, postgresDSN},
+	SQLite:   {SQLite, sqliteDSN},
+}
+
+// aliases are the other names a DBMS is known by in configurations.
+var aliases = map[string]string{
+	"postgresql": Postgres,
+	"sqlite":     SQLite,
+}
+
+func dialectOf(dbms string) (dialect, error) {
+	name := strings.ToLower(dbms)
+	if a, ok := aliases[name]; ok {
+		name = a
+	}
+
+	d, ok := dialects[name]
+	if !ok {
+		return dialect{}, fmt.Errorf(UnsupportedDBMS)
+	}
+
+	return d, nil
+}
+
+// DSN returns the data source name to connect to the database of the configuration.
+func (c Config) DSN() (string, error) {
+	d, err := dialectOf(c.DBMS)
+	if err != nil {
+		return "", err
+	}
+
+	return d.dsn(c)
+}
+
+// mysqlDSN connects over protocol, which is like "tcp(db:3306)",
+// or over TCP to host and port if protocol is omitted.
+func mysqlDSN(c Config) (string, error) {
+	if c.DBName == "" {
+		return "", fmt.Errorf(MissingDBName)
+	}
+
+	m := mysql.NewConfig()
+	m.User = c.User
+	m.Passwd = c.Password
+	m.DBName = c.DBName
+	m.ParseTime = true
+	m.Params = map[string]string{"charset": "utf8mb4"}
+
+	switch {
+	case c.Protocol != "":
+		open := strings.Index(c.Protocol, "(")
+		if open < 1 || !strings.HasSuffix(c.Protocol, ")") {
+			return "", fmt.Errorf(InvalidProtocol)
+		}
+		m.Net = c.Protocol[:open]
+		m.Addr = c.Protocol[open+1 : len(c.Protocol)-1]
+	case c.Host != "":
+		addr, err := hostPort(c, 3306)
+		if err != nil {
+			return "", err
+		}
+		m.Net = "tcp"
+		m.Addr = addr
+	}
+
+	return m.FormatDSN(), nil
+}
+
+// postgresDSN builds a connection string of key/value pairs. SSL is disabled if sslmode is omitted.
+func postgresDSN(c Config) (string, error) {
+	if c.DBName == "" {
+		return "", fmt.Errorf(MissingDBName)
+	}
+
+	addr, err := hostPort(c, 5432)
+	if err != nil {
+		return "", err
+	}
+	host, port, _ := net.SplitHostPort(addr)
+
+	sslmode := c.SSLMode
+	if sslmode == "" {
+		sslmode = "disable"
+	}
+
+	pairs := [][2]string{
+		{"host", host},
+		{"port", port},
+		{"user", c.User},
+		{"password", c.Password},
+		{"dbname", c.DBName},
+		{"sslmode", sslmode},
+	}
+
+	kvs := make([]string, 0, len(pairs))
+	for _, p := range pairs {
+		if p[1] == "" {
+			continue
+		}
+		kvs = append(kvs, p[0]+"="+quote(p[1]))
+	}
+
+	return strings.Join(kvs, " "), nil
+}
+
+// quote quotes a value of a PostgreSQL connection string if it has spaces, quotes or backslashes.
+func quote(v string) string {
+	if !strings.ContainsAny(v, " '\\") {
+		return v
+	}
+
+	r := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	return "'" + r.Replace(v) + "'"
+}
+
+// sqliteDSN opens the database file of path. An in-memory database is
+// shared by the connections, so that it is not lost when one is closed.
+func sqliteDSN(c Config) (string, error) {
+	switch c.Path {
+	case "":
+		return "", fmt.Errorf(MissingPath)
+	case InMemory:
+		return "file::memory:?cache=shared", nil
+	}
+
+	return "file:" + c.Path, nil
+}
+
+func hostPort(c Config, defaultPort int) (string, error) {
+	host := c.Host
+	if host == "" {
+		host = "localhost"
+	}
+
+	port := c.Port
+	if port == 0 {
+		port = defaultPort
+	}
+	if port < 1 || port > 65535 {
+		return "", fmt.Errorf(InvalidPort)
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(port)), nil
+}
@@ -0,0 +1,77 @@
+package handler
+
+import "testing"
+
+func TestDSN(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   Config
+		expected string
+	}{
+		{
+			"mysql over protocol",
+			Config{DBMS: "mysql", User: "root", Password: "pass", Protocol: "tcp(db:3306)", DBName: "kiwi_basket"},
+			"root:pass@tcp(db:3306)/kiwi_basket?parseTime=true&charset=utf8mb4",
+		},
+		{
+			"mysql by host",
+			Config{DBMS: "mysql", User: "root", Password: "pass", Host: "db", DBName: "kiwi_basket"},
+			"root:pass@tcp(db:3306)/kiwi_basket?parseTime=true&charset=utf8mb4",
+		},
+		{
+			"postgres",
+			Config{DBMS: "postgres", User: "kiwi", Password: "pass", Host: "db", Port: 5433, DBName: "kiwi_basket"},
+			"host=db port=5433 user=kiwi password=pass dbname=kiwi_basket sslmode=disable",
+		},
+		{
+			"postgres with quoted password",
+			Config{DBMS: "postgresql", User: "kiwi", Password: `it's a \secret`, DBName: "kiwi_basket", SSLMode: "require"},
+			`host=localhost port=5432 user=kiwi password='it\'s a \\secret' dbname=kiwi_basket sslmode=require`,
+		},
+		{
+			"sqlite file",
+			Config{DBMS: "sqlite3", Path: "/var/lib/kiwi-basket/db.sqlite"},
+			"file:/var/lib/kiwi-basket/db.sqlite",
+		},
+		{
+			"sqlite in memory",
+			Config{DBMS: "sqlite", Path: InMemory},
+			"file::memory:?cache=shared",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dsn, err := test.config.DSN()
+			if err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if dsn != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, dsn)
+			}
+		})
+	}
+}
+
+func TestInvalidDSN(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   Config
+		expected string
+	}{
+		{"unknown DBMS", Config{DBMS: "oracle", DBName: "kiwi_basket"}, UnsupportedDBMS},
+		{"mysql without database", Config{DBMS: "mysql", Protocol: "tcp(db:3306)"}, MissingDBName},
+		{"mysql with invalid protocol", Config{DBMS: "mysql", Protocol: "db:3306", DBName: "kiwi_basket"}, InvalidProtocol},
+		{"postgres with invalid port", Config{DBMS: "postgres", Port: 70000, DBName: "kiwi_basket"}, InvalidPort},
+		{"sqlite without path", Config{DBMS: "sqlite3"}, MissingPath},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.config.DSN()
+			if err == nil || err.Error() != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+}
@@ -1,24 +1,147 @@
 package handler
 
 import (
-	_ "github.com/go-sql-driver/mysql"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
 	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/mysql"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 )
 
+// Config selects the DBMS by dbms, which is one of "mysql", "postgres" and "sqlite3".
+// Host and port are used by PostgreSQL, and by MySQL if protocol is omitted.
+// Path is the database file of SQLite, or ":memory:" for an in-memory database.
+// Timeout bounds the queries of a request, like "5s", and is DefaultTimeout if omitted.
 type Config struct {
-	DBMS     string `yaml:"dbms"`
-	User     string `yaml:"user"`
-	Password string `yaml:"password"`
-	Protocol string `yaml:"protocol"`
-	DBName   string `yaml:"dbname"`
+	DBMS     string        `yaml:"dbms"`
+	User     string        `yaml:"user"`
+	Password string        `yaml:"password"`
+	Protocol string        `yaml:"protocol"`
+	Host     string        `yaml:"host"`
+	Port     int           `yaml:"port"`
+	DBName   string        `yaml:"dbname"`
+	SSLMode  string        `yaml:"sslmode"`
+	Path     string        `yaml:"path"`
+	Timeout  time.Duration `yaml:"timeout"`
 }
 
+const DefaultTimeout = 10 * time.Second
+
 type DbHandler struct {
-	Db *gorm.DB
+	Db      *gorm.DB
+	driver  string
+	timeout time.Duration
 }
 
 func NewDbHandler(c Config) (*DbHandler, error) {
-	connect := c.User + ":" + c.Password + "@" + c.Protocol + "/" + c.DBName + "?charset=utf8mb4" + "&parseTime=true"
-	db, err := gorm.Open(c.DBMS, connect)
-	return &DbHandler{db}, err
+	d, err := dialectOf(c.DBMS)
+	if err != nil {
+		return nil, err
+	}
+
+	connect, err := d.dsn(c)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := gorm.Open(d.driver, connect)
+	if err != nil {
+		return nil, err
+	}
+	db = configure(db)
+
+	// SQLite allows a single writer, and an in-memory database lives only as long as its connection.
+	if d.driver == SQLite {
+		db.DB().SetMaxOpenConns(1)
+	}
+
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &DbHandler{db, d.driver, timeout}, nil
+}
+
+// configure applies the settings of gorm to db. WithContext opens a database for each context,
+// which is given the settings again, so they must be applied here rather than to Db.
+func configure(db *gorm.DB) *gorm.DB {
+	db.SetLogger(logger{})
+	return db
+}
+
+// logger writes the logs of gorm to the default logger at the debug level: the errors of the queries,
+// which the repositories return and which are logged where they are handled, and the queries themselves
+// if LogMode is enabled, without their values which may be secrets.
+type logger struct{}
+
+func (logger) Print(values ...interface{}) {
+	if len(values) < 2 {
+		return
+	}
+
+	attrs := []slog.Attr{slog.Any("source", values[1])}
+	switch {
+	case values[0] == "sql" && len(values) >= 6:
+		attrs = append(attrs, slog.Any("duration", values[2]), slog.Any("sql", values[3]), slog.Any("rows", values[5]))
+	default:
+		attrs = append(attrs, slog.String("message", fmt.Sprint(values[2:]...)))
+	}
+
+	slog.LogAttrs(context.Background(), slog.LevelDebug, "gorm", attrs...)
+}
+
+// Timeout returns how long the queries of a request may take in total.
+func (h *DbHandler) Timeout() time.Duration {
+	return h.timeout
+}
+
+// Ping checks that the database accepts connections before ctx is done.
+func (h *DbHandler) Ping(ctx context.Context) error {
+	return h.Db.DB().PingContext(ctx)
+}
+
+var (
+	RecordNotFound = errs.NotFound("record_not_found", "record not found")
+	Timeout        = errs.Unavailable("database_timeout", "database did not respond in time")
+)
+
+// IsConflict reports whether err is a violation of a unique index or a primary key,
+// which a write racing another one gets.
+func IsConflict(err error) bool {
+	var m *mysql.MySQLError
+	if errors.As(err, &m) {
+		return m.Number == 1062
+	}
+	var p *pq.Error
+	if errors.As(err, &p) {
+		return p.Code == "23505"
+	}
+	var s sqlite3.Error
+	if errors.As(err, &s) {
+		return s.ExtendedCode == sqlite3.ErrConstraintUnique || s.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+	}
+
+	return false
+}
+
+// Translate converts the errors of gorm escaping the repositories into those of the domain.
+func Translate(err error) error {
+	if gorm.IsRecordNotFoundError(err) {
+		return RecordNotFound
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return Timeout
+	}
+
+	return err
 }
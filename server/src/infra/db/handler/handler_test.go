@@ -0,0 +1,126 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	taskModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/migration"
+	taskRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/task"
+)
+
+func TestSQLite(t *testing.T) {
+	h, err := handler.NewDbHandler(handler.Config{DBMS: handler.SQLite, Path: handler.InMemory})
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	defer h.Db.Close()
+
+	if _, err = migration.NewMigrator(h, migration.Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	r := taskRepository.NewTaskRepository(h)
+	u, _ := username.NewUsername("gleam")
+	task, _ := taskModel.NewTask(-1, "2020-01-01", "report")
+
+	if err = r.Create(context.Background(), u, task); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	tasks, err := r.GetAll(context.Background(), u)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if len(tasks) != 1 || tasks[0].Title() != "report" || tasks[0].TextDate() != "2020-01-01" {
+		t.Fatalf("expected: %v; got: %v\n", task, tasks)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	h, err := handler.NewDbHandler(handler.Config{DBMS: handler.SQLite, Path: handler.InMemory})
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	defer h.Db.Close()
+
+	if _, err = migration.NewMigrator(h, migration.Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	err = h.Db.Where("id = ?", 1).Take(new(taskRepository.Task)).Error
+	if expected := handler.RecordNotFound; !errors.Is(handler.Translate(err), expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, err)
+	}
+
+	if err = fmt.Errorf("connection refused"); handler.Translate(err) != err {
+		t.Fatalf("expected: %v; got: %v\n", err, handler.Translate(err))
+	}
+}
+
+func TestIsConflict(t *testing.T) {
+	h, err := handler.NewDbHandler(handler.Config{DBMS: handler.SQLite, Path: handler.InMemory})
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	defer h.Db.Close()
+
+	if _, err = migration.NewMigrator(h, migration.Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	insert := "INSERT INTO timetables_versions (username, term_id, number) VALUES ('gleam', 0, 1)"
+	if err = h.Db.Exec(insert).Error; handler.IsConflict(err) {
+		t.Fatalf("unexpected conflict: %v\n", err)
+	}
+	if err = h.Db.Exec(insert).Error; !handler.IsConflict(err) {
+		t.Fatalf("expected a conflict; got: %v\n", err)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	h, err := handler.NewDbHandler(handler.Config{DBMS: handler.SQLite, Path: handler.InMemory, Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	defer h.Db.Close()
+
+	if h.Timeout() != time.Second {
+		t.Fatalf("expected: %v; got: %v\n", time.Second, h.Timeout())
+	}
+	if _, err = migration.NewMigrator(h, migration.Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	u, _ := username.NewUsername("gleam")
+	_, err = taskRepository.NewTaskRepository(h).GetAll(ctx, u)
+	if expected := handler.Timeout; !errors.Is(handler.Translate(err), expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, err)
+	}
+}
+
+func TestQueryTimeout(t *testing.T) {
+	h, err := handler.NewDbHandler(handler.Config{DBMS: handler.SQLite, Path: handler.InMemory})
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	defer h.Db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// A query which never ends on its own, so that it is the timeout which cancels it.
+	var count int
+	err = h.WithContext(ctx).Raw("WITH RECURSIVE r(i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM r) SELECT COUNT(*) FROM r").Row().Scan(&count)
+	if expected := handler.Timeout; !errors.Is(handler.Translate(err), expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, err)
+	}
+}
@@ -0,0 +1,149 @@
+package institution
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	institutionModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/institution"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	institutionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/institution"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type InstitutionRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewInstitutionRepository(h *handler.DbHandler) institutionRepository.IInstitutionRepository {
+	return &InstitutionRepository{h}
+}
+
+type Institution struct {
+	ID   uint `gorm:"primary_key;auto_increment"`
+	Name string
+}
+
+type InstitutionMember struct {
+	InstitutionID uint   `gorm:"primary_key;auto_increment:false"`
+	Username      string `gorm:"primary_key"`
+	Role          string
+	Joined        bool
+}
+
+func toRecord(i institutionModel.Institution) Institution {
+	d := Institution{Name: i.Name()}
+	if i.ID() != -1 {
+		d.ID = uint(i.ID())
+	}
+
+	return d
+}
+
+func toMemberRecord(id uint, m institutionModel.Member) InstitutionMember {
+	return InstitutionMember{id, m.Username().Name(), m.Role().String(), m.Joined()}
+}
+
+func fromRecord(i Institution, ms []InstitutionMember) (institutionModel.Institution, error) {
+	members := make([]institutionModel.Member, 0, len(ms))
+	for _, m := range ms {
+		u, err := username.NewUsername(m.Username)
+		if err != nil {
+			return institutionModel.Institution{}, err
+		}
+		role, err := institutionModel.ParseRole(m.Role)
+		if err != nil {
+			return institutionModel.Institution{}, err
+		}
+		members = append(members, institutionModel.NewMember(u, role, m.Joined))
+	}
+
+	return institutionModel.NewInstitution(int(i.ID), i.Name, members)
+}
+
+func (r *InstitutionRepository) Create(ctx context.Context, i institutionModel.Institution) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		d := toRecord(i)
+		if err := tx.Create(&d).Error; err != nil {
+			return err
+		}
+
+		for _, m := range i.Members() {
+			md := toMemberRecord(d.ID, m)
+			if err := tx.Create(&md).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *InstitutionRepository) Exists(ctx context.Context, id int) (bool, error) {
+	i := new(Institution)
+	err := r.dbHandler.WithContext(ctx).Where("id = ?", uint(id)).Take(i).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return i.ID != 0, nil
+}
+
+func (r *InstitutionRepository) Get(ctx context.Context, id int) (institutionModel.Institution, error) {
+	i := new(Institution)
+	err := r.dbHandler.WithContext(ctx).Where("id = ?", uint(id)).Take(i).Error
+	if err != nil {
+		return institutionModel.Institution{}, err
+	}
+
+	ms := make([]InstitutionMember, 0)
+	err = r.dbHandler.WithContext(ctx).Where("institution_id = ?", i.ID).Order("username").Find(&ms).Error
+	if err != nil {
+		return institutionModel.Institution{}, err
+	}
+
+	return fromRecord(*i, ms)
+}
+
+func (r *InstitutionRepository) GetAll(ctx context.Context, u username.Username) ([]institutionModel.Institution, error) {
+	ms := make([]InstitutionMember, 0)
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Order("institution_id").Find(&ms).Error
+	if err != nil {
+		return []institutionModel.Institution{}, err
+	}
+
+	institutions := make([]institutionModel.Institution, 0)
+	for _, m := range ms {
+		i, err := r.Get(ctx, int(m.InstitutionID))
+		if err != nil {
+			return institutions, err
+		}
+		institutions = append(institutions, i)
+	}
+
+	return institutions, nil
+}
+
+func (r *InstitutionRepository) SetMember(ctx context.Context, id int, m institutionModel.Member) error {
+	d := toMemberRecord(uint(id), m)
+	return r.dbHandler.WithContext(ctx).Save(&d).Error
+}
+
+func (r *InstitutionRepository) RemoveMember(ctx context.Context, id int, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("institution_id = ? AND username = ?", uint(id), u.Name()).Delete(InstitutionMember{}).Error
+}
+
+func (r *InstitutionRepository) Remove(ctx context.Context, id int) error {
+	if id < 1 {
+		return errs.Invalid("invalid_id", "invalid id")
+	}
+
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("institution_id = ?", uint(id)).Delete(InstitutionMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", uint(id)).Delete(Institution{}).Error
+	})
+}
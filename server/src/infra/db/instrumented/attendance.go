@@ -0,0 +1,91 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/attendance"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	attendanceRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/attendance"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type AttendanceRepository struct {
+	repository attendanceRepository.IAttendanceRepository
+}
+
+func NewAttendanceRepository(r attendanceRepository.IAttendanceRepository) attendanceRepository.IAttendanceRepository {
+	return &AttendanceRepository{r}
+}
+
+func (r *AttendanceRepository) Set(ctx context.Context, u username.Username, record attendance.Record) error {
+	start := time.Now()
+	err := r.repository.Set(ctx, u, record)
+	metrics.ObserveQuery("attendance", "Set", start, err)
+
+	return err
+}
+
+func (r *AttendanceRepository) Exists(ctx context.Context, u username.Username, t time.Time, id int) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, u, t, id)
+	metrics.ObserveQuery("attendance", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *AttendanceRepository) GetAll(ctx context.Context, u username.Username) ([]attendance.Record, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, u)
+	metrics.ObserveQuery("attendance", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *AttendanceRepository) Remove(ctx context.Context, u username.Username, t time.Time, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u, t, id)
+	metrics.ObserveQuery("attendance", "Remove", start, err)
+
+	return err
+}
+
+func (r *AttendanceRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveAll(ctx, u)
+	metrics.ObserveQuery("attendance", "RemoveAll", start, err)
+
+	return err
+}
+
+func (r *AttendanceRepository) SetPolicy(ctx context.Context, u username.Username, p attendance.Policy) error {
+	start := time.Now()
+	err := r.repository.SetPolicy(ctx, u, p)
+	metrics.ObserveQuery("attendance", "SetPolicy", start, err)
+
+	return err
+}
+
+func (r *AttendanceRepository) PolicyExists(ctx context.Context, u username.Username) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.PolicyExists(ctx, u)
+	metrics.ObserveQuery("attendance", "PolicyExists", start, err)
+
+	return v, err
+}
+
+func (r *AttendanceRepository) GetPolicy(ctx context.Context, u username.Username) (attendance.Policy, error) {
+	start := time.Now()
+	v, err := r.repository.GetPolicy(ctx, u)
+	metrics.ObserveQuery("attendance", "GetPolicy", start, err)
+
+	return v, err
+}
+
+func (r *AttendanceRepository) RemovePolicy(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemovePolicy(ctx, u)
+	metrics.ObserveQuery("attendance", "RemovePolicy", start, err)
+
+	return err
+}
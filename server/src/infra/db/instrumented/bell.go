@@ -0,0 +1,51 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type BellRepository struct {
+	repository bellRepository.IBellRepository
+}
+
+func NewBellRepository(r bellRepository.IBellRepository) bellRepository.IBellRepository {
+	return &BellRepository{r}
+}
+
+func (r *BellRepository) Create(ctx context.Context, u username.Username, s bell.Schedule) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, u, s)
+	metrics.ObserveQuery("bell", "Create", start, err)
+
+	return err
+}
+
+func (r *BellRepository) Delete(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.Delete(ctx, u)
+	metrics.ObserveQuery("bell", "Delete", start, err)
+
+	return err
+}
+
+func (r *BellRepository) Exists(ctx context.Context, u username.Username) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, u)
+	metrics.ObserveQuery("bell", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *BellRepository) Get(ctx context.Context, u username.Username) (bell.Schedule, error) {
+	start := time.Now()
+	v, err := r.repository.Get(ctx, u)
+	metrics.ObserveQuery("bell", "Get", start, err)
+
+	return v, err
+}
@@ -0,0 +1,51 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/check"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	checkRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/check"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type CheckRepository struct {
+	repository checkRepository.ICheckRepository
+}
+
+func NewCheckRepository(r checkRepository.ICheckRepository) checkRepository.ICheckRepository {
+	return &CheckRepository{r}
+}
+
+func (r *CheckRepository) SetLimits(ctx context.Context, u username.Username, l check.Limits) error {
+	start := time.Now()
+	err := r.repository.SetLimits(ctx, u, l)
+	metrics.ObserveQuery("check", "SetLimits", start, err)
+
+	return err
+}
+
+func (r *CheckRepository) LimitsExist(ctx context.Context, u username.Username) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.LimitsExist(ctx, u)
+	metrics.ObserveQuery("check", "LimitsExist", start, err)
+
+	return v, err
+}
+
+func (r *CheckRepository) GetLimits(ctx context.Context, u username.Username) (check.Limits, error) {
+	start := time.Now()
+	v, err := r.repository.GetLimits(ctx, u)
+	metrics.ObserveQuery("check", "GetLimits", start, err)
+
+	return v, err
+}
+
+func (r *CheckRepository) RemoveLimits(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveLimits(ctx, u)
+	metrics.ObserveQuery("check", "RemoveLimits", start, err)
+
+	return err
+}
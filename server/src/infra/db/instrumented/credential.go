@@ -0,0 +1,60 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type CredentialRepository struct {
+	repository credentialRepository.ICredentialRepository
+}
+
+func NewCredentialRepository(r credentialRepository.ICredentialRepository) credentialRepository.ICredentialRepository {
+	return &CredentialRepository{r}
+}
+
+func (r *CredentialRepository) Append(ctx context.Context, a credential.Auth) error {
+	start := time.Now()
+	err := r.repository.Append(ctx, a)
+	metrics.ObserveQuery("credential", "Append", start, err)
+
+	return err
+}
+
+func (r *CredentialRepository) Remove(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u)
+	metrics.ObserveQuery("credential", "Remove", start, err)
+
+	return err
+}
+
+func (r *CredentialRepository) Exists(ctx context.Context, t token.Token) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, t)
+	metrics.ObserveQuery("credential", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *CredentialRepository) GetByToken(ctx context.Context, t token.Token) (credential.Auth, error) {
+	start := time.Now()
+	v, err := r.repository.GetByToken(ctx, t)
+	metrics.ObserveQuery("credential", "GetByToken", start, err)
+
+	return v, err
+}
+
+func (r *CredentialRepository) GetByUsername(ctx context.Context, u username.Username) (credential.Auth, error) {
+	start := time.Now()
+	v, err := r.repository.GetByUsername(ctx, u)
+	metrics.ObserveQuery("credential", "GetByUsername", start, err)
+
+	return v, err
+}
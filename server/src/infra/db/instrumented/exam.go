@@ -0,0 +1,67 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type ExamRepository struct {
+	repository examRepository.IExamRepository
+}
+
+func NewExamRepository(r examRepository.IExamRepository) examRepository.IExamRepository {
+	return &ExamRepository{r}
+}
+
+func (r *ExamRepository) Create(ctx context.Context, u username.Username, e exam.Exam) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, u, e)
+	metrics.ObserveQuery("exam", "Create", start, err)
+
+	return err
+}
+
+func (r *ExamRepository) Exists(ctx context.Context, u username.Username, id int) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, u, id)
+	metrics.ObserveQuery("exam", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *ExamRepository) GetAll(ctx context.Context, u username.Username) ([]exam.Exam, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, u)
+	metrics.ObserveQuery("exam", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *ExamRepository) Update(ctx context.Context, u username.Username, e exam.Exam) error {
+	start := time.Now()
+	err := r.repository.Update(ctx, u, e)
+	metrics.ObserveQuery("exam", "Update", start, err)
+
+	return err
+}
+
+func (r *ExamRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u, id)
+	metrics.ObserveQuery("exam", "Remove", start, err)
+
+	return err
+}
+
+func (r *ExamRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveAll(ctx, u)
+	metrics.ObserveQuery("exam", "RemoveAll", start, err)
+
+	return err
+}
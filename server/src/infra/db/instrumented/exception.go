@@ -0,0 +1,51 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type ExceptionRepository struct {
+	repository exceptionRepository.IExceptionRepository
+}
+
+func NewExceptionRepository(r exceptionRepository.IExceptionRepository) exceptionRepository.IExceptionRepository {
+	return &ExceptionRepository{r}
+}
+
+func (r *ExceptionRepository) Create(ctx context.Context, u username.Username, e exception.Exception) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, u, e)
+	metrics.ObserveQuery("exception", "Create", start, err)
+
+	return err
+}
+
+func (r *ExceptionRepository) GetAll(ctx context.Context, u username.Username) ([]exception.Exception, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, u)
+	metrics.ObserveQuery("exception", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *ExceptionRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u, id)
+	metrics.ObserveQuery("exception", "Remove", start, err)
+
+	return err
+}
+
+func (r *ExceptionRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveAll(ctx, u)
+	metrics.ObserveQuery("exception", "RemoveAll", start, err)
+
+	return err
+}
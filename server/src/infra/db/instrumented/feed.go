@@ -0,0 +1,60 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/feed"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	feedRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/feed"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type FeedRepository struct {
+	repository feedRepository.IFeedRepository
+}
+
+func NewFeedRepository(r feedRepository.IFeedRepository) feedRepository.IFeedRepository {
+	return &FeedRepository{r}
+}
+
+func (r *FeedRepository) Append(ctx context.Context, f feed.Feed) error {
+	start := time.Now()
+	err := r.repository.Append(ctx, f)
+	metrics.ObserveQuery("feed", "Append", start, err)
+
+	return err
+}
+
+func (r *FeedRepository) Remove(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u)
+	metrics.ObserveQuery("feed", "Remove", start, err)
+
+	return err
+}
+
+func (r *FeedRepository) Exists(ctx context.Context, t token.Token) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, t)
+	metrics.ObserveQuery("feed", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *FeedRepository) GetByToken(ctx context.Context, t token.Token) (feed.Feed, error) {
+	start := time.Now()
+	v, err := r.repository.GetByToken(ctx, t)
+	metrics.ObserveQuery("feed", "GetByToken", start, err)
+
+	return v, err
+}
+
+func (r *FeedRepository) GetByUsername(ctx context.Context, u username.Username) (feed.Feed, error) {
+	start := time.Now()
+	v, err := r.repository.GetByUsername(ctx, u)
+	metrics.ObserveQuery("feed", "GetByUsername", start, err)
+
+	return v, err
+}
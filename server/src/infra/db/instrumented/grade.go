@@ -0,0 +1,91 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/grade"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	gradeRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/grade"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type GradeRepository struct {
+	repository gradeRepository.IGradeRepository
+}
+
+func NewGradeRepository(r gradeRepository.IGradeRepository) gradeRepository.IGradeRepository {
+	return &GradeRepository{r}
+}
+
+func (r *GradeRepository) Create(ctx context.Context, u username.Username, s grade.Score) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, u, s)
+	metrics.ObserveQuery("grade", "Create", start, err)
+
+	return err
+}
+
+func (r *GradeRepository) Exists(ctx context.Context, u username.Username, id int) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, u, id)
+	metrics.ObserveQuery("grade", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *GradeRepository) GetAll(ctx context.Context, u username.Username) ([]grade.Score, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, u)
+	metrics.ObserveQuery("grade", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *GradeRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u, id)
+	metrics.ObserveQuery("grade", "Remove", start, err)
+
+	return err
+}
+
+func (r *GradeRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveAll(ctx, u)
+	metrics.ObserveQuery("grade", "RemoveAll", start, err)
+
+	return err
+}
+
+func (r *GradeRepository) SetScale(ctx context.Context, u username.Username, s grade.Scale) error {
+	start := time.Now()
+	err := r.repository.SetScale(ctx, u, s)
+	metrics.ObserveQuery("grade", "SetScale", start, err)
+
+	return err
+}
+
+func (r *GradeRepository) ScaleExists(ctx context.Context, u username.Username) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.ScaleExists(ctx, u)
+	metrics.ObserveQuery("grade", "ScaleExists", start, err)
+
+	return v, err
+}
+
+func (r *GradeRepository) GetScale(ctx context.Context, u username.Username) (grade.Scale, error) {
+	start := time.Now()
+	v, err := r.repository.GetScale(ctx, u)
+	metrics.ObserveQuery("grade", "GetScale", start, err)
+
+	return v, err
+}
+
+func (r *GradeRepository) RemoveScale(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveScale(ctx, u)
+	metrics.ObserveQuery("grade", "RemoveScale", start, err)
+
+	return err
+}
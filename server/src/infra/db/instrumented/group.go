@@ -0,0 +1,83 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/group"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	groupRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/group"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type GroupRepository struct {
+	repository groupRepository.IGroupRepository
+}
+
+func NewGroupRepository(r groupRepository.IGroupRepository) groupRepository.IGroupRepository {
+	return &GroupRepository{r}
+}
+
+func (r *GroupRepository) Create(ctx context.Context, g group.Group) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, g)
+	metrics.ObserveQuery("group", "Create", start, err)
+
+	return err
+}
+
+func (r *GroupRepository) Exists(ctx context.Context, id int) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, id)
+	metrics.ObserveQuery("group", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *GroupRepository) Get(ctx context.Context, id int) (group.Group, error) {
+	start := time.Now()
+	v, err := r.repository.Get(ctx, id)
+	metrics.ObserveQuery("group", "Get", start, err)
+
+	return v, err
+}
+
+func (r *GroupRepository) GetAll(ctx context.Context, u username.Username) ([]group.Group, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, u)
+	metrics.ObserveQuery("group", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *GroupRepository) SetMember(ctx context.Context, id int, m group.Member) error {
+	start := time.Now()
+	err := r.repository.SetMember(ctx, id, m)
+	metrics.ObserveQuery("group", "SetMember", start, err)
+
+	return err
+}
+
+func (r *GroupRepository) RemoveMember(ctx context.Context, id int, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveMember(ctx, id, u)
+	metrics.ObserveQuery("group", "RemoveMember", start, err)
+
+	return err
+}
+
+func (r *GroupRepository) Remove(ctx context.Context, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, id)
+	metrics.ObserveQuery("group", "Remove", start, err)
+
+	return err
+}
+
+func (r *GroupRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveAll(ctx, u)
+	metrics.ObserveQuery("group", "RemoveAll", start, err)
+
+	return err
+}
@@ -0,0 +1,75 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/institution"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	institutionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/institution"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type InstitutionRepository struct {
+	repository institutionRepository.IInstitutionRepository
+}
+
+func NewInstitutionRepository(r institutionRepository.IInstitutionRepository) institutionRepository.IInstitutionRepository {
+	return &InstitutionRepository{r}
+}
+
+func (r *InstitutionRepository) Create(ctx context.Context, i institution.Institution) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, i)
+	metrics.ObserveQuery("institution", "Create", start, err)
+
+	return err
+}
+
+func (r *InstitutionRepository) Exists(ctx context.Context, id int) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, id)
+	metrics.ObserveQuery("institution", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *InstitutionRepository) Get(ctx context.Context, id int) (institution.Institution, error) {
+	start := time.Now()
+	v, err := r.repository.Get(ctx, id)
+	metrics.ObserveQuery("institution", "Get", start, err)
+
+	return v, err
+}
+
+func (r *InstitutionRepository) GetAll(ctx context.Context, u username.Username) ([]institution.Institution, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, u)
+	metrics.ObserveQuery("institution", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *InstitutionRepository) SetMember(ctx context.Context, id int, m institution.Member) error {
+	start := time.Now()
+	err := r.repository.SetMember(ctx, id, m)
+	metrics.ObserveQuery("institution", "SetMember", start, err)
+
+	return err
+}
+
+func (r *InstitutionRepository) RemoveMember(ctx context.Context, id int, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveMember(ctx, id, u)
+	metrics.ObserveQuery("institution", "RemoveMember", start, err)
+
+	return err
+}
+
+func (r *InstitutionRepository) Remove(ctx context.Context, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, id)
+	metrics.ObserveQuery("institution", "Remove", start, err)
+
+	return err
+}
@@ -0,0 +1,3 @@
+// Package instrumented wraps the repositories to observe the durations of their queries by method,
+// labeled by the name of the package of each repository.
+package instrumented
@@ -0,0 +1,52 @@
+package instrumented
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+)
+
+const queryDuration = "kiwi_basket_db_query_duration_seconds"
+
+func TestTaskRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u, _ := username.NewUsername("user")
+	tk, _ := task.NewTask(1, "2020-04-30", "report")
+	refused := fmt.Errorf("dial tcp: connection refused")
+
+	mock := mocks.NewMockITaskRepository(ctrl)
+	mock.EXPECT().GetAll(gomock.Any(), u).Return([]task.Task{tk}, nil)
+	mock.EXPECT().Remove(gomock.Any(), u, 1).Return(refused)
+
+	before, err := testutil.GatherAndCount(prometheus.DefaultGatherer, queryDuration)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	r := NewTaskRepository(mock)
+	ts, err := r.GetAll(context.Background(), u)
+	if err != nil || len(ts) != 1 || ts[0] != tk {
+		t.Fatalf("expected: %v; got: %v %v\n", []task.Task{tk}, ts, err)
+	}
+	if err = r.Remove(context.Background(), u, 1); !errors.Is(err, refused) {
+		t.Fatalf("expected: %v; got: %v\n", refused, err)
+	}
+
+	after, err := testutil.GatherAndCount(prometheus.DefaultGatherer, queryDuration)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if after-before != 2 {
+		t.Fatalf("expected: %v; got: %v\n", 2, after-before)
+	}
+}
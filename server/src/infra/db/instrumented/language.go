@@ -0,0 +1,51 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	languageRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/language"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type LanguageRepository struct {
+	repository languageRepository.ILanguageRepository
+}
+
+func NewLanguageRepository(r languageRepository.ILanguageRepository) languageRepository.ILanguageRepository {
+	return &LanguageRepository{r}
+}
+
+func (r *LanguageRepository) Set(ctx context.Context, u username.Username, l language.Language) error {
+	start := time.Now()
+	err := r.repository.Set(ctx, u, l)
+	metrics.ObserveQuery("language", "Set", start, err)
+
+	return err
+}
+
+func (r *LanguageRepository) Exists(ctx context.Context, u username.Username) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, u)
+	metrics.ObserveQuery("language", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *LanguageRepository) Get(ctx context.Context, u username.Username) (language.Language, error) {
+	start := time.Now()
+	v, err := r.repository.Get(ctx, u)
+	metrics.ObserveQuery("language", "Get", start, err)
+
+	return v, err
+}
+
+func (r *LanguageRepository) Remove(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u)
+	metrics.ObserveQuery("language", "Remove", start, err)
+
+	return err
+}
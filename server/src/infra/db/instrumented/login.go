@@ -0,0 +1,51 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/login"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type LoginRepository struct {
+	repository loginRepository.ILoginRepository
+}
+
+func NewLoginRepository(r loginRepository.ILoginRepository) loginRepository.ILoginRepository {
+	return &LoginRepository{r}
+}
+
+func (r *LoginRepository) Create(ctx context.Context, l login.Login) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, l)
+	metrics.ObserveQuery("login", "Create", start, err)
+
+	return err
+}
+
+func (r *LoginRepository) Delete(ctx context.Context, l login.Login) error {
+	start := time.Now()
+	err := r.repository.Delete(ctx, l)
+	metrics.ObserveQuery("login", "Delete", start, err)
+
+	return err
+}
+
+func (r *LoginRepository) Exists(ctx context.Context, u username.Username) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, u)
+	metrics.ObserveQuery("login", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *LoginRepository) Get(ctx context.Context, u username.Username) (login.Login, error) {
+	start := time.Now()
+	v, err := r.repository.Get(ctx, u)
+	metrics.ObserveQuery("login", "Get", start, err)
+
+	return v, err
+}
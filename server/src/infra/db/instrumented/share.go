@@ -0,0 +1,68 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/share"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	shareRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/share"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type ShareRepository struct {
+	repository shareRepository.IShareRepository
+}
+
+func NewShareRepository(r shareRepository.IShareRepository) shareRepository.IShareRepository {
+	return &ShareRepository{r}
+}
+
+func (r *ShareRepository) Create(ctx context.Context, s share.Share) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, s)
+	metrics.ObserveQuery("share", "Create", start, err)
+
+	return err
+}
+
+func (r *ShareRepository) GetAll(ctx context.Context, u username.Username) ([]share.Share, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, u)
+	metrics.ObserveQuery("share", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *ShareRepository) Exists(ctx context.Context, t token.Token) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, t)
+	metrics.ObserveQuery("share", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *ShareRepository) GetByToken(ctx context.Context, t token.Token) (share.Share, error) {
+	start := time.Now()
+	v, err := r.repository.GetByToken(ctx, t)
+	metrics.ObserveQuery("share", "GetByToken", start, err)
+
+	return v, err
+}
+
+func (r *ShareRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u, id)
+	metrics.ObserveQuery("share", "Remove", start, err)
+
+	return err
+}
+
+func (r *ShareRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveAll(ctx, u)
+	metrics.ObserveQuery("share", "RemoveAll", start, err)
+
+	return err
+}
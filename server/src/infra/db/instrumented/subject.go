@@ -0,0 +1,67 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type SubjectRepository struct {
+	repository subjectRepository.ISubjectRepository
+}
+
+func NewSubjectRepository(r subjectRepository.ISubjectRepository) subjectRepository.ISubjectRepository {
+	return &SubjectRepository{r}
+}
+
+func (r *SubjectRepository) Create(ctx context.Context, u username.Username, s subject.Subject) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, u, s)
+	metrics.ObserveQuery("subject", "Create", start, err)
+
+	return err
+}
+
+func (r *SubjectRepository) Exists(ctx context.Context, u username.Username, id int) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, u, id)
+	metrics.ObserveQuery("subject", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *SubjectRepository) GetAll(ctx context.Context, u username.Username) ([]subject.Subject, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, u)
+	metrics.ObserveQuery("subject", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *SubjectRepository) Update(ctx context.Context, u username.Username, s subject.Subject) error {
+	start := time.Now()
+	err := r.repository.Update(ctx, u, s)
+	metrics.ObserveQuery("subject", "Update", start, err)
+
+	return err
+}
+
+func (r *SubjectRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u, id)
+	metrics.ObserveQuery("subject", "Remove", start, err)
+
+	return err
+}
+
+func (r *SubjectRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveAll(ctx, u)
+	metrics.ObserveQuery("subject", "RemoveAll", start, err)
+
+	return err
+}
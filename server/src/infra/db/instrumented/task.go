@@ -0,0 +1,51 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	taskRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/task"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type TaskRepository struct {
+	repository taskRepository.ITaskRepository
+}
+
+func NewTaskRepository(r taskRepository.ITaskRepository) taskRepository.ITaskRepository {
+	return &TaskRepository{r}
+}
+
+func (r *TaskRepository) Create(ctx context.Context, u username.Username, t task.Task) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, u, t)
+	metrics.ObserveQuery("task", "Create", start, err)
+
+	return err
+}
+
+func (r *TaskRepository) GetAll(ctx context.Context, u username.Username) ([]task.Task, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, u)
+	metrics.ObserveQuery("task", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *TaskRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u, id)
+	metrics.ObserveQuery("task", "Remove", start, err)
+
+	return err
+}
+
+func (r *TaskRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveAll(ctx, u)
+	metrics.ObserveQuery("task", "RemoveAll", start, err)
+
+	return err
+}
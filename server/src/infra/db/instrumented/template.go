@@ -0,0 +1,74 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/template"
+	templateRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/template"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type TemplateRepository struct {
+	repository templateRepository.ITemplateRepository
+}
+
+func NewTemplateRepository(r templateRepository.ITemplateRepository) templateRepository.ITemplateRepository {
+	return &TemplateRepository{r}
+}
+
+func (r *TemplateRepository) Create(ctx context.Context, t template.Template) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, t)
+	metrics.ObserveQuery("template", "Create", start, err)
+
+	return err
+}
+
+func (r *TemplateRepository) Exists(ctx context.Context, id int) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, id)
+	metrics.ObserveQuery("template", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *TemplateRepository) Get(ctx context.Context, id int) (template.Template, error) {
+	start := time.Now()
+	v, err := r.repository.Get(ctx, id)
+	metrics.ObserveQuery("template", "Get", start, err)
+
+	return v, err
+}
+
+func (r *TemplateRepository) GetAll(ctx context.Context, id int) ([]template.Template, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, id)
+	metrics.ObserveQuery("template", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *TemplateRepository) Update(ctx context.Context, t template.Template) error {
+	start := time.Now()
+	err := r.repository.Update(ctx, t)
+	metrics.ObserveQuery("template", "Update", start, err)
+
+	return err
+}
+
+func (r *TemplateRepository) Remove(ctx context.Context, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, id)
+	metrics.ObserveQuery("template", "Remove", start, err)
+
+	return err
+}
+
+func (r *TemplateRepository) RemoveAll(ctx context.Context, id int) error {
+	start := time.Now()
+	err := r.repository.RemoveAll(ctx, id)
+	metrics.ObserveQuery("template", "RemoveAll", start, err)
+
+	return err
+}
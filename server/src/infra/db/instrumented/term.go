@@ -0,0 +1,51 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type TermRepository struct {
+	repository termRepository.ITermRepository
+}
+
+func NewTermRepository(r termRepository.ITermRepository) termRepository.ITermRepository {
+	return &TermRepository{r}
+}
+
+func (r *TermRepository) Create(ctx context.Context, u username.Username, t term.Term) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, u, t)
+	metrics.ObserveQuery("term", "Create", start, err)
+
+	return err
+}
+
+func (r *TermRepository) GetAll(ctx context.Context, u username.Username) ([]term.Term, error) {
+	start := time.Now()
+	v, err := r.repository.GetAll(ctx, u)
+	metrics.ObserveQuery("term", "GetAll", start, err)
+
+	return v, err
+}
+
+func (r *TermRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	start := time.Now()
+	err := r.repository.Remove(ctx, u, id)
+	metrics.ObserveQuery("term", "Remove", start, err)
+
+	return err
+}
+
+func (r *TermRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	start := time.Now()
+	err := r.repository.RemoveAll(ctx, u)
+	metrics.ObserveQuery("term", "RemoveAll", start, err)
+
+	return err
+}
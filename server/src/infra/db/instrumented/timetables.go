@@ -0,0 +1,91 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+)
+
+type TimetablesRepository struct {
+	repository timetablesRepository.ITimetablesRepository
+}
+
+func NewTimetablesRepository(r timetablesRepository.ITimetablesRepository) timetablesRepository.ITimetablesRepository {
+	return &TimetablesRepository{r}
+}
+
+func (r *TimetablesRepository) Create(ctx context.Context, u username.Username, id int, t timetables.Timetables) error {
+	start := time.Now()
+	err := r.repository.Create(ctx, u, id, t)
+	metrics.ObserveQuery("timetables", "Create", start, err)
+
+	return err
+}
+
+func (r *TimetablesRepository) Delete(ctx context.Context, u username.Username, id int) error {
+	start := time.Now()
+	err := r.repository.Delete(ctx, u, id)
+	metrics.ObserveQuery("timetables", "Delete", start, err)
+
+	return err
+}
+
+func (r *TimetablesRepository) Exists(ctx context.Context, u username.Username, id int) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.Exists(ctx, u, id)
+	metrics.ObserveQuery("timetables", "Exists", start, err)
+
+	return v, err
+}
+
+func (r *TimetablesRepository) Get(ctx context.Context, u username.Username, id int) (timetables.Timetables, error) {
+	start := time.Now()
+	v, err := r.repository.Get(ctx, u, id)
+	metrics.ObserveQuery("timetables", "Get", start, err)
+
+	return v, err
+}
+
+func (r *TimetablesRepository) GetVersions(ctx context.Context, u username.Username, id int) ([]timetables.Version, error) {
+	start := time.Now()
+	v, err := r.repository.GetVersions(ctx, u, id)
+	metrics.ObserveQuery("timetables", "GetVersions", start, err)
+
+	return v, err
+}
+
+func (r *TimetablesRepository) VersionExists(ctx context.Context, u username.Username, id int, id2 int) (bool, error) {
+	start := time.Now()
+	v, err := r.repository.VersionExists(ctx, u, id, id2)
+	metrics.ObserveQuery("timetables", "VersionExists", start, err)
+
+	return v, err
+}
+
+func (r *TimetablesRepository) GetVersion(ctx context.Context, u username.Username, id int, id2 int) (timetables.Version, error) {
+	start := time.Now()
+	v, err := r.repository.GetVersion(ctx, u, id, id2)
+	metrics.ObserveQuery("timetables", "GetVersion", start, err)
+
+	return v, err
+}
+
+func (r *TimetablesRepository) DeleteWithVersions(ctx context.Context, u username.Username, id int) error {
+	start := time.Now()
+	err := r.repository.DeleteWithVersions(ctx, u, id)
+	metrics.ObserveQuery("timetables", "DeleteWithVersions", start, err)
+
+	return err
+}
+
+func (r *TimetablesRepository) MoveTerm(ctx context.Context, u username.Username, id int, id2 int) error {
+	start := time.Now()
+	err := r.repository.MoveTerm(ctx, u, id, id2)
+	metrics.ObserveQuery("timetables", "MoveTerm", start, err)
+
+	return err
+}
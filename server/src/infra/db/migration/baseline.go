@@ -0,0 +1,288 @@
+package migration
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// baseline are the tables which the repositories created on their own before migrations were introduced,
+// in the order they were created.
+var baseline = []string{
+	"logins",
+	"auths",
+	"tasks",
+	"terms",
+	"subjects",
+	"timetables",
+	"timetable",
+	"classes",
+	"timetables_versions",
+	"exceptions",
+	"bells",
+	"feeds",
+	"shares",
+	"groups",
+	"group_members",
+	"attendances",
+	"attendance_policies",
+	"scores",
+	"grading_scales",
+	"exams",
+	"timetable_limits",
+	"institutions",
+	"institution_members",
+	"templates",
+	"template_classes",
+}
+
+// createTables creates the tables as AutoMigrate did, which only adds what is
+// missing, so databases created before migrations were introduced adopt it as well.
+// The records are copies of those of the repositories at the time, which later
+// migrations change, so that the tables are created the same way whatever the
+// repositories become.
+func createTables(tx *gorm.DB) error {
+	type login struct {
+		Username string `gorm:"primary_key"`
+		Password string
+	}
+
+	type auth struct {
+		Username string `gorm:"primary_key"`
+		Token    string `gorm:"primary_key"`
+	}
+
+	type task struct {
+		ID       uint `gorm:"primary_key;auto_increment"`
+		Username string
+		Date     time.Time
+		Title    string
+	}
+
+	type term struct {
+		ID       uint `gorm:"primary_key;auto_increment"`
+		Username string
+		Name     string
+		Start    time.Time
+		End      time.Time
+	}
+
+	type subject struct {
+		ID         uint   `gorm:"primary_key;auto_increment"`
+		Username   string `gorm:"unique_index:idx_subject_username_name"`
+		Name       string `gorm:"unique_index:idx_subject_username_name"`
+		Instructor string
+		Credits    int
+		Color      string
+		Syllabus   string `gorm:"size:510"`
+		Room       string
+	}
+
+	type timetables struct {
+		Username                string `gorm:"primary_key"`
+		TermID                  uint   `gorm:"primary_key;auto_increment:false;default:0"`
+		Mon, Tue, Wed, Thu, Fri uint
+	}
+
+	type timetable struct {
+		ID                          uint `gorm:"primary_key;auto_increment"`
+		Day                         string
+		One, Two, Three, Four, Five *uint
+	}
+
+	type class struct {
+		ID        uint `gorm:"primary_key;auto_increment"`
+		Subject   string
+		Room      sql.NullString
+		Memo      string `gorm:"size:510"`
+		Weeks     string
+		Dates     string `gorm:"size:510"`
+		Next      *uint
+		SubjectID *uint `gorm:"index"`
+	}
+
+	type version struct {
+		ID                      uint   `gorm:"primary_key;auto_increment"`
+		Username                string `gorm:"index"`
+		TermID                  uint
+		Number                  int
+		SavedAt                 time.Time
+		Mon, Tue, Wed, Thu, Fri uint
+	}
+
+	type exception struct {
+		ID       uint `gorm:"primary_key;auto_increment"`
+		Username string
+		Kind     string
+		Date     time.Time
+		Period   int
+		Subject  string
+		Room     sql.NullString
+		Memo     string `gorm:"size:510"`
+		ToDate   *time.Time
+		ToPeriod int
+	}
+
+	type bell struct {
+		Username string `gorm:"primary_key"`
+		TimeZone string
+		Periods  string
+	}
+
+	type feed struct {
+		Username string `gorm:"primary_key"`
+		Token    string `gorm:"unique_index"`
+	}
+
+	type share struct {
+		ID        uint   `gorm:"primary_key;auto_increment"`
+		Username  string `gorm:"index"`
+		Token     string `gorm:"unique_index"`
+		ShowRoom  bool
+		ShowMemo  bool
+		ExpiresAt *time.Time
+	}
+
+	type group struct {
+		ID    uint `gorm:"primary_key;auto_increment"`
+		Name  string
+		Owner string `gorm:"index"`
+	}
+
+	type groupMember struct {
+		GroupID  uint   `gorm:"primary_key;auto_increment:false"`
+		Username string `gorm:"primary_key"`
+		Joined   bool
+		Sharing  bool
+	}
+
+	type attendance struct {
+		Username  string    `gorm:"primary_key"`
+		Date      time.Time `gorm:"primary_key"`
+		Period    int       `gorm:"primary_key;auto_increment:false"`
+		Status    string
+		SubjectID uint
+		Subject   string
+	}
+
+	type policy struct {
+		Username    string `gorm:"primary_key"`
+		Weeks       int
+		Numerator   int
+		Denominator int
+		Lates       int
+		Margin      int
+	}
+
+	type score struct {
+		ID        uint   `gorm:"primary_key;auto_increment"`
+		Username  string `gorm:"index"`
+		SubjectID uint
+		Title     string
+		Points    float64
+		Max       float64
+		Weight    float64
+	}
+
+	type scale struct {
+		Username string `gorm:"primary_key"`
+		Bands    string `gorm:"size:1020"`
+	}
+
+	type exam struct {
+		ID        uint   `gorm:"primary_key;auto_increment"`
+		Username  string `gorm:"index"`
+		SubjectID uint
+		Subject   string
+		TermID    uint
+		Title     string
+		Start     time.Time
+		End       time.Time
+		Room      string
+	}
+
+	type limits struct {
+		Username string `gorm:"primary_key"`
+		Slots    int
+		Credits  int
+	}
+
+	type institution struct {
+		ID   uint `gorm:"primary_key;auto_increment"`
+		Name string
+	}
+
+	type institutionMember struct {
+		InstitutionID uint   `gorm:"primary_key;auto_increment:false"`
+		Username      string `gorm:"primary_key"`
+		Role          string
+	}
+
+	type template struct {
+		ID            uint `gorm:"primary_key;auto_increment"`
+		InstitutionID uint `gorm:"index"`
+		Name          string
+		Course        string
+		Year          int
+	}
+
+	type templateClass struct {
+		ID         uint `gorm:"primary_key;auto_increment"`
+		TemplateID uint `gorm:"index"`
+		Day        time.Weekday
+		Period     int
+		Position   int
+		Subject    string
+		Room       sql.NullString
+		Memo       string `gorm:"size:510"`
+		Weeks      string
+		Dates      string `gorm:"size:510"`
+	}
+
+	records := []interface{}{
+		login{},
+		auth{},
+		task{},
+		term{},
+		subject{},
+		timetables{},
+		timetable{},
+		class{},
+		version{},
+		exception{},
+		bell{},
+		feed{},
+		share{},
+		group{},
+		groupMember{},
+		attendance{},
+		policy{},
+		score{},
+		scale{},
+		exam{},
+		limits{},
+		institution{},
+		institutionMember{},
+		template{},
+		templateClass{},
+	}
+
+	for i, r := range records {
+		if err := tx.Table(baseline[i]).AutoMigrate(r).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dropTables(tx *gorm.DB) error {
+	for i := len(baseline) - 1; i >= 0; i-- {
+		if err := tx.DropTableIfExists(baseline[i]).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
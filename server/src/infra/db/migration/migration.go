@@ -0,0 +1,171 @@
+package migration
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+const (
+	SchemaBehind      = "database schema is behind the server; run the migrate up command"
+	SchemaAhead       = "database schema is ahead of the server; upgrade the server or run the migrate down command with it"
+	InvalidMigrations = "migrations must be numbered from 1 without gaps"
+	InvalidSteps      = "number of steps must be at least 1"
+	MissingDown       = "migration cannot be reverted"
+)
+
+// Migration is a change of the schema or of the data. Down reverts what Up does,
+// and is nil if the change cannot be reverted.
+// A migration must not depend on records of the repositories, which change with
+// later migrations, except for creating their tables the first time.
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(*gorm.DB) error
+	Down    func(*gorm.DB) error
+}
+
+// SchemaMigration is a migration applied to the database.
+type SchemaMigration struct {
+	Version   uint `gorm:"primary_key;auto_increment:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (s SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+type Migrator struct {
+	dbHandler  *handler.DbHandler
+	migrations []Migration
+}
+
+func NewMigrator(h *handler.DbHandler, ms []Migration) Migrator {
+	return Migrator{h, ms}
+}
+
+// Latest returns the version of the schema the server expects.
+func (m Migrator) Latest() int {
+	return len(m.migrations)
+}
+
+// Current returns the version of the schema of the database, which is 0 before any migrations.
+func (m Migrator) Current() (int, error) {
+	if err := m.prepare(); err != nil {
+		return 0, err
+	}
+
+	applied := SchemaMigration{}
+	err := m.dbHandler.Db.Order("version desc").Take(&applied).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return int(applied.Version), nil
+}
+
+// Check fails unless the schema of the database is the one the server expects.
+func (m Migrator) Check() error {
+	current, err := m.Current()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case current < m.Latest():
+		return fmt.Errorf(SchemaBehind)
+	case current > m.Latest():
+		return fmt.Errorf(SchemaAhead)
+	}
+
+	return nil
+}
+
+// Up applies the migrations not applied yet in order, and returns the ones applied.
+// Each migration is applied in a transaction together with its record, so a failed
+// migration is not recorded, though statements which the DBMS commits implicitly
+// such as DDL of MySQL are not rolled back.
+func (m Migrator) Up() ([]Migration, error) {
+	current, err := m.Current()
+	if err != nil {
+		return nil, err
+	}
+	if current > m.Latest() {
+		return nil, fmt.Errorf(SchemaAhead)
+	}
+
+	applied := make([]Migration, 0)
+	for _, mg := range m.migrations[current:] {
+		err := m.dbHandler.Db.Transaction(func(tx *gorm.DB) error {
+			if err := mg.Up(tx); err != nil {
+				return err
+			}
+
+			return tx.Create(&SchemaMigration{uint(mg.Version), mg.Name, time.Now()}).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("%d %s: %v", mg.Version, mg.Name, err)
+		}
+		applied = append(applied, mg)
+	}
+
+	return applied, nil
+}
+
+// Down reverts the last steps migrations applied in reverse order, and returns the ones reverted.
+func (m Migrator) Down(steps int) ([]Migration, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf(InvalidSteps)
+	}
+
+	current, err := m.Current()
+	if err != nil {
+		return nil, err
+	}
+	if current > m.Latest() {
+		return nil, fmt.Errorf(SchemaAhead)
+	}
+
+	reverted := make([]Migration, 0)
+	for v := current; v > 0 && len(reverted) < steps; v-- {
+		mg := m.migrations[v-1]
+		if mg.Down == nil {
+			return reverted, fmt.Errorf("%d %s: %s", mg.Version, mg.Name, MissingDown)
+		}
+
+		err := m.dbHandler.Db.Transaction(func(tx *gorm.DB) error {
+			if err := mg.Down(tx); err != nil {
+				return err
+			}
+
+			return tx.Where("version = ?", mg.Version).Delete(SchemaMigration{}).Error
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("%d %s: %v", mg.Version, mg.Name, err)
+		}
+		reverted = append(reverted, mg)
+	}
+
+	return reverted, nil
+}
+
+// prepare validates the migrations and creates the table of the migrations applied.
+func (m Migrator) prepare() error {
+	for i, mg := range m.migrations {
+		if mg.Version != i+1 || mg.Up == nil {
+			return fmt.Errorf(InvalidMigrations)
+		}
+	}
+
+	if m.dbHandler.Db.HasTable(SchemaMigration{}) {
+		return nil
+	}
+
+	return m.dbHandler.Db.CreateTable(SchemaMigration{}).Error
+}
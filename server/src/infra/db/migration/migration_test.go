@@ -0,0 +1,423 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+	institutionDb "github.com/team-gleam/kiwi-basket/server/src/infra/db/institution"
+	subjectDb "github.com/team-gleam/kiwi-basket/server/src/infra/db/subject"
+	termDb "github.com/team-gleam/kiwi-basket/server/src/infra/db/term"
+	timetablesDb "github.com/team-gleam/kiwi-basket/server/src/infra/db/timetables"
+)
+
+func newHandler(t *testing.T) (*handler.DbHandler, func()) {
+	dir, err := ioutil.TempDir("", "migration")
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	h, err := handler.NewDbHandler(handler.Config{DBMS: handler.SQLite, Path: filepath.Join(dir, "test.db")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	return h, func() {
+		h.Db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+type note struct {
+	ID   uint `gorm:"primary_key;auto_increment"`
+	Text string
+}
+
+var notes = []Migration{
+	{
+		1,
+		"create notes",
+		func(tx *gorm.DB) error { return tx.CreateTable(note{}).Error },
+		func(tx *gorm.DB) error { return tx.DropTable(note{}).Error },
+	},
+	{
+		2,
+		"add a note",
+		func(tx *gorm.DB) error { return tx.Create(&note{Text: "hello"}).Error },
+		func(tx *gorm.DB) error { return tx.Delete(note{}).Error },
+	},
+}
+
+func TestUpAndDown(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	m := NewMigrator(h, notes)
+	if err := m.Check(); err == nil || err.Error() != SchemaBehind {
+		t.Fatalf("expected: %v; got: %v\n", SchemaBehind, err)
+	}
+
+	applied, err := m.Up()
+	if err != nil || len(applied) != 2 {
+		t.Fatalf("expected: %v; got: %v %v\n", 2, applied, err)
+	}
+	if err = m.Check(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	if applied, err = m.Up(); err != nil || len(applied) != 0 {
+		t.Fatalf("expected no migrations; got: %v %v\n", applied, err)
+	}
+
+	reverted, err := m.Down(1)
+	if err != nil || len(reverted) != 1 || reverted[0].Version != 2 {
+		t.Fatalf("expected: %v; got: %v %v\n", 2, reverted, err)
+	}
+	if current, _ := m.Current(); current != 1 {
+		t.Fatalf("expected: %v; got: %v\n", 1, current)
+	}
+	var count int
+	h.Db.Model(note{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("expected: %v; got: %v\n", 0, count)
+	}
+
+	if reverted, err = m.Down(5); err != nil || len(reverted) != 1 {
+		t.Fatalf("expected: %v; got: %v %v\n", 1, reverted, err)
+	}
+	if h.Db.HasTable(note{}) {
+		t.Fatalf("expected the table to be dropped\n")
+	}
+}
+
+func TestSchemaAhead(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	if _, err := NewMigrator(h, notes).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	m := NewMigrator(h, notes[:1])
+	if err := m.Check(); err == nil || err.Error() != SchemaAhead {
+		t.Fatalf("expected: %v; got: %v\n", SchemaAhead, err)
+	}
+	if _, err := m.Up(); err == nil || err.Error() != SchemaAhead {
+		t.Fatalf("expected: %v; got: %v\n", SchemaAhead, err)
+	}
+}
+
+func TestFailedMigration(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	failing := append(notes[:1:1], Migration{
+		2,
+		"fail",
+		func(tx *gorm.DB) error {
+			if err := tx.Create(&note{Text: "partial"}).Error; err != nil {
+				return err
+			}
+			return fmt.Errorf("failed")
+		},
+		nil,
+	})
+
+	m := NewMigrator(h, failing)
+	applied, err := m.Up()
+	if err == nil || len(applied) != 1 {
+		t.Fatalf("expected an error after %v; got: %v %v\n", 1, applied, err)
+	}
+	if current, _ := m.Current(); current != 1 {
+		t.Fatalf("expected: %v; got: %v\n", 1, current)
+	}
+	var count int
+	h.Db.Model(note{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("expected: %v; got: %v\n", 0, count)
+	}
+}
+
+func TestInvalidMigrations(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	_, err := NewMigrator(h, notes[1:]).Up()
+	if err == nil || err.Error() != InvalidMigrations {
+		t.Fatalf("expected: %v; got: %v\n", InvalidMigrations, err)
+	}
+}
+
+func TestMigrations(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	m := NewMigrator(h, Migrations)
+	if _, err := m.Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if err := m.Check(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	_, err := m.Down(m.Latest())
+	if err == nil {
+		t.Fatalf("expected an error for an irreversible migration\n")
+	}
+
+	_, err = NewMigrator(h, Migrations[:1]).Down(1)
+	if err == nil || err.Error() != SchemaAhead {
+		t.Fatalf("expected: %v; got: %v\n", SchemaAhead, err)
+	}
+}
+
+func TestLinkSubjects(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	if _, err := NewMigrator(h, Migrations[:1]).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	// A class stored before the catalog was introduced.
+	class := timetablesDb.NewClass("Math", "101", "")
+	h.Db.Create(&class)
+	day := timetablesDb.NewTimetable("mon", &class.ID, nil, nil, nil, nil)
+	h.Db.Create(&day)
+	h.Db.Create(&timetablesDb.Timetables{Username: "gleam", Mon: day.ID, Tue: day.ID, Wed: day.ID, Thu: day.ID, Fri: day.ID})
+
+	if _, err := NewMigrator(h, Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	linked := timetablesDb.Class{}
+	h.Db.Where("id = ?", class.ID).Take(&linked)
+	subject := subjectDb.Subject{}
+	h.Db.Where("username = ? AND name = ?", "gleam", "Math").Take(&subject)
+	if linked.SubjectID == nil || *linked.SubjectID != subject.ID {
+		t.Fatalf("expected: %v; got: %v\n", subject.ID, linked.SubjectID)
+	}
+}
+
+func TestKeyTimetablesByTerm(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	// The table of timetables created before terms were introduced, keyed by the users alone.
+	type timetables struct {
+		Username                string `gorm:"primary_key"`
+		Mon, Tue, Wed, Thu, Fri uint
+	}
+	if err := h.Db.Table("timetables").CreateTable(timetables{}).Error; err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	h.Db.Table("timetables").Create(&timetables{Username: "gleam", Mon: 1, Tue: 2, Wed: 3, Thu: 4, Fri: 5})
+
+	m := NewMigrator(h, Migrations[:5])
+	if _, err := m.Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	kept := timetablesDb.Timetables{}
+	h.Db.Where("username = ? AND term_id = ?", "gleam", 0).Take(&kept)
+	if expected := timetablesDb.NewTimetables("gleam", 0, 1, 2, 3, 4, 5); kept != expected {
+		t.Fatalf("expected: %v; got: %v\n", expected, kept)
+	}
+
+	if err := h.Db.Create(timetablesDb.NewTimetables("gleam", 1, 6, 7, 8, 9, 10)).Error; err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if err := h.Db.Create(timetablesDb.NewTimetables("gleam", 1, 6, 7, 8, 9, 10)).Error; err == nil {
+		t.Fatalf("expected an error for a duplicate timetable of the term\n")
+	}
+
+	if _, err := m.Down(1); err == nil || err.Error() != "5 key timetables by term: "+SeveralTerms {
+		t.Fatalf("expected: %v; got: %v\n", SeveralTerms, err)
+	}
+
+	h.Db.Where("username = ? AND term_id = ?", "gleam", 1).Delete(timetablesDb.Timetables{})
+	if _, err := m.Down(1); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if err := h.Db.Create(timetablesDb.NewTimetables("gleam", 1, 6, 7, 8, 9, 10)).Error; err == nil {
+		t.Fatalf("expected an error for a second timetable of the user\n")
+	}
+}
+
+func TestAdoptTimetables(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	if _, err := NewMigrator(h, Migrations[:5]).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	// Timetables registered before the users had terms, one of whom has registered terms since.
+	h.Db.Create(timetablesDb.NewTimetables("gleam", 0, 1, 2, 3, 4, 5))
+	h.Db.Create(&timetablesDb.Version{Username: "gleam", Number: 1, Mon: 1, Tue: 2, Wed: 3, Thu: 4, Fri: 5})
+	h.Db.Create(timetablesDb.NewTimetables("kiwi", 0, 6, 7, 8, 9, 10))
+	h.Db.Create(&termDb.Term{Username: "gleam", Name: "spring"})
+	h.Db.Create(&termDb.Term{Username: "gleam", Name: "fall"})
+
+	if _, err := NewMigrator(h, Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	first := termDb.Term{}
+	h.Db.Where("username = ? AND name = ?", "gleam", "spring").Take(&first)
+
+	var count int
+	h.Db.Model(timetablesDb.Timetables{}).Where("username = ? AND term_id = ?", "gleam", first.ID).Count(&count)
+	if count != 1 {
+		t.Fatalf("expected the timetables to be moved to the first term; got: %v\n", count)
+	}
+	h.Db.Model(timetablesDb.Version{}).Where("username = ? AND term_id = ?", "gleam", first.ID).Count(&count)
+	if count != 1 {
+		t.Fatalf("expected the version to be moved to the first term; got: %v\n", count)
+	}
+	h.Db.Model(timetablesDb.Timetables{}).Where("username = ? AND term_id = 0", "kiwi").Count(&count)
+	if count != 1 {
+		t.Fatalf("expected the timetables of a user without terms to be kept; got: %v\n", count)
+	}
+}
+
+func TestSnapshotVersions(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	if _, err := NewMigrator(h, Migrations[:6]).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	// A version saved before the versions kept the subjects of their classes.
+	subject := subjectDb.Subject{Username: "gleam", Name: "Math", Room: "101"}
+	h.Db.Create(&subject)
+	class := timetablesDb.NewNoRoomClass("", "")
+	class.SubjectID = &subject.ID
+	h.Db.Create(&class)
+	day := timetablesDb.NewTimetable("mon", &class.ID, nil, nil, nil, nil)
+	h.Db.Create(&day)
+	h.Db.Create(&timetablesDb.Version{Username: "gleam", Number: 1, Mon: day.ID, Tue: day.ID, Wed: day.ID, Thu: day.ID, Fri: day.ID})
+
+	if _, err := NewMigrator(h, Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	snapshot := timetablesDb.Class{}
+	h.Db.Where("id = ?", class.ID).Take(&snapshot)
+	if snapshot.Subject != "Math" || snapshot.Room.String != "101" {
+		t.Fatalf("expected: %v %v; got: %v %v\n", "Math", "101", snapshot.Subject, snapshot.Room.String)
+	}
+}
+
+func TestRemovedSubjectStaysRemoved(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	if _, err := NewMigrator(h, Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	subject := subjectDb.Subject{Username: "gleam", Name: "Math", Room: "101"}
+	h.Db.Create(&subject)
+	class := timetablesDb.NewNoRoomClass("Math", "")
+	class.SubjectID = &subject.ID
+	h.Db.Create(&class)
+	day := timetablesDb.NewTimetable("mon", &class.ID, nil, nil, nil, nil)
+	h.Db.Create(&day)
+	h.Db.Create(&timetablesDb.Timetables{Username: "gleam", Mon: day.ID, Tue: day.ID, Wed: day.ID, Thu: day.ID, Fri: day.ID})
+
+	u, _ := username.NewUsername("gleam")
+	if err := subjectDb.NewSubjectRepository(h).Remove(context.Background(), u, int(subject.ID)); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	// Linking the classes again, as reverting and applying the migration does, must not add the subject back.
+	if err := linkSubjects(h.Db); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	var count int
+	h.Db.Model(subjectDb.Subject{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("expected the subject to stay removed; got: %v\n", count)
+	}
+
+	ts, err := timetablesDb.NewTimetablesRepository(h).Get(context.Background(), u, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	c := ts.Mon().First().Classes()[0]
+	if c.Subject() != "Math" || c.Room() != "101" || c.SubjectID() != 0 {
+		t.Fatalf("expected: %v %v %v; got: %v %v %v\n", "Math", "101", 0, c.Subject(), c.Room(), c.SubjectID())
+	}
+}
+
+func TestInviteMembers(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	if _, err := NewMigrator(h, Migrations[:8]).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	// Members added by the admins before they had to accept the invitations.
+	h.Db.Exec("INSERT INTO institution_members (institution_id, username, role) VALUES (1, 'admin', 'admin'), (1, 'student', 'student')")
+
+	if _, err := NewMigrator(h, Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	ms := make([]institutionDb.InstitutionMember, 0)
+	if err := h.Db.Order("username").Find(&ms).Error; err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if len(ms) != 2 || !ms[0].Joined || ms[1].Joined {
+		t.Fatalf("expected the admin to stay joined and the student to be invited; got: %v\n", ms)
+	}
+}
+
+func TestNumberVersions(t *testing.T) {
+	h, cleanup := newHandler(t)
+	defer cleanup()
+
+	if _, err := NewMigrator(h, Migrations[:9]).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	// Versions numbered the same by concurrent saves, and those of another term.
+	for _, v := range []timetablesDb.Version{
+		{Username: "gleam", Number: 1},
+		{Username: "gleam", Number: 2},
+		{Username: "gleam", Number: 2},
+		{Username: "gleam", Number: 3},
+		{Username: "gleam", TermID: 1, Number: 1},
+	} {
+		h.Db.Create(&v)
+	}
+
+	if _, err := NewMigrator(h, Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	vs := make([]timetablesDb.Version, 0)
+	h.Db.Order("id").Find(&vs)
+	numbers := make([]int, 0)
+	for _, v := range vs {
+		numbers = append(numbers, v.Number)
+	}
+	if expected := []int{1, 2, 3, 4, 1}; fmt.Sprint(numbers) != fmt.Sprint(expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, numbers)
+	}
+
+	err := h.Db.Create(&timetablesDb.Version{Username: "gleam", Number: 4}).Error
+	if !handler.IsConflict(err) {
+		t.Fatalf("expected a conflict; got: %v\n", err)
+	}
+}
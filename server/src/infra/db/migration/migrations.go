@@ -0,0 +1,404 @@
+package migration
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	languageDb "github.com/team-gleam/kiwi-basket/server/src/infra/db/user/language"
+)
+
+// Migrations are the migrations of the schema in order. New migrations are appended
+// with the next version, and applied migrations must not be changed.
+var Migrations = []Migration{
+	{1, "create tables", createTables, dropTables},
+	{2, "link classes to subjects", linkSubjects, keepSubjects},
+	{3, "create languages", createLanguages, dropLanguages},
+	{4, "add expiry to tokens", addTokenExpiry, nil},
+	{5, "key timetables by term", keyTimetablesByTerm, keyTimetablesByUser},
+	{6, "move timetables without terms to the first terms", adoptTimetables, nil},
+	{7, "snapshot subjects of versions", snapshotVersions, keepSnapshots},
+	{8, "detach classes of removed subjects", detachClasses, undetachClasses},
+	{9, "invite members to institutions", inviteMembers, nil},
+	{10, "number versions uniquely", numberVersions, unnumberVersions},
+}
+
+const SeveralTerms = "timetables of several terms cannot be keyed by their users"
+
+// linkSubjects links the classes stored before the catalog was introduced
+// to the subjects of their owners, adding a subject for each distinct name.
+func linkSubjects(tx *gorm.DB) error {
+	type timetables struct {
+		Username                string
+		Mon, Tue, Wed, Thu, Fri uint
+	}
+
+	type timetable struct {
+		One, Two, Three, Four, Five *uint
+	}
+
+	type class struct {
+		ID        uint
+		Subject   string
+		Room      sql.NullString
+		Next      *uint
+		SubjectID *uint
+	}
+
+	type subject struct {
+		ID       uint `gorm:"primary_key;auto_increment"`
+		Username string
+		Name     string
+		Room     string
+	}
+
+	resolve := func(u string, c class) (uint, error) {
+		s := subject{}
+		err := tx.Table("subjects").Where("username = ? AND name = ?", u, c.Subject).Take(&s).Error
+		if err == nil {
+			return s.ID, nil
+		}
+		if !gorm.IsRecordNotFoundError(err) {
+			return 0, err
+		}
+
+		s = subject{Username: u, Name: c.Subject, Room: c.Room.String}
+		err = tx.Table("subjects").Create(&s).Error
+		return s.ID, err
+	}
+
+	link := func(u string, id *uint) error {
+		for id != nil {
+			c := class{}
+			if err := tx.Table("classes").Where("id = ?", *id).Take(&c).Error; err != nil {
+				return err
+			}
+
+			if c.SubjectID == nil {
+				s, err := resolve(u, c)
+				if err != nil {
+					return err
+				}
+				err = tx.Table("classes").Where("id = ?", c.ID).Update("subject_id", s).Error
+				if err != nil {
+					return err
+				}
+			}
+			id = c.Next
+		}
+
+		return nil
+	}
+
+	var unlinked int
+	err := tx.Table("classes").Where("subject_id IS NULL").Count(&unlinked).Error
+	if err != nil || unlinked == 0 {
+		return err
+	}
+
+	tss := make([]timetables, 0)
+	if err := tx.Table("timetables").Find(&tss).Error; err != nil {
+		return err
+	}
+
+	for _, ts := range tss {
+		for _, day := range []uint{ts.Mon, ts.Tue, ts.Wed, ts.Thu, ts.Fri} {
+			t := timetable{}
+			if err := tx.Table("timetable").Where("id = ?", day).Take(&t).Error; err != nil {
+				return err
+			}
+
+			for _, id := range []*uint{t.One, t.Two, t.Three, t.Four, t.Five} {
+				if err := link(ts.Username, id); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// keepSubjects reverts linkSubjects by leaving the classes linked, which the tables of
+// the first version allow. Unlinking them would lose the subjects they were moved to since,
+// and the subjects added for them may be used by other records.
+func keepSubjects(tx *gorm.DB) error {
+	return nil
+}
+
+func createLanguages(tx *gorm.DB) error {
+	return tx.CreateTable(languageDb.Language{}).Error
+}
+
+func dropLanguages(tx *gorm.DB) error {
+	return tx.DropTableIfExists(languageDb.Language{}).Error
+}
+
+// auth is the table of tokens with the expiry added by addTokenExpiry.
+type auth struct {
+	ExpiresAt *time.Time
+}
+
+func (auth) TableName() string {
+	return "auths"
+}
+
+// addTokenExpiry adds the expiry to the tokens, which is null for those issued before, as they never expire.
+// SQLite cannot drop the column, so it is not reverted.
+func addTokenExpiry(tx *gorm.DB) error {
+	return tx.AutoMigrate(auth{}).Error
+}
+
+// keyTimetablesByTerm makes the terms part of the primary key of the timetables, so that a user has one in each term.
+// Databases created before terms were introduced keep the users as the key, which AutoMigrate never changes,
+// so the table is created again with the key and the timetables are copied to it.
+func keyTimetablesByTerm(tx *gorm.DB) error {
+	type timetables struct {
+		Username                string `gorm:"primary_key"`
+		TermID                  uint   `gorm:"primary_key;auto_increment:false;default:0"`
+		Mon, Tue, Wed, Thu, Fri uint
+	}
+
+	return rebuildTimetables(tx, timetables{})
+}
+
+// keyTimetablesByUser reverts keyTimetablesByTerm unless a user has timetables of several terms,
+// which the users cannot key without losing all but one of them.
+func keyTimetablesByUser(tx *gorm.DB) error {
+	var several int
+	err := tx.Table("timetables").Select("username").Group("username").Having("COUNT(*) > 1").Count(&several).Error
+	if err != nil {
+		return err
+	}
+	if several > 0 {
+		return fmt.Errorf(SeveralTerms)
+	}
+
+	type timetables struct {
+		Username                string `gorm:"primary_key"`
+		TermID                  uint   `gorm:"default:0"`
+		Mon, Tue, Wed, Thu, Fri uint
+	}
+
+	return rebuildTimetables(tx, timetables{})
+}
+
+// rebuildTimetables creates the table of the timetables again as the record, and copies the timetables to it.
+func rebuildTimetables(tx *gorm.DB, record interface{}) error {
+	const rebuilt = "timetables_rebuilt"
+
+	if err := tx.Table(rebuilt).CreateTable(record).Error; err != nil {
+		return err
+	}
+
+	err := tx.Exec("INSERT INTO " + rebuilt + " (username, term_id, mon, tue, wed, thu, fri) " +
+		"SELECT username, COALESCE(term_id, 0), mon, tue, wed, thu, fri FROM timetables").Error
+	if err != nil {
+		return err
+	}
+
+	if err = tx.DropTable("timetables").Error; err != nil {
+		return err
+	}
+
+	return tx.Exec("ALTER TABLE " + rebuilt + " RENAME TO timetables").Error
+}
+
+// adoptTimetables moves the timetables registered before their users had any terms, and their versions,
+// to the first terms of the users who have registered terms since, which the timetables would be lost for.
+// The timetables are left without terms if the first term has its own.
+// Which timetables were moved is not recorded, so it is not reverted.
+func adoptTimetables(tx *gorm.DB) error {
+	type term struct {
+		Username string
+		ID       uint
+	}
+
+	firsts := make([]term, 0)
+	err := tx.Table("terms").Select("username, MIN(id) AS id").Group("username").Scan(&firsts).Error
+	if err != nil {
+		return err
+	}
+
+	for _, t := range firsts {
+		var own int
+		err = tx.Table("timetables").Where("username = ? AND term_id = ?", t.Username, t.ID).Count(&own).Error
+		if err != nil {
+			return err
+		}
+		if own == 0 {
+			err = tx.Table("timetables").Where("username = ? AND term_id = 0", t.Username).Update("term_id", t.ID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Table("timetables_versions").Where("username = ? AND term_id = ?", t.Username, t.ID).Count(&own).Error
+		if err != nil {
+			return err
+		}
+		if own == 0 {
+			err = tx.Table("timetables_versions").Where("username = ? AND term_id = 0", t.Username).Update("term_id", t.ID).Error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// snapshotVersions writes the names and default rooms of the subjects to the classes of the versions,
+// which were read with the current ones until the versions kept their own, so that the versions read
+// the same until the subjects change again.
+func snapshotVersions(tx *gorm.DB) error {
+	type version struct {
+		Mon, Tue, Wed, Thu, Fri uint
+	}
+
+	type timetable struct {
+		One, Two, Three, Four, Five *uint
+	}
+
+	type class struct {
+		ID        uint
+		Room      sql.NullString
+		Next      *uint
+		SubjectID *uint
+	}
+
+	type subject struct {
+		Name string
+		Room string
+	}
+
+	snapshot := func(id *uint) error {
+		for id != nil {
+			c := class{}
+			if err := tx.Table("classes").Where("id = ?", *id).Take(&c).Error; err != nil {
+				return err
+			}
+			id = c.Next
+
+			if c.SubjectID == nil {
+				continue
+			}
+			s := subject{}
+			err := tx.Table("subjects").Where("id = ?", *c.SubjectID).Take(&s).Error
+			if gorm.IsRecordNotFoundError(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			values := map[string]interface{}{"subject": s.Name}
+			if !c.Room.Valid && s.Room != "" {
+				values["room"] = s.Room
+			}
+			if err = tx.Table("classes").Where("id = ?", c.ID).Updates(values).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	vs := make([]version, 0)
+	if err := tx.Table("timetables_versions").Find(&vs).Error; err != nil {
+		return err
+	}
+
+	for _, v := range vs {
+		for _, day := range []uint{v.Mon, v.Tue, v.Wed, v.Thu, v.Fri} {
+			t := timetable{}
+			err := tx.Table("timetable").Where("id = ?", day).Take(&t).Error
+			if gorm.IsRecordNotFoundError(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			for _, id := range []*uint{t.One, t.Two, t.Three, t.Four, t.Five} {
+				if err := snapshot(id); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// keepSnapshots reverts snapshotVersions by leaving the names and rooms in the classes of the versions,
+// which are read with the current ones of their subjects again.
+func keepSnapshots(tx *gorm.DB) error {
+	return nil
+}
+
+// detachClasses marks the classes whose subjects were removed before they were marked on removal,
+// which are the only classes without subjects once linkSubjects has linked the others.
+func detachClasses(tx *gorm.DB) error {
+	return tx.Table("classes").Where("subject_id IS NULL").Update("subject_id", 0).Error
+}
+
+func undetachClasses(tx *gorm.DB) error {
+	return tx.Table("classes").Where("subject_id = 0").Update("subject_id", gorm.Expr("NULL")).Error
+}
+
+// inviteMembers adds whether the members have accepted their invitations to institutions.
+// Admins stay joined to keep managing their institutions, while students, who were added
+// without their consent, are invited to join them again.
+// SQLite cannot drop the column, so it is not reverted.
+func inviteMembers(tx *gorm.DB) error {
+	type institutionMember struct {
+		Joined bool
+	}
+
+	err := tx.Table("institution_members").AutoMigrate(institutionMember{}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Table("institution_members").Update("joined", gorm.Expr("role = ?", "admin")).Error
+}
+
+const versionNumber = "uix_timetables_versions_username_term_id_number"
+
+// numberVersions adds a unique index on the numbers of the versions of the timetables of each term,
+// which concurrent saves could number the same. Versions sharing a number are numbered again
+// in the order they were saved, and the later versions of their terms move along.
+func numberVersions(tx *gorm.DB) error {
+	type version struct {
+		ID       uint
+		Username string
+		TermID   uint
+		Number   int
+	}
+
+	vs := make([]version, 0)
+	err := tx.Table("timetables_versions").Order("username, term_id, number, id").Find(&vs).Error
+	if err != nil {
+		return err
+	}
+
+	for i, v := range vs {
+		if i == 0 || v.Username != vs[i-1].Username || v.TermID != vs[i-1].TermID || v.Number > vs[i-1].Number {
+			continue
+		}
+
+		vs[i].Number = vs[i-1].Number + 1
+		err = tx.Table("timetables_versions").Where("id = ?", v.ID).Update("number", vs[i].Number).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Table("timetables_versions").AddUniqueIndex(versionNumber, "username", "term_id", "number").Error
+}
+
+func unnumberVersions(tx *gorm.DB) error {
+	return tx.Table("timetables_versions").RemoveIndex(versionNumber).Error
+}
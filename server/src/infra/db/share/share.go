@@ -0,0 +1,132 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	shareModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/share"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	shareRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/share"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type ShareRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewShareRepository(h *handler.DbHandler) shareRepository.IShareRepository {
+	return &ShareRepository{h}
+}
+
+type Share struct {
+	ID        uint   `gorm:"primary_key;auto_increment"`
+	Username  string `gorm:"index"`
+	Token     string `gorm:"unique_index"`
+	ShowRoom  bool
+	ShowMemo  bool
+	ExpiresAt *time.Time
+}
+
+func toRecord(s shareModel.Share) Share {
+	d := Share{
+		Username: s.Username().Name(),
+		Token:    s.Token().Token(),
+		ShowRoom: s.Visibility().Room(),
+		ShowMemo: s.Visibility().Memo(),
+	}
+	if s.ID() != -1 {
+		d.ID = uint(s.ID())
+	}
+	if expires, ok := s.Expires(); ok {
+		d.ExpiresAt = &expires
+	}
+
+	return d
+}
+
+func fromRecord(s Share) (shareModel.Share, error) {
+	u, err := username.NewUsername(s.Username)
+	if err != nil {
+		return shareModel.Share{}, err
+	}
+
+	expires := time.Time{}
+	if s.ExpiresAt != nil {
+		expires = *s.ExpiresAt
+	}
+
+	return shareModel.NewShare(
+		int(s.ID),
+		u,
+		token.NewToken(s.Token),
+		shareModel.NewVisibility(s.ShowRoom, s.ShowMemo),
+		expires,
+	), nil
+}
+
+func (r *ShareRepository) Create(ctx context.Context, s shareModel.Share) error {
+	d := toRecord(s)
+	return r.dbHandler.WithContext(ctx).Create(&d).Error
+}
+
+func (r *ShareRepository) GetAll(ctx context.Context, u username.Username) ([]shareModel.Share, error) {
+	ds := make([]Share, 0)
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Order("id").Find(&ds).Error
+	if err != nil {
+		return []shareModel.Share{}, err
+	}
+
+	shares := make([]shareModel.Share, 0)
+	for _, d := range ds {
+		s, err := fromRecord(d)
+		if err != nil {
+			return shares, err
+		}
+		shares = append(shares, s)
+	}
+
+	return shares, nil
+}
+
+func (r *ShareRepository) Exists(ctx context.Context, t token.Token) (bool, error) {
+	s := new(Share)
+	err := r.dbHandler.WithContext(ctx).Where("token = ?", t.Token()).Take(s).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return s.Token != "", nil
+}
+
+func (r *ShareRepository) GetByToken(ctx context.Context, t token.Token) (shareModel.Share, error) {
+	share := new(Share)
+	err := r.dbHandler.WithContext(ctx).Where("token = ?", t.Token()).Take(share).Error
+	if err != nil {
+		return shareModel.Share{}, err
+	}
+
+	s, err := fromRecord(*share)
+	if errors.Is(err, username.InvalidUsername) {
+		return shareModel.Share{}, errs.NotFound("user_not_found", "user not found")
+	}
+
+	return s, nil
+}
+
+func (r *ShareRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	if id < 1 {
+		return errs.Invalid("invalid_id", "invalid id")
+	}
+
+	return r.dbHandler.WithContext(ctx).Where("id = ? AND username = ?", uint(id), u.Name()).Delete(Share{}).Error
+}
+
+func (r *ShareRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Share{}).Error
+}
@@ -0,0 +1,195 @@
+package subject
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	subjectModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type SubjectRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewSubjectRepository(h *handler.DbHandler) subjectRepository.ISubjectRepository {
+	return &SubjectRepository{h}
+}
+
+// Subject is a row of the catalog. Names are unique for each user,
+// as classes of timetables are linked to their subjects by name.
+type Subject struct {
+	ID         uint   `gorm:"primary_key;auto_increment"`
+	Username   string `gorm:"unique_index:idx_subject_username_name"`
+	Name       string `gorm:"unique_index:idx_subject_username_name"`
+	Instructor string
+	Credits    int
+	Color      string
+	Syllabus   string `gorm:"size:510"`
+	Room       string
+}
+
+// classes is the table of the classes of timetables, whose rows refer to subjects.
+const classes = "classes"
+
+// Detached is the subject ID of the classes whose subject was removed. Classes stored before
+// the catalog was introduced refer to no subject instead, and only those are linked by name,
+// so that a removed subject is never added back for the classes which referred to it.
+const Detached uint = 0
+
+func toRecord(s subjectModel.Subject, u username.Username) Subject {
+	id := uint(s.ID())
+	if s.ID() == -1 {
+		id = 0
+	}
+
+	return Subject{id, u.Name(), s.Name(), s.Instructor(), s.Credits(), s.Color(), s.Syllabus(), s.Room()}
+}
+
+func fromRecord(s Subject) (subjectModel.Subject, error) {
+	return subjectModel.NewSubject(int(s.ID), s.Name, s.Instructor, s.Credits, s.Color, s.Syllabus, s.Room)
+}
+
+// Resolve returns the ID of the subject of the user which a class refers to.
+// A subject given by id is taken if the user owns it, otherwise the one of
+// the name is, which is added to the catalog with room as its default room
+// if the user has no such subject yet.
+func Resolve(db *gorm.DB, u string, id uint, name, room string) (uint, error) {
+	s := Subject{}
+	if id != 0 {
+		err := db.Where("id = ? AND username = ?", id, u).Take(&s).Error
+		if err == nil {
+			return s.ID, nil
+		}
+		if !gorm.IsRecordNotFoundError(err) {
+			return 0, err
+		}
+	}
+
+	err := db.Where("username = ? AND name = ?", u, name).Take(&s).Error
+	if err == nil {
+		return s.ID, nil
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		return 0, err
+	}
+
+	s = Subject{Username: u, Name: name, Room: room}
+	err = db.Create(&s).Error
+	return s.ID, err
+}
+
+func (r *SubjectRepository) Create(ctx context.Context, u username.Username, s subjectModel.Subject) error {
+	d := toRecord(s, u)
+	return r.dbHandler.WithContext(ctx).Create(&d).Error
+}
+
+func (r *SubjectRepository) Exists(ctx context.Context, u username.Username, id int) (bool, error) {
+	if id < 1 {
+		return false, nil
+	}
+
+	s := Subject{}
+	err := r.dbHandler.WithContext(ctx).Where("id = ? AND username = ?", uint(id), u.Name()).Take(&s).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *SubjectRepository) GetAll(ctx context.Context, u username.Username) ([]subjectModel.Subject, error) {
+	ds := make([]Subject, 0)
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Order("name").Find(&ds).Error
+	if err != nil {
+		return []subjectModel.Subject{}, err
+	}
+
+	subjects := make([]subjectModel.Subject, 0)
+	for _, d := range ds {
+		s, err := fromRecord(d)
+		if err != nil {
+			return subjects, err
+		}
+		subjects = append(subjects, s)
+	}
+
+	return subjects, nil
+}
+
+func (r *SubjectRepository) Update(ctx context.Context, u username.Username, s subjectModel.Subject) error {
+	d := toRecord(s, u)
+	return r.dbHandler.WithContext(ctx).Model(Subject{}).Where("id = ? AND username = ?", d.ID, d.Username).Updates(map[string]interface{}{
+		"name":       d.Name,
+		"instructor": d.Instructor,
+		"credits":    d.Credits,
+		"color":      d.Color,
+		"syllabus":   d.Syllabus,
+		"room":       d.Room,
+	}).Error
+}
+
+// Remove removes the subject from the catalog. The classes referring to it
+// keep its current name and are detached from the catalog.
+func (r *SubjectRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	if id < 1 {
+		return errs.Invalid("invalid_id", "invalid id")
+	}
+
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		s := Subject{}
+		err := tx.Where("id = ? AND username = ?", uint(id), u.Name()).Take(&s).Error
+		if gorm.IsRecordNotFoundError(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = unlink(tx, s); err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", s.ID).Delete(Subject{}).Error
+	})
+}
+
+func (r *SubjectRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ds := make([]Subject, 0)
+		err := tx.Where("username = ?", u.Name()).Find(&ds).Error
+		if err != nil {
+			return err
+		}
+
+		for _, s := range ds {
+			if err = unlink(tx, s); err != nil {
+				return err
+			}
+		}
+
+		return tx.Where("username = ?", u.Name()).Delete(Subject{}).Error
+	})
+}
+
+// unlink detaches the classes from the subject, writing its current name, and its default room
+// to those without their own, back to them so that they read the same as before.
+func unlink(tx *gorm.DB, s Subject) error {
+	if s.Room != "" {
+		err := tx.Table(classes).Where("subject_id = ? AND room IS NULL", s.ID).Update("room", s.Room).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Table(classes).Where("subject_id = ?", s.ID).Updates(map[string]interface{}{
+		"subject":    s.Name,
+		"subject_id": Detached,
+	}).Error
+}
@@ -1,9 +1,10 @@
 package task
 
 import (
-	"fmt"
+	"context"
 	"time"
 
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 	taskModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
 	taskRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/task"
@@ -15,7 +16,6 @@ type TaskRepository struct {
 }
 
 func NewTaskRepository(h *handler.DbHandler) taskRepository.ITaskRepository {
-	h.Db.AutoMigrate(Task{})
 	return &TaskRepository{h}
 }
 
@@ -44,14 +44,14 @@ func fromRecord(t Task) (taskModel.Task, username.Username, error) {
 	return task, u, err
 }
 
-func (r *TaskRepository) Create(u username.Username, t taskModel.Task) error {
+func (r *TaskRepository) Create(ctx context.Context, u username.Username, t taskModel.Task) error {
 	d := toRecord(t, u)
-	return r.dbHandler.Db.Create(&d).Error
+	return r.dbHandler.WithContext(ctx).Create(&d).Error
 }
 
-func (r *TaskRepository) GetAll(u username.Username) ([]taskModel.Task, error) {
+func (r *TaskRepository) GetAll(ctx context.Context, u username.Username) ([]taskModel.Task, error) {
 	ds := make([]Task, 0)
-	err := r.dbHandler.Db.Where("username = ?", u.Name()).Find(&ds).Error
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Find(&ds).Error
 	if err != nil {
 		return []taskModel.Task{}, err
 	}
@@ -68,14 +68,14 @@ func (r *TaskRepository) GetAll(u username.Username) ([]taskModel.Task, error) {
 	return tasks, nil
 }
 
-func (r *TaskRepository) Remove(u username.Username, id int) error {
+func (r *TaskRepository) Remove(ctx context.Context, u username.Username, id int) error {
 	if id < 1 {
-		return fmt.Errorf("invalid id")
+		return errs.Invalid("invalid_id", "invalid id")
 	}
 
-	return r.dbHandler.Db.Where("id = ?", uint(id)).Delete(Task{}).Error
+	return r.dbHandler.WithContext(ctx).Where("id = ?", uint(id)).Delete(Task{}).Error
 }
 
-func (r *TaskRepository) RemoveAll(u username.Username) error {
-	return r.dbHandler.Db.Where("username = ?", u.Name()).Delete(Task{}).Error
+func (r *TaskRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Task{}).Error
 }
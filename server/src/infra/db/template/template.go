@@ -0,0 +1,282 @@
+package template
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	templateModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/template"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	templateRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/template"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type TemplateRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewTemplateRepository(h *handler.DbHandler) templateRepository.ITemplateRepository {
+	return &TemplateRepository{h}
+}
+
+type Template struct {
+	ID            uint `gorm:"primary_key;auto_increment"`
+	InstitutionID uint `gorm:"index"`
+	Name          string
+	Course        string
+	Year          int
+}
+
+// TemplateClass is a row of a class of a template. Classes sharing a slot
+// are ordered by Position in the order their rules are tried.
+type TemplateClass struct {
+	ID         uint `gorm:"primary_key;auto_increment"`
+	TemplateID uint `gorm:"index"`
+	Day        time.Weekday
+	Period     int
+	Position   int
+	Subject    string
+	Room       sql.NullString
+	Memo       string `gorm:"size:510"`
+	Weeks      string
+	Dates      string `gorm:"size:510"`
+}
+
+const (
+	DateLayout = "2006-01-02"
+)
+
+var weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+func toRecord(t templateModel.Template) Template {
+	d := Template{
+		InstitutionID: uint(t.InstitutionID()),
+		Name:          t.Name(),
+		Course:        t.Course(),
+		Year:          t.Year(),
+	}
+	if t.ID() != -1 {
+		d.ID = uint(t.ID())
+	}
+
+	return d
+}
+
+func toClassRecords(id uint, ts timetablesModel.Timetables) []TemplateClass {
+	cs := make([]TemplateClass, 0)
+	for _, w := range weekdays {
+		day, _ := ts.Day(w)
+		for i, s := range day.Slots() {
+			for j, c := range s.Classes() {
+				ds := make([]string, 0)
+				for _, d := range c.Rule().Dates() {
+					ds = append(ds, d.Format(DateLayout))
+				}
+
+				cs = append(cs, TemplateClass{
+					TemplateID: id,
+					Day:        w,
+					Period:     i + 1,
+					Position:   j,
+					Subject:    c.Subject(),
+					Room:       sql.NullString{String: c.Room(), Valid: !c.IsNoRoom()},
+					Memo:       c.Memo(),
+					Weeks:      c.Rule().Weeks().String(),
+					Dates:      strings.Join(ds, ","),
+				})
+			}
+		}
+	}
+
+	return cs
+}
+
+func (c TemplateClass) toClass() (timetablesModel.Class, error) {
+	rule, err := c.rule()
+	if err != nil {
+		return timetablesModel.Class{}, err
+	}
+
+	if !c.Room.Valid {
+		return timetablesModel.NoRoom(c.Subject, c.Memo).WithRule(rule), nil
+	}
+
+	return timetablesModel.NewClass(c.Subject, c.Room.String, c.Memo).WithRule(rule), nil
+}
+
+func (c TemplateClass) rule() (timetablesModel.Rule, error) {
+	w, err := timetablesModel.ParseWeeks(c.Weeks)
+	if err != nil {
+		return timetablesModel.Rule{}, err
+	}
+
+	switch w {
+	case timetablesModel.OddWeeks:
+		return timetablesModel.Odd(), nil
+	case timetablesModel.EvenWeeks:
+		return timetablesModel.Even(), nil
+	case timetablesModel.OnDates:
+		ds := make([]time.Time, 0)
+		for _, d := range strings.Split(c.Dates, ",") {
+			t, err := time.Parse(DateLayout, d)
+			if err != nil {
+				return timetablesModel.Rule{}, err
+			}
+			ds = append(ds, t)
+		}
+		return timetablesModel.Only(ds)
+	default:
+		return timetablesModel.Every(), nil
+	}
+}
+
+// fromRecord builds a template of its classes, which must be ordered by day, period and position.
+func fromRecord(t Template, cs []TemplateClass) (templateModel.Template, error) {
+	days := make(map[time.Weekday]timetablesModel.Timetable)
+	for _, w := range weekdays {
+		days[w] = timetablesModel.NewTimetableOfSlots(
+			timetablesModel.EmptySlot(),
+			timetablesModel.EmptySlot(),
+			timetablesModel.EmptySlot(),
+			timetablesModel.EmptySlot(),
+			timetablesModel.EmptySlot(),
+		)
+	}
+
+	for _, c := range cs {
+		class, err := c.toClass()
+		if err != nil {
+			return templateModel.Template{}, err
+		}
+
+		day, ok := days[c.Day]
+		if !ok {
+			continue
+		}
+		s, _ := day.Period(c.Period)
+		days[c.Day] = day.WithPeriod(c.Period, timetablesModel.NewSlot(append(s.Classes(), class)...))
+	}
+
+	ts := timetablesModel.NewTimetables(
+		days[time.Monday],
+		days[time.Tuesday],
+		days[time.Wednesday],
+		days[time.Thursday],
+		days[time.Friday],
+	)
+
+	return templateModel.NewTemplate(int(t.ID), int(t.InstitutionID), t.Name, t.Course, t.Year, ts)
+}
+
+func (r *TemplateRepository) Create(ctx context.Context, t templateModel.Template) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		d := toRecord(t)
+		if err := tx.Create(&d).Error; err != nil {
+			return err
+		}
+
+		return createClasses(tx, d.ID, t.Timetables())
+	})
+}
+
+func createClasses(tx *gorm.DB, id uint, ts timetablesModel.Timetables) error {
+	for _, c := range toClassRecords(id, ts) {
+		if err := tx.Create(&c).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TemplateRepository) Exists(ctx context.Context, id int) (bool, error) {
+	t := new(Template)
+	err := r.dbHandler.WithContext(ctx).Where("id = ?", uint(id)).Take(t).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return t.ID != 0, nil
+}
+
+func (r *TemplateRepository) Get(ctx context.Context, id int) (templateModel.Template, error) {
+	t := new(Template)
+	err := r.dbHandler.WithContext(ctx).Where("id = ?", uint(id)).Take(t).Error
+	if err != nil {
+		return templateModel.Template{}, err
+	}
+
+	cs := make([]TemplateClass, 0)
+	err = r.dbHandler.WithContext(ctx).Where("template_id = ?", t.ID).Order("day").Order("period").Order("position").Find(&cs).Error
+	if err != nil {
+		return templateModel.Template{}, err
+	}
+
+	return fromRecord(*t, cs)
+}
+
+// GetAll returns the templates of the institution, ordered by year, course and name.
+func (r *TemplateRepository) GetAll(ctx context.Context, institution int) ([]templateModel.Template, error) {
+	ts := make([]Template, 0)
+	err := r.dbHandler.WithContext(ctx).Where("institution_id = ?", uint(institution)).
+		Order("year").Order("course").Order("name").Find(&ts).Error
+	if err != nil {
+		return []templateModel.Template{}, err
+	}
+
+	templates := make([]templateModel.Template, 0)
+	for _, t := range ts {
+		tmpl, err := r.Get(ctx, int(t.ID))
+		if err != nil {
+			return templates, err
+		}
+		templates = append(templates, tmpl)
+	}
+
+	return templates, nil
+}
+
+func (r *TemplateRepository) Update(ctx context.Context, t templateModel.Template) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		d := toRecord(t)
+		if err := tx.Save(&d).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("template_id = ?", d.ID).Delete(TemplateClass{}).Error; err != nil {
+			return err
+		}
+
+		return createClasses(tx, d.ID, t.Timetables())
+	})
+}
+
+func (r *TemplateRepository) Remove(ctx context.Context, id int) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("template_id = ?", uint(id)).Delete(TemplateClass{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", uint(id)).Delete(Template{}).Error
+	})
+}
+
+// RemoveAll removes the templates of the institution.
+func (r *TemplateRepository) RemoveAll(ctx context.Context, institution int) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ts := make([]Template, 0)
+		if err := tx.Where("institution_id = ?", uint(institution)).Find(&ts).Error; err != nil {
+			return err
+		}
+
+		for _, t := range ts {
+			if err := tx.Where("template_id = ?", t.ID).Delete(TemplateClass{}).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Where("institution_id = ?", uint(institution)).Delete(Template{}).Error
+	})
+}
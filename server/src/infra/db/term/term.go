@@ -0,0 +1,81 @@
+package term
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	termModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type TermRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewTermRepository(h *handler.DbHandler) termRepository.ITermRepository {
+	return &TermRepository{h}
+}
+
+type Term struct {
+	ID       uint `gorm:"primary_key;auto_increment"`
+	Username string
+	Name     string
+	Start    time.Time
+	End      time.Time
+}
+
+func toRecord(t termModel.Term, u username.Username) Term {
+	if t.ID() == -1 {
+		return Term{0, u.Name(), t.Name(), t.Start(), t.End()}
+	}
+
+	return Term{uint(t.ID()), u.Name(), t.Name(), t.Start(), t.End()}
+}
+
+func fromRecord(t Term) (termModel.Term, error) {
+	return termModel.NewTerm(
+		int(t.ID),
+		t.Name,
+		t.Start.Format(termModel.Layout),
+		t.End.Format(termModel.Layout),
+	)
+}
+
+func (r *TermRepository) Create(ctx context.Context, u username.Username, t termModel.Term) error {
+	d := toRecord(t, u)
+	return r.dbHandler.WithContext(ctx).Create(&d).Error
+}
+
+func (r *TermRepository) GetAll(ctx context.Context, u username.Username) ([]termModel.Term, error) {
+	ds := make([]Term, 0)
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Order("start").Find(&ds).Error
+	if err != nil {
+		return []termModel.Term{}, err
+	}
+
+	terms := make([]termModel.Term, 0)
+	for _, d := range ds {
+		t, err := fromRecord(d)
+		if err != nil {
+			return terms, err
+		}
+		terms = append(terms, t)
+	}
+
+	return terms, nil
+}
+
+func (r *TermRepository) Remove(ctx context.Context, u username.Username, id int) error {
+	if id < 1 {
+		return errs.Invalid("invalid_id", "invalid id")
+	}
+
+	return r.dbHandler.WithContext(ctx).Where("id = ? AND username = ?", uint(id), u.Name()).Delete(Term{}).Error
+}
+
+func (r *TermRepository) RemoveAll(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Term{}).Error
+}
@@ -1,13 +1,17 @@
 package timetables
 
 import (
+	"context"
 	"database/sql"
+	"strings"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
 	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
 	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+	subjectDb "github.com/team-gleam/kiwi-basket/server/src/infra/db/subject"
 )
 
 type TimetablesRepository struct {
@@ -15,22 +19,19 @@ type TimetablesRepository struct {
 }
 
 func NewTimetablesRepository(h *handler.DbHandler) timetablesRepository.ITimetablesRepository {
-	h.Db.AutoMigrate(
-		Timetables{},
-		Timetable{},
-		Class{},
-	)
 	return &TimetablesRepository{h}
 }
 
 type Timetables struct {
 	Username                string `gorm:"primary_key"`
+	TermID                  uint   `gorm:"primary_key;auto_increment:false;default:0"`
 	Mon, Tue, Wed, Thu, Fri uint
 }
 
-func NewTimetables(u string, mon, tue, wed, thu, fri uint) Timetables {
+func NewTimetables(u string, term, mon, tue, wed, thu, fri uint) Timetables {
 	return Timetables{
 		Username: u,
+		TermID:   term,
 		Mon:      mon,
 		Tue:      tue,
 		Wed:      wed,
@@ -64,11 +65,19 @@ func (t Timetable) TableName() string {
 	return "timetable"
 }
 
+// Class is a row of the classes sharing a slot. Classes of a slot are
+// chained by Next in the order their rules are tried.
+// The name of the subject referred by SubjectID takes precedence over Subject,
+// which is kept for classes detached from the catalog, whose SubjectID is subjectDb.Detached.
 type Class struct {
-	ID      uint `gorm:"primary_key;auto_increment"`
-	Subject string
-	Room    sql.NullString
-	Memo    string `gorm:"size:510"`
+	ID        uint `gorm:"primary_key;auto_increment"`
+	Subject   string
+	Room      sql.NullString
+	Memo      string `gorm:"size:510"`
+	Weeks     string
+	Dates     string `gorm:"size:510"`
+	Next      *uint
+	SubjectID *uint `gorm:"index"`
 }
 
 func NewClass(s, r, m string) Class {
@@ -92,6 +101,53 @@ func NewNoRoomClass(s, m string) Class {
 	}
 }
 
+func (c Class) withRule(r timetablesModel.Rule, next *uint) Class {
+	c.Weeks = r.Weeks().String()
+
+	ds := make([]string, 0)
+	for _, d := range r.Dates() {
+		ds = append(ds, d.Format(DateLayout))
+	}
+	c.Dates = strings.Join(ds, ",")
+	c.Next = next
+
+	return c
+}
+
+func (c Class) rule() (timetablesModel.Rule, error) {
+	if c.Weeks == "" {
+		return timetablesModel.Every(), nil
+	}
+
+	w, err := timetablesModel.ParseWeeks(c.Weeks)
+	if err != nil {
+		return timetablesModel.Rule{}, err
+	}
+
+	switch w {
+	case timetablesModel.OddWeeks:
+		return timetablesModel.Odd(), nil
+	case timetablesModel.EvenWeeks:
+		return timetablesModel.Even(), nil
+	case timetablesModel.OnDates:
+		ds := make([]time.Time, 0)
+		for _, d := range strings.Split(c.Dates, ",") {
+			t, err := time.Parse(DateLayout, d)
+			if err != nil {
+				return timetablesModel.Rule{}, err
+			}
+			ds = append(ds, t)
+		}
+		return timetablesModel.Only(ds)
+	default:
+		return timetablesModel.Every(), nil
+	}
+}
+
+const (
+	DateLayout = "2006-01-02"
+)
+
 const (
 	NoClass = "no class"
 	Mon     = "mon"
@@ -101,61 +157,99 @@ const (
 	Fri     = "fri"
 )
 
-func (r *TimetablesRepository) Create(u username.Username, t timetablesModel.Timetables) error {
-	mon, err := r.createTimetable(Mon, t.Mon())
-	if err != nil {
-		return err
-	}
-	tue, err := r.createTimetable(Tue, t.Tue())
-	if err != nil {
-		return err
-	}
-	wed, err := r.createTimetable(Wed, t.Wed())
-	if err != nil {
-		return err
-	}
-	thu, err := r.createTimetable(Thu, t.Thu())
-	if err != nil {
-		return err
-	}
-	fri, err := r.createTimetable(Fri, t.Fri())
-	if err != nil {
-		return err
+// attempts is how many times Create tries to save timetables whose version is numbered
+// the same by a concurrent save.
+const attempts = 3
+
+// Create saves the timetables together with their new version, in a transaction
+// so that no timetables are saved without a version. The transaction is tried again
+// when another one has taken the number of the version.
+func (r *TimetablesRepository) Create(ctx context.Context, u username.Username, term int, t timetablesModel.Timetables) error {
+	var err error
+	for i := 0; i < attempts; i++ {
+		err = r.create(ctx, u, term, t)
+		if !handler.IsConflict(err) {
+			return err
+		}
 	}
 
-	return r.dbHandler.Db.Create(NewTimetables(u.Name(), mon, tue, wed, thu, fri)).Error
+	return err
 }
 
-func (r *TimetablesRepository) createTimetable(day string, timetable timetablesModel.Timetable) (uint, error) {
-	_1, err := r.createClass(timetable.First())
+func (r *TimetablesRepository) create(ctx context.Context, u username.Username, term int, t timetablesModel.Timetables) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		days := make([]uint, 0, 5)
+		for i, day := range []timetablesModel.Timetable{t.Mon(), t.Tue(), t.Wed(), t.Thu(), t.Fri()} {
+			id, err := createTimetable(tx, u, []string{Mon, Tue, Wed, Thu, Fri}[i], day, false)
+			if err != nil {
+				return err
+			}
+			days = append(days, id)
+		}
+
+		err := tx.Create(NewTimetables(u.Name(), uint(term), days[0], days[1], days[2], days[3], days[4])).Error
+		if err != nil {
+			return err
+		}
+
+		return createVersion(tx, u, term, t)
+	})
+}
+
+// createTimetable stores the timetable of the day. The classes of a snapshot hold the name and the room
+// of their subjects at the time, so that later changes of the subjects do not change them.
+func createTimetable(db *gorm.DB, u username.Username, day string, timetable timetablesModel.Timetable, snapshot bool) (uint, error) {
+	_1, err := createSlot(db, u, timetable.First(), snapshot)
 	if err != nil {
 		return 0, err
 	}
-	_2, err := r.createClass(timetable.Second())
+	_2, err := createSlot(db, u, timetable.Second(), snapshot)
 	if err != nil {
 		return 0, err
 	}
-	_3, err := r.createClass(timetable.Third())
+	_3, err := createSlot(db, u, timetable.Third(), snapshot)
 	if err != nil {
 		return 0, err
 	}
-	_4, err := r.createClass(timetable.Fourth())
+	_4, err := createSlot(db, u, timetable.Fourth(), snapshot)
 	if err != nil {
 		return 0, err
 	}
-	_5, err := r.createClass(timetable.Fifth())
+	_5, err := createSlot(db, u, timetable.Fifth(), snapshot)
 	if err != nil {
 		return 0, err
 	}
 
 	t := NewTimetable(day, _1, _2, _3, _4, _5)
-	err = r.dbHandler.Db.Create(&t).Error
+	err = db.Create(&t).Error
 	return t.ID, err
 }
 
-func (r *TimetablesRepository) createClass(class timetablesModel.Class) (*uint, error) {
+// createSlot stores the classes of the slot from the last one
+// so that each row can point to the next, and returns the ID of the first.
+func createSlot(db *gorm.DB, u username.Username, slot timetablesModel.Slot, snapshot bool) (*uint, error) {
+	var next *uint
+	classes := slot.Classes()
+	for i := len(classes) - 1; i >= 0; i-- {
+		id, err := createClass(db, u, classes[i], next, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		next = id
+	}
+
+	return next, nil
+}
+
+// createClass stores the class linked to its subject in the catalog of the user.
+func createClass(db *gorm.DB, u username.Username, class timetablesModel.Class, next *uint, snapshot bool) (*uint, error) {
 	if class.IsNoClass() {
-		return nil, nil
+		return next, nil
+	}
+
+	subject, err := subjectDb.Resolve(db, u.Name(), uint(class.SubjectID()), class.Subject(), class.Room())
+	if err != nil {
+		return nil, err
 	}
 
 	var c Class
@@ -164,84 +258,92 @@ func (r *TimetablesRepository) createClass(class timetablesModel.Class) (*uint,
 	} else {
 		c = NewNoRoomClass(class.Subject(), class.Memo())
 	}
+	c = c.withRule(class.Rule(), next)
+	c.SubjectID = &subject
+	if snapshot {
+		if err = withSubject(db, &c); err != nil {
+			return nil, err
+		}
+	}
 
-	err := r.dbHandler.Db.Create(&c).Error
+	err = db.Create(&c).Error
 	return &c.ID, err
 }
 
-func (r *TimetablesRepository) Delete(u username.Username) error {
+func (r *TimetablesRepository) Delete(ctx context.Context, u username.Username, term int) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return deleteTimetables(tx, u, term)
+	})
+}
+
+func deleteTimetables(db *gorm.DB, u username.Username, term int) error {
 	ts := new(Timetables)
-	err := r.dbHandler.Db.Where("username = ?", u.Name()).Take(ts).Error
+	err := db.Where("username = ? AND term_id = ?", u.Name(), uint(term)).Take(ts).Error
 	if err != nil {
 		return err
 	}
 
-	err = r.deleteTimetable(ts.Mon)
-	if err != nil {
-		return err
-	}
-	err = r.deleteTimetable(ts.Tue)
-	if err != nil {
-		return err
-	}
-	err = r.deleteTimetable(ts.Wed)
-	if err != nil {
-		return err
-	}
-	err = r.deleteTimetable(ts.Thu)
-	if err != nil {
-		return err
-	}
-	err = r.deleteTimetable(ts.Fri)
-	if err != nil {
-		return err
+	for _, id := range []uint{ts.Mon, ts.Tue, ts.Wed, ts.Thu, ts.Fri} {
+		if err = deleteTimetable(db, id); err != nil {
+			return err
+		}
 	}
 
-	return r.dbHandler.Db.Where("username = ?", u.Name()).Delete(Timetables{}).Error
+	return db.Where("username = ? AND term_id = ?", u.Name(), uint(term)).Delete(Timetables{}).Error
 }
 
-func (r *TimetablesRepository) deleteTimetable(id uint) error {
+func deleteTimetable(db *gorm.DB, id uint) error {
 	td := new(Timetable)
-	err := r.dbHandler.Db.Where("id = ?", id).Take(td).Error
+	err := db.Where("id = ?", id).Take(td).Error
 	if err != nil {
 		return err
 	}
 
-	err = r.deleteClass(td.One)
+	err = deleteClass(db, td.One)
 	if err != nil {
 		return err
 	}
-	err = r.deleteClass(td.Two)
+	err = deleteClass(db, td.Two)
 	if err != nil {
 		return err
 	}
-	err = r.deleteClass(td.Three)
+	err = deleteClass(db, td.Three)
 	if err != nil {
 		return err
 	}
-	err = r.deleteClass(td.Four)
+	err = deleteClass(db, td.Four)
 	if err != nil {
 		return err
 	}
-	err = r.deleteClass(td.Five)
+	err = deleteClass(db, td.Five)
 	if err != nil {
 		return err
 	}
 
-	return r.dbHandler.Db.Where("id = ?", id).Delete(Timetable{}).Error
+	return db.Where("id = ?", id).Delete(Timetable{}).Error
 }
 
-func (r *TimetablesRepository) deleteClass(id *uint) error {
-	if id != nil {
-		return r.dbHandler.Db.Where("id = ?", *id).Delete(Class{}).Error
+func deleteClass(db *gorm.DB, id *uint) error {
+	for id != nil {
+		c := Class{}
+		err := db.Where("id = ?", *id).Take(&c).Error
+		if err != nil {
+			return err
+		}
+
+		err = db.Where("id = ?", *id).Delete(Class{}).Error
+		if err != nil {
+			return err
+		}
+		id = c.Next
 	}
 
 	return nil
 }
 
-func (r *TimetablesRepository) Exists(u username.Username) (bool, error) {
+func (r *TimetablesRepository) Exists(ctx context.Context, u username.Username, term int) (bool, error) {
 	t := Timetables{}
-	err := r.dbHandler.Db.Where("username = ?", u.Name()).Take(&t).Error
+	err := r.dbHandler.WithContext(ctx).Where("username = ? AND term_id = ?", u.Name(), uint(term)).Take(&t).Error
 	if gorm.IsRecordNotFoundError(err) {
 		return false, nil
 	}
@@ -252,30 +354,31 @@ func (r *TimetablesRepository) Exists(u username.Username) (bool, error) {
 	return t != Timetables{}, nil
 }
 
-func (r *TimetablesRepository) Get(u username.Username) (timetablesModel.Timetables, error) {
+func (r *TimetablesRepository) Get(ctx context.Context, u username.Username, term int) (timetablesModel.Timetables, error) {
+	db := r.dbHandler.WithContext(ctx)
 	ts := Timetables{}
-	err := r.dbHandler.Db.Where("username = ?", u.Name()).Take(&ts).Error
+	err := db.Where("username = ? AND term_id = ?", u.Name(), uint(term)).Take(&ts).Error
 	if err != nil {
 		return timetablesModel.Timetables{}, err
 	}
 
-	mon, err := r.getTimetable(ts.Mon)
+	mon, err := getTimetable(db, ts.Mon, false)
 	if err != nil {
 		return timetablesModel.Timetables{}, err
 	}
-	tue, err := r.getTimetable(ts.Tue)
+	tue, err := getTimetable(db, ts.Tue, false)
 	if err != nil {
 		return timetablesModel.Timetables{}, err
 	}
-	wed, err := r.getTimetable(ts.Wed)
+	wed, err := getTimetable(db, ts.Wed, false)
 	if err != nil {
 		return timetablesModel.Timetables{}, err
 	}
-	thu, err := r.getTimetable(ts.Thu)
+	thu, err := getTimetable(db, ts.Thu, false)
 	if err != nil {
 		return timetablesModel.Timetables{}, err
 	}
-	fri, err := r.getTimetable(ts.Fri)
+	fri, err := getTimetable(db, ts.Fri, false)
 	if err != nil {
 		return timetablesModel.Timetables{}, err
 	}
@@ -283,51 +386,104 @@ func (r *TimetablesRepository) Get(u username.Username) (timetablesModel.Timetab
 	return timetablesModel.NewTimetables(mon, tue, wed, thu, fri), nil
 }
 
-func (r *TimetablesRepository) getTimetable(id uint) (timetablesModel.Timetable, error) {
+// getTimetable returns the timetable of the day. The classes of a snapshot are returned as they were stored,
+// and those of the others with the current names and rooms of their subjects.
+func getTimetable(db *gorm.DB, id uint, snapshot bool) (timetablesModel.Timetable, error) {
 	t := Timetable{}
-	err := r.dbHandler.Db.Where("id = ?", id).Take(&t).Error
+	err := db.Where("id = ?", id).Take(&t).Error
 	if err != nil {
 		return timetablesModel.Timetable{}, err
 	}
 
-	_1, err := r.getClass(t.One)
+	_1, err := getSlot(db, t.One, snapshot)
 	if err != nil {
 		return timetablesModel.Timetable{}, err
 	}
-	_2, err := r.getClass(t.Two)
+	_2, err := getSlot(db, t.Two, snapshot)
 	if err != nil {
 		return timetablesModel.Timetable{}, err
 	}
-	_3, err := r.getClass(t.Three)
+	_3, err := getSlot(db, t.Three, snapshot)
 	if err != nil {
 		return timetablesModel.Timetable{}, err
 	}
-	_4, err := r.getClass(t.Four)
+	_4, err := getSlot(db, t.Four, snapshot)
 	if err != nil {
 		return timetablesModel.Timetable{}, err
 	}
-	_5, err := r.getClass(t.Five)
+	_5, err := getSlot(db, t.Five, snapshot)
 	if err != nil {
 		return timetablesModel.Timetable{}, err
 	}
 
-	return timetablesModel.NewTimetable(_1, _2, _3, _4, _5), nil
+	return timetablesModel.NewTimetableOfSlots(_1, _2, _3, _4, _5), nil
 }
 
-func (r *TimetablesRepository) getClass(id *uint) (timetablesModel.Class, error) {
-	if id == nil {
-		return timetablesModel.NoClass(), nil
-	}
+func getSlot(db *gorm.DB, id *uint, snapshot bool) (timetablesModel.Slot, error) {
+	classes := make([]timetablesModel.Class, 0)
+	for id != nil {
+		c := Class{}
+		err := db.Where("id = ?", id).Take(&c).Error
+		if err != nil {
+			return timetablesModel.Slot{}, err
+		}
+
+		if !snapshot {
+			if err = withSubject(db, &c); err != nil {
+				return timetablesModel.Slot{}, err
+			}
+		}
+
+		class, err := c.toClass()
+		if err != nil {
+			return timetablesModel.Slot{}, err
+		}
+		classes = append(classes, class)
+		id = c.Next
+	}
+
+	return timetablesModel.NewSlot(classes...), nil
+}
 
-	c := Class{}
-	err := r.dbHandler.Db.Where("id = ?", id).Take(&c).Error
+func (c Class) toClass() (timetablesModel.Class, error) {
+	rule, err := c.rule()
 	if err != nil {
 		return timetablesModel.Class{}, err
 	}
 
+	var subject int
+	if c.SubjectID != nil {
+		subject = int(*c.SubjectID)
+	}
+
 	if !c.Room.Valid {
-		return timetablesModel.NoRoom(c.Subject, c.Memo), nil
+		return timetablesModel.NoRoom(c.Subject, c.Memo).WithRule(rule).WithSubject(subject), nil
+	}
+
+	return timetablesModel.NewClass(c.Subject, c.Room.String, c.Memo).WithRule(rule).WithSubject(subject), nil
+}
+
+// withSubject fills the class with the name of its subject, and with the
+// default room of the subject if the class has no room of its own.
+func withSubject(db *gorm.DB, c *Class) error {
+	if c.SubjectID == nil || *c.SubjectID == subjectDb.Detached {
+		return nil
 	}
 
-	return timetablesModel.NewClass(c.Subject, c.Room.String, c.Memo), nil
+	s := subjectDb.Subject{}
+	err := db.Where("id = ?", *c.SubjectID).Take(&s).Error
+	if gorm.IsRecordNotFoundError(err) {
+		c.SubjectID = nil
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	c.Subject = s.Name
+	if !c.Room.Valid && s.Room != "" {
+		c.Room = sql.NullString{String: s.Room, Valid: true}
+	}
+
+	return nil
 }
@@ -0,0 +1,147 @@
+package timetables
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+)
+
+// Version is a row of a saved version of timetables. It refers to its own
+// copies of the timetable of each day, which are never updated, and whose
+// classes hold the names and rooms of their subjects at the time.
+type Version struct {
+	ID                      uint   `gorm:"primary_key;auto_increment"`
+	Username                string `gorm:"index;unique_index:uix_timetables_versions_username_term_id_number"`
+	TermID                  uint   `gorm:"unique_index:uix_timetables_versions_username_term_id_number"`
+	Number                  int    `gorm:"unique_index:uix_timetables_versions_username_term_id_number"`
+	SavedAt                 time.Time
+	Mon, Tue, Wed, Thu, Fri uint
+}
+
+func (v Version) TableName() string {
+	return "timetables_versions"
+}
+
+func createVersion(db *gorm.DB, u username.Username, term int, t timetablesModel.Timetables) error {
+	last := Version{}
+	err := db.Where("username = ? AND term_id = ?", u.Name(), uint(term)).Order("number desc").Take(&last).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+
+	v := Version{
+		Username: u.Name(),
+		TermID:   uint(term),
+		Number:   last.Number + 1,
+		SavedAt:  time.Now().UTC(),
+	}
+	days := []*uint{&v.Mon, &v.Tue, &v.Wed, &v.Thu, &v.Fri}
+	for i, day := range []timetablesModel.Timetable{t.Mon(), t.Tue(), t.Wed(), t.Thu(), t.Fri()} {
+		id, err := createTimetable(db, u, []string{Mon, Tue, Wed, Thu, Fri}[i], day, true)
+		if err != nil {
+			return err
+		}
+		*days[i] = id
+	}
+
+	return db.Create(&v).Error
+}
+
+func (r *TimetablesRepository) GetVersions(ctx context.Context, u username.Username, term int) ([]timetablesModel.Version, error) {
+	db := r.dbHandler.WithContext(ctx)
+	vs := make([]Version, 0)
+	err := db.Where("username = ? AND term_id = ?", u.Name(), uint(term)).Order("number").Find(&vs).Error
+	if err != nil {
+		return []timetablesModel.Version{}, err
+	}
+
+	versions := make([]timetablesModel.Version, 0)
+	for _, v := range vs {
+		version, err := toVersion(db, v)
+		if err != nil {
+			return versions, err
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+func (r *TimetablesRepository) VersionExists(ctx context.Context, u username.Username, term, number int) (bool, error) {
+	v := Version{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ? AND term_id = ? AND number = ?", u.Name(), uint(term), number).Take(&v).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *TimetablesRepository) GetVersion(ctx context.Context, u username.Username, term, number int) (timetablesModel.Version, error) {
+	db := r.dbHandler.WithContext(ctx)
+	v := Version{}
+	err := db.Where("username = ? AND term_id = ? AND number = ?", u.Name(), uint(term), number).Take(&v).Error
+	if err != nil {
+		return timetablesModel.Version{}, err
+	}
+
+	return toVersion(db, v)
+}
+
+func toVersion(db *gorm.DB, v Version) (timetablesModel.Version, error) {
+	days := make([]timetablesModel.Timetable, 0, 5)
+	for _, id := range []uint{v.Mon, v.Tue, v.Wed, v.Thu, v.Fri} {
+		t, err := getTimetable(db, id, true)
+		if err != nil {
+			return timetablesModel.Version{}, err
+		}
+		days = append(days, t)
+	}
+
+	ts := timetablesModel.NewTimetables(days[0], days[1], days[2], days[3], days[4])
+	return timetablesModel.NewVersion(v.Number, v.SavedAt, ts), nil
+}
+
+// DeleteWithVersions removes the timetables of the term, if any, and their versions in a transaction,
+// so that neither is left without the other.
+func (r *TimetablesRepository) DeleteWithVersions(ctx context.Context, u username.Username, term int) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := deleteTimetables(tx, u, term)
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+
+		vs := make([]Version, 0)
+		err = tx.Where("username = ? AND term_id = ?", u.Name(), uint(term)).Find(&vs).Error
+		if err != nil {
+			return err
+		}
+
+		for _, v := range vs {
+			for _, id := range []uint{v.Mon, v.Tue, v.Wed, v.Thu, v.Fri} {
+				if err = deleteTimetable(tx, id); err != nil {
+					return err
+				}
+			}
+		}
+
+		return tx.Where("username = ? AND term_id = ?", u.Name(), uint(term)).Delete(Version{}).Error
+	})
+}
+
+func (r *TimetablesRepository) MoveTerm(ctx context.Context, u username.Username, from, to int) error {
+	return r.dbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(Timetables{}).Where("username = ? AND term_id = ?", u.Name(), uint(from)).Update("term_id", uint(to)).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(Version{}).Where("username = ? AND term_id = ?", u.Name(), uint(from)).Update("term_id", uint(to)).Error
+	})
+}
@@ -0,0 +1,132 @@
+package timetables_test
+
+import (
+	"context"
+	"testing"
+
+	subjectModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/migration"
+	subjectDb "github.com/team-gleam/kiwi-basket/server/src/infra/db/subject"
+	timetablesDb "github.com/team-gleam/kiwi-basket/server/src/infra/db/timetables"
+)
+
+func newHandler(t *testing.T) *handler.DbHandler {
+	h, err := handler.NewDbHandler(handler.Config{DBMS: handler.SQLite, Path: handler.InMemory})
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	t.Cleanup(func() { h.Db.Close() })
+
+	if _, err = migration.NewMigrator(h, migration.Migrations).Up(); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	return h
+}
+
+func TestVersionKeepsSubjects(t *testing.T) {
+	h := newHandler(t)
+	ctx := context.Background()
+	u, _ := username.NewUsername("gleam")
+
+	subjects := subjectDb.NewSubjectRepository(h)
+	math, _ := subjectModel.NewSubject(0, "Math", "", 2, "", "", "101")
+	if err := subjects.Create(ctx, u, math); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	all, _ := subjects.GetAll(ctx, u)
+	id := all[0].ID()
+
+	class := timetablesModel.NoRoom("Math", "").WithSubject(id)
+	empty := timetablesModel.NewTimetable(
+		timetablesModel.NoClass(),
+		timetablesModel.NoClass(),
+		timetablesModel.NoClass(),
+		timetablesModel.NoClass(),
+		timetablesModel.NoClass(),
+	)
+	day := timetablesModel.NewTimetable(class, timetablesModel.NoClass(), timetablesModel.NoClass(), timetablesModel.NoClass(), timetablesModel.NoClass())
+	ts := timetablesModel.NewTimetables(day, empty, empty, empty, empty)
+
+	r := timetablesDb.NewTimetablesRepository(h)
+	if err := r.Create(ctx, u, 0, ts); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	algebra, _ := subjectModel.NewSubject(id, "Algebra", "", 2, "", "", "202")
+	if err := subjects.Update(ctx, u, algebra); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	current, err := r.Get(ctx, u, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if c := current.Mon().First().Classes()[0]; c.Subject() != "Algebra" || c.Room() != "202" {
+		t.Fatalf("expected: %v %v; got: %v %v\n", "Algebra", "202", c.Subject(), c.Room())
+	}
+
+	v, err := r.GetVersion(ctx, u, 0, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if c := v.Timetables().Mon().First().Classes()[0]; c.Subject() != "Math" || c.Room() != "101" {
+		t.Fatalf("expected: %v %v; got: %v %v\n", "Math", "101", c.Subject(), c.Room())
+	}
+}
+
+func TestDeleteWithVersions(t *testing.T) {
+	h := newHandler(t)
+	ctx := context.Background()
+	u, _ := username.NewUsername("gleam")
+
+	empty := timetablesModel.NewTimetable(
+		timetablesModel.NoClass(),
+		timetablesModel.NoClass(),
+		timetablesModel.NoClass(),
+		timetablesModel.NoClass(),
+		timetablesModel.NoClass(),
+	)
+	ts := timetablesModel.NewTimetables(empty, empty, empty, empty, empty)
+
+	r := timetablesDb.NewTimetablesRepository(h)
+	if err := r.Create(ctx, u, 0, ts); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if err := r.Delete(ctx, u, 0); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if err := r.Create(ctx, u, 0, ts); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	vs, err := r.GetVersions(ctx, u, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if len(vs) != 2 || vs[0].Number() != 1 || vs[1].Number() != 2 {
+		t.Fatalf("expected the versions 1 and 2; got: %v\n", vs)
+	}
+
+	t.Run("with timetables", func(t *testing.T) {
+		if err := r.DeleteWithVersions(ctx, u, 0); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+
+		if exists, _ := r.Exists(ctx, u, 0); exists {
+			t.Fatalf("expected the timetables to be removed\n")
+		}
+		if vs, _ := r.GetVersions(ctx, u, 0); len(vs) != 0 {
+			t.Fatalf("expected the versions to be removed; got: %v\n", vs)
+		}
+	})
+
+	t.Run("without timetables", func(t *testing.T) {
+		if err := r.DeleteWithVersions(ctx, u, 0); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+}
@@ -1,9 +1,12 @@
 package credential
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"time"
 
 	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 	credentialModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
@@ -16,74 +19,89 @@ type CredentialRepository struct {
 }
 
 func NewCredentialRepository(h *handler.DbHandler) credentialRepository.ICredentialRepository {
-	h.Db.AutoMigrate(Auth{})
 	return &CredentialRepository{h}
 }
 
 type Auth struct {
-	Username string `gorm:"primary_key"`
-	Token    string `gorm:"primary_key"`
+	Username  string `gorm:"primary_key"`
+	Token     string `gorm:"primary_key"`
+	ExpiresAt *time.Time
 }
 
 func toRecord(a credentialModel.Auth) Auth {
-	return Auth{a.Username().Name(), a.Token().Token()}
+	d := Auth{Username: a.Username().Name(), Token: a.Token().Token()}
+	if expires, ok := a.Expires(); ok {
+		d.ExpiresAt = &expires
+	}
+
+	return d
 }
 
 func fromRecord(a Auth) (credentialModel.Auth, error) {
 	u, err := username.NewUsername(a.Username)
-	return credentialModel.NewAuth(u, token.NewToken(a.Token)), err
+	if a.ExpiresAt == nil {
+		return credentialModel.NewAuth(u, token.NewToken(a.Token)), err
+	}
+
+	return credentialModel.NewExpiringAuth(u, token.NewToken(a.Token), *a.ExpiresAt), err
 }
 
-func (r *CredentialRepository) Append(a credentialModel.Auth) error {
+func (r *CredentialRepository) Append(ctx context.Context, a credentialModel.Auth) error {
 	d := toRecord(a)
-	return r.dbHandler.Db.Create(&d).Error
+	return r.dbHandler.WithContext(ctx).Create(&d).Error
 }
 
-func (r *CredentialRepository) Remove(u username.Username) error {
-	err := r.dbHandler.Db.Where("username = ?", u.Name()).Delete(Auth{}).Error
+func (r *CredentialRepository) Remove(ctx context.Context, u username.Username) error {
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Auth{}).Error
 	if gorm.IsRecordNotFoundError(err) {
 		return nil
 	}
 	return err
 }
 
-func (r *CredentialRepository) Exists(t token.Token) (bool, error) {
+// Exists tells whether the token is issued and has not expired.
+func (r *CredentialRepository) Exists(ctx context.Context, t token.Token) (bool, error) {
 	a := new(Auth)
-	err := r.dbHandler.Db.Where("token = ?", t.Token()).Take(a).Error
+	err := r.dbHandler.WithContext(ctx).Where("token = ?", t.Token()).Take(a).Error
 	if gorm.IsRecordNotFoundError(err) {
 		return false, nil
 	}
 	if err != nil {
 		return false, err
 	}
-	return a.Token != "", nil
+	if a.Token == "" {
+		return false, nil
+	}
+
+	auth, _ := fromRecord(*a)
+	return !auth.IsExpired(time.Now()), nil
 }
 
-func (r *CredentialRepository) GetByToken(t token.Token) (credentialModel.Auth, error) {
+func (r *CredentialRepository) GetByToken(ctx context.Context, t token.Token) (credentialModel.Auth, error) {
 	auth := new(Auth)
-	err := r.dbHandler.Db.Where("token = ?", t.Token()).Take(auth).Error
+	err := r.dbHandler.WithContext(ctx).Where("token = ?", t.Token()).Take(auth).Error
 	if err != nil {
 		return credentialModel.Auth{}, err
 	}
 
 	a, err := fromRecord(*auth)
-	if err != nil && err.Error() == username.InvalidUsername {
-		return credentialModel.Auth{}, fmt.Errorf("user not found")
+	if errors.Is(err, username.InvalidUsername) {
+		return credentialModel.Auth{}, errs.NotFound("user_not_found", "user not found")
 	}
 
 	return a, nil
 }
 
-func (r *CredentialRepository) GetByUsername(u username.Username) (credentialModel.Auth, error) {
+func (r *CredentialRepository) GetByUsername(ctx context.Context, u username.Username) (credentialModel.Auth, error) {
 	auth := new(Auth)
-	err := r.dbHandler.Db.Where("username = ?", u.Name()).Take(auth).Error
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(auth).Error
 	if err != nil {
 		return credentialModel.Auth{}, err
 	}
 
 	a, err := fromRecord(*auth)
-	if err != nil && err.Error() == username.InvalidUsername {
-		return credentialModel.Auth{}, fmt.Errorf("user not found")
+	if errors.Is(err, username.InvalidUsername) {
+		return credentialModel.Auth{}, errs.NotFound("user_not_found", "user not found")
 	}
 
 	return a, nil
@@ -0,0 +1,56 @@
+package language
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	languageModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	languageRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/language"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+)
+
+type LanguageRepository struct {
+	dbHandler *handler.DbHandler
+}
+
+func NewLanguageRepository(h *handler.DbHandler) languageRepository.ILanguageRepository {
+	return &LanguageRepository{h}
+}
+
+type Language struct {
+	Username string `gorm:"primary_key"`
+	Language string
+}
+
+func (r *LanguageRepository) Set(ctx context.Context, u username.Username, l languageModel.Language) error {
+	d := Language{u.Name(), l.Code()}
+	return r.dbHandler.WithContext(ctx).Save(&d).Error
+}
+
+func (r *LanguageRepository) Exists(ctx context.Context, u username.Username) (bool, error) {
+	l := Language{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(&l).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *LanguageRepository) Get(ctx context.Context, u username.Username) (languageModel.Language, error) {
+	l := Language{}
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(&l).Error
+	if err != nil {
+		return languageModel.Language{}, err
+	}
+
+	return languageModel.NewLanguage(l.Language)
+}
+
+func (r *LanguageRepository) Remove(ctx context.Context, u username.Username) error {
+	return r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Delete(Language{}).Error
+}
@@ -1,9 +1,11 @@
 package login
 
 import (
-	"fmt"
+	"context"
+	"errors"
 
 	"github.com/jinzhu/gorm"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 	loginModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/login"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
 	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
@@ -15,7 +17,6 @@ type LoginRepository struct {
 }
 
 func NewLoginRepository(h *handler.DbHandler) loginRepository.ILoginRepository {
-	h.Db.AutoMigrate(Login{})
 	return &LoginRepository{h}
 }
 
@@ -33,19 +34,19 @@ func fromRecord(l Login) (loginModel.Login, error) {
 	return loginModel.NewLogin(u, l.Password), err
 }
 
-func (r *LoginRepository) Create(l loginModel.Login) error {
+func (r *LoginRepository) Create(ctx context.Context, l loginModel.Login) error {
 	login := toRecord(l)
-	return r.dbHandler.Db.Create(&login).Error
+	return r.dbHandler.WithContext(ctx).Create(&login).Error
 }
 
-func (r *LoginRepository) Delete(l loginModel.Login) error {
+func (r *LoginRepository) Delete(ctx context.Context, l loginModel.Login) error {
 	login := toRecord(l)
-	return r.dbHandler.Db.Delete(login).Error
+	return r.dbHandler.WithContext(ctx).Delete(login).Error
 }
 
-func (r *LoginRepository) Exists(u username.Username) (bool, error) {
+func (r *LoginRepository) Exists(ctx context.Context, u username.Username) (bool, error) {
 	l := new(Login)
-	err := r.dbHandler.Db.Where("username = ?", u.Name()).Take(l).Error
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(l).Error
 	if gorm.IsRecordNotFoundError(err) {
 		return false, nil
 	}
@@ -56,16 +57,16 @@ func (r *LoginRepository) Exists(u username.Username) (bool, error) {
 	return l.Username != "", nil
 }
 
-func (r *LoginRepository) Get(u username.Username) (loginModel.Login, error) {
+func (r *LoginRepository) Get(ctx context.Context, u username.Username) (loginModel.Login, error) {
 	login := new(Login)
-	err := r.dbHandler.Db.Where("username = ?", u.Name()).Take(login).Error
+	err := r.dbHandler.WithContext(ctx).Where("username = ?", u.Name()).Take(login).Error
 	if err != nil {
 		return loginModel.Login{}, err
 	}
 
 	l, err := fromRecord(*login)
-	if err != nil && err.Error() == username.InvalidUsername {
-		return loginModel.Login{}, fmt.Errorf("user not found")
+	if errors.Is(err, username.InvalidUsername) {
+		return loginModel.Login{}, errs.NotFound("user_not_found", "user not found")
 	}
 
 	return l, nil
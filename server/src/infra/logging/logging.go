@@ -0,0 +1,183 @@
+// Package logging writes structured logs with log/slog. The logs written while handling a request
+// carry its ID, and the name of the user once authenticated, and secrets are redacted from them.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+// Redacted replaces the values of the secrets in the logs.
+const Redacted = "REDACTED"
+
+// LevelOff is above every level logged, so that nothing is.
+const LevelOff = slog.Level(12)
+
+// Levels are the levels of the logs by their names in the configuration.
+var Levels = map[string]slog.Level{
+	"debug": slog.LevelDebug,
+	"info":  slog.LevelInfo,
+	"warn":  slog.LevelWarn,
+	"error": slog.LevelError,
+	"off":   LevelOff,
+}
+
+// secrets are the keys of the attributes, and the names of the parameters of paths, whose values are redacted.
+var secrets = map[string]bool{
+	"password":      true,
+	"token":         true,
+	"authorization": true,
+}
+
+func isSecret(key string) bool {
+	return secrets[strings.ToLower(key)]
+}
+
+// New returns the logger writing JSON lines of the level and above to w.
+func New(w io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(contextHandler{slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: redact,
+	})})
+}
+
+func redact(_ []string, a slog.Attr) slog.Attr {
+	if isSecret(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+	return a
+}
+
+// contextHandler adds the attributes of the request being handled in the context to the records.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if req, ok := ctx.Value(requestKey{}).(*request); ok {
+		r.AddAttrs(req.attrs()...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+type requestKey struct{}
+
+// request holds the attributes of a request, which are added while it is handled.
+type request struct {
+	mu       sync.Mutex
+	id       string
+	username string
+}
+
+func (r *request) attrs() []slog.Attr {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attrs := []slog.Attr{slog.String("request_id", r.id)}
+	if r.username != "" {
+		attrs = append(attrs, slog.String("username", r.username))
+	}
+	return attrs
+}
+
+func (r *request) authenticated(u username.Username) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.username = u.Name()
+}
+
+// Middleware logs each request after it is handled, unless skipped, with the status it is responded with.
+// The logs written while handling it carry the ID given by the middleware of echo, which must run before,
+// and the name of the user once authenticated.
+func Middleware(skip func(echo.Context) bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			req := &request{id: ctx.Response().Header().Get(echo.HeaderXRequestID)}
+			c := context.WithValue(ctx.Request().Context(), requestKey{}, req)
+			c = credentialUsecase.WithAuthenticated(c, req.authenticated)
+			ctx.SetRequest(ctx.Request().WithContext(c))
+
+			err := next(ctx)
+			if err != nil {
+				ctx.Error(err)
+			}
+			if skip(ctx) {
+				return err
+			}
+
+			status := ctx.Response().Status
+			level := slog.LevelInfo
+			switch {
+			case status >= 500:
+				level = slog.LevelError
+			case status >= 400:
+				level = slog.LevelWarn
+			}
+
+			slog.LogAttrs(c, level, "request",
+				slog.String("method", ctx.Request().Method),
+				slog.String("route", ctx.Path()),
+				slog.String("path", path(ctx)),
+				slog.Int("status", status),
+				slog.Duration("latency", time.Since(start)),
+				slog.Int64("bytes_out", ctx.Response().Size),
+				slog.String("remote_ip", ctx.RealIP()),
+				slog.String("user_agent", ctx.Request().UserAgent()),
+			)
+
+			return err
+		}
+	}
+}
+
+// path returns the path of the request whose secret parameters, like the tokens of shares, are redacted.
+// The query is left out as it may hold secrets as well.
+func path(ctx echo.Context) string {
+	p := ctx.Request().URL.Path
+	for i, name := range ctx.ParamNames() {
+		if v := ctx.ParamValues()[i]; isSecret(name) && v != "" {
+			p = strings.Replace(p, v, Redacted, 1)
+		}
+	}
+	return p
+}
+
+// Recover logs the panics of the handlers with their stacks, and returns them as errors,
+// which are responded with 500.
+func Recover() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					stack := make([]byte, 4<<10)
+					stack = stack[:runtime.Stack(stack, false)]
+
+					err = fmt.Errorf("panic: %v", r)
+					slog.ErrorContext(ctx.Request().Context(), "panic recovered", slog.Any("error", err), slog.String("stack", string(stack)))
+				}
+			}()
+
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+// capture makes the default logger write to the buffer returned until the test ends.
+func capture(t *testing.T) *bytes.Buffer {
+	b := new(bytes.Buffer)
+	original := slog.Default()
+	slog.SetDefault(New(b, slog.LevelDebug))
+	t.Cleanup(func() { slog.SetDefault(original) })
+
+	return b
+}
+
+func lines(t *testing.T, b *bytes.Buffer) []map[string]interface{} {
+	logs := make([]map[string]interface{}, 0)
+	for _, l := range strings.Split(strings.TrimSpace(b.String()), "\n") {
+		m := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(l), &m); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		logs = append(logs, m)
+	}
+	return logs
+}
+
+func TestRedact(t *testing.T) {
+	b := capture(t)
+	slog.Info("signing in", "username", "gleam", "password", "abcdefg", slog.Group("header", "Token", "1234567890"))
+
+	if s := b.String(); strings.Contains(s, "abcdefg") || strings.Contains(s, "1234567890") || !strings.Contains(s, "gleam") {
+		t.Fatalf("expected the secrets to be redacted; got: %v\n", s)
+	}
+}
+
+func TestLevels(t *testing.T) {
+	b := new(bytes.Buffer)
+	New(b, Levels["off"]).Error("failed")
+
+	if b.Len() != 0 {
+		t.Fatalf("expected nothing to be logged; got: %v\n", b.String())
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u, _ := username.NewUsername("gleam")
+	tk := token.NewToken("1234567890")
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	credentialRepository.EXPECT().GetByToken(gomock.Any(), tk).Return(credential.NewAuth(u, tk), nil).AnyTimes()
+	whose := credentialUsecase.NewCredentialUsecase(credentialRepository, mocks.NewMockILoginRepository(ctrl)).Whose
+
+	e := echo.New()
+	e.Use(middleware.RequestID())
+	e.Use(Middleware(func(ctx echo.Context) bool { return ctx.Path() == "/healthz" }))
+	e.Use(Recover())
+
+	e.GET("/shares/:token", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+	e.GET("/tasks", func(ctx echo.Context) error {
+		if _, err := whose(ctx.Request().Context(), tk); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx.Request().Context(), "getting tasks")
+		return fmt.Errorf("dial tcp: connection refused")
+	})
+	e.GET("/panic", func(ctx echo.Context) error {
+		panic("nil map")
+	})
+	e.GET("/healthz", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	tests := []struct {
+		name     string
+		target   string
+		expected []map[string]interface{}
+	}{
+		{
+			"redacted path",
+			"/shares/abcdef?token=abcdef",
+			[]map[string]interface{}{
+				{"level": "INFO", "msg": "request", "route": "/shares/:token", "path": "/shares/" + Redacted, "status": 200.0},
+			},
+		},
+		{
+			"authenticated",
+			"/tasks",
+			[]map[string]interface{}{
+				{"level": "INFO", "msg": "getting tasks", "username": "gleam"},
+				{"level": "ERROR", "msg": "request", "route": "/tasks", "status": 500.0, "username": "gleam"},
+			},
+		},
+		{
+			"panic",
+			"/panic",
+			[]map[string]interface{}{
+				{"level": "ERROR", "msg": "panic recovered", "error": "panic: nil map"},
+				{"level": "ERROR", "msg": "request", "status": 500.0},
+			},
+		},
+		{"skipped", "/healthz", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := capture(t)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, test.target, nil))
+
+			if test.expected == nil {
+				if b.Len() != 0 {
+					t.Fatalf("expected nothing to be logged; got: %v\n", b.String())
+				}
+				return
+			}
+
+			logs := lines(t, b)
+			if len(logs) != len(test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, logs)
+			}
+			for i, expected := range test.expected {
+				if logs[i]["request_id"] != rec.Header().Get(echo.HeaderXRequestID) {
+					t.Fatalf("expected: %v; got: %v\n", rec.Header().Get(echo.HeaderXRequestID), logs[i]["request_id"])
+				}
+				for k, v := range expected {
+					if logs[i][k] != v {
+						t.Fatalf("%s expected: %v; got: %v\n", k, v, logs[i][k])
+					}
+				}
+			}
+			if strings.Contains(b.String(), "abcdef") {
+				t.Fatalf("expected the token to be redacted; got: %v\n", b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Package metrics exposes the metrics of the server to Prometheus: the requests by route and status,
+// the queries by repository method, the sign-ins, and the runtime of Go.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+)
+
+// Path is the path Prometheus scrapes the metrics from.
+const Path = "/metrics"
+
+const namespace = "kiwi_basket"
+
+// The results of queries and sign-ins.
+const (
+	ResultOK      = "ok"
+	ResultError   = "error"
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// unmatched is the route of the requests which matched no routes, so that their paths do not become labels.
+const unmatched = "unmatched"
+
+var (
+	requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests by method, route and status.",
+	}, []string{"method", "route", "status"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of HTTP requests by method, route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	queryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Duration of the queries of the repositories by method and result.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"repository", "method", "result"})
+
+	signIns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sign_ins_total",
+		Help:      "Number of sign-ins by result: success, failure for invalid credentials or requests, or error.",
+	}, []string{"result"})
+)
+
+// The registry of the default registerer also collects the runtime of Go and the process.
+func init() {
+	prometheus.MustRegister(requests, requestDuration, queryDuration, signIns)
+}
+
+// Handler responds with the metrics in the format of Prometheus.
+func Handler() echo.HandlerFunc {
+	return echo.WrapHandler(promhttp.Handler())
+}
+
+// Middleware counts the requests and observes their latency by the route they matched.
+// Errors are handled here so that the status they are responded with is known.
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			err := next(ctx)
+			if err != nil {
+				ctx.Error(err)
+			}
+
+			// The router sets the path of the request as is if no routes match it.
+			route := ctx.Path()
+			if err == echo.ErrNotFound {
+				route = unmatched
+			}
+			labels := prometheus.Labels{
+				"method": ctx.Request().Method,
+				"route":  route,
+				"status": strconv.Itoa(ctx.Response().Status),
+			}
+			requests.With(labels).Inc()
+			requestDuration.With(labels).Observe(time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
+
+// SignIns counts the results of the sign-ins of the route, by the status the error is responded with.
+func SignIns() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			err := next(ctx)
+
+			switch {
+			case err == nil:
+				signIns.WithLabelValues(ResultSuccess).Inc()
+			case errorResponse.Status(handler.Translate(err)) < http.StatusInternalServerError:
+				signIns.WithLabelValues(ResultFailure).Inc()
+			default:
+				signIns.WithLabelValues(ResultError).Inc()
+			}
+
+			return err
+		}
+	}
+}
+
+// ObserveQuery observes the duration of the query of the method of the repository since start.
+func ObserveQuery(repository, method string, start time.Time, err error) {
+	result := ResultOK
+	if err != nil {
+		result = ResultError
+	}
+
+	queryDuration.WithLabelValues(repository, method, result).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+)
+
+func noPreference(echo.Context) (language.Language, bool) {
+	return language.Language{}, false
+}
+
+func newServer() *echo.Echo {
+	e := echo.New()
+	e.HTTPErrorHandler = errorResponse.NewHTTPErrorHandler(func(err error) error { return err }, noPreference)
+	e.Use(Middleware())
+
+	e.GET("/terms", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+	e.GET("/shares/:token", func(ctx echo.Context) error {
+		return errs.NotFound("share_not_found", "share not found")
+	})
+	e.POST("/tokens", func(ctx echo.Context) error {
+		switch ctx.QueryParam("result") {
+		case ResultFailure:
+			return errs.Unauthorized("invalid_password", "invalid password")
+		case ResultError:
+			return fmt.Errorf("dial tcp: connection refused")
+		}
+		return ctx.NoContent(http.StatusOK)
+	}, SignIns())
+	e.GET(Path, Handler())
+
+	return e
+}
+
+func TestMiddleware(t *testing.T) {
+	e := newServer()
+
+	tests := []struct {
+		method string
+		target string
+		route  string
+		status int
+	}{
+		{http.MethodGet, "/terms", "/terms", http.StatusOK},
+		{http.MethodGet, "/shares/abc", "/shares/:token", http.StatusNotFound},
+		{http.MethodGet, "/shares/def", "/shares/:token", http.StatusNotFound},
+		{http.MethodGet, "/unknown", unmatched, http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(test.method, test.target, nil))
+		if rec.Code != test.status {
+			t.Fatalf("expected: %v; got: %v\n", test.status, rec.Code)
+		}
+	}
+
+	for _, test := range []struct {
+		route    string
+		status   string
+		expected float64
+	}{
+		{"/terms", "200", 1},
+		{"/shares/:token", "404", 2},
+		{unmatched, "404", 1},
+	} {
+		c := requests.WithLabelValues(http.MethodGet, test.route, test.status)
+		if got := testutil.ToFloat64(c); got != test.expected {
+			t.Fatalf("%s %s expected: %v; got: %v\n", test.route, test.status, test.expected, got)
+		}
+	}
+}
+
+func TestSignIns(t *testing.T) {
+	e := newServer()
+
+	for _, result := range []string{ResultSuccess, ResultSuccess, ResultFailure, ResultError} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/tokens?result="+result, nil))
+	}
+
+	for _, test := range []struct {
+		result   string
+		expected float64
+	}{
+		{ResultSuccess, 2},
+		{ResultFailure, 1},
+		{ResultError, 1},
+	} {
+		if got := testutil.ToFloat64(signIns.WithLabelValues(test.result)); got != test.expected {
+			t.Fatalf("%s expected: %v; got: %v\n", test.result, test.expected, got)
+		}
+	}
+}
+
+func TestObserveQuery(t *testing.T) {
+	ObserveQuery("term", "GetAll", time.Now(), nil)
+	ObserveQuery("term", "GetAll", time.Now(), fmt.Errorf("record not found"))
+	ObserveQuery("term", "Remove", time.Now(), nil)
+
+	if n := testutil.CollectAndCount(queryDuration); n != 3 {
+		t.Fatalf("expected: %v; got: %v\n", 3, n)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newServer().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected: %v; got: %v\n", http.StatusOK, rec.Code)
+	}
+	for _, name := range []string{"go_goroutines", "kiwi_basket_http_requests_total"} {
+		if !strings.Contains(rec.Body.String(), name) {
+			t.Fatalf("expected %v in: %v\n", name, rec.Body.String())
+		}
+	}
+}
@@ -1,33 +1,94 @@
 package router
 
 import (
-	"log"
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/config"
+	attendanceRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/attendance"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/bell"
+	checkRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/check"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/exam"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/exception"
+	feedRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/feed"
+	gradeRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/grade"
+	groupRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/group"
 	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+	institutionRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/institution"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/instrumented"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/migration"
+	shareRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/share"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/subject"
 	taskRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/task"
+	templateRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/template"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/term"
 	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/timetables"
 	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/user/credential"
+	languageRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/user/language"
 	loginRepository "github.com/team-gleam/kiwi-basket/server/src/infra/db/user/login"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/logging"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/metrics"
+	attendanceController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/attendance"
+	bellController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/bell"
+	calendarController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/calendar"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	examController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/exam"
+	exceptionController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/exception"
+	gradeController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/grade"
+	groupController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/group"
+	healthController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/health"
+	institutionController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/institution"
+	sessionController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/session"
+	shareController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/share"
+	subjectController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/subject"
 	taskController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/task"
+	termController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/term"
 	timetablesController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/timetables"
 	credentialController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/user/credential"
+	languageController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/user/language"
 	loginController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/user/login"
 )
 
-func Run(c handler.Config) {
+// Run serves the API with the configuration, over HTTPS if TLS is enabled.
+func Run(c config.Config) {
 	e := echo.New()
+	e.HideBanner, e.HidePort = true, true
+	slog.SetDefault(logging.New(os.Stderr, logging.Levels[c.LogLevel]))
 
-	h, err := handler.NewDbHandler(c)
+	h, err := handler.NewDbHandler(c.DB)
 	if err != nil {
-		log.Fatal(err)
+		fatal(err)
+	}
+	migrator := migration.NewMigrator(h, migration.Migrations)
+	if err = migrator.Check(); err != nil {
+		fatal(err)
 	}
 
-	taskRepo := taskRepository.NewTaskRepository(h)
-	timetablesRepo := timetablesRepository.NewTimetablesRepository(h)
-	credentialRepo := credentialRepository.NewCredentialRepository(h)
-	loginRepo := loginRepository.NewLoginRepository(h)
+	taskRepo := instrumented.NewTaskRepository(taskRepository.NewTaskRepository(h))
+	subjectRepo := instrumented.NewSubjectRepository(subjectRepository.NewSubjectRepository(h))
+	timetablesRepo := instrumented.NewTimetablesRepository(timetablesRepository.NewTimetablesRepository(h))
+	credentialRepo := instrumented.NewCredentialRepository(credentialRepository.NewCredentialRepository(h))
+	loginRepo := instrumented.NewLoginRepository(loginRepository.NewLoginRepository(h))
+	termRepo := instrumented.NewTermRepository(termRepository.NewTermRepository(h))
+	exceptionRepo := instrumented.NewExceptionRepository(exceptionRepository.NewExceptionRepository(h))
+	bellRepo := instrumented.NewBellRepository(bellRepository.NewBellRepository(h))
+	feedRepo := instrumented.NewFeedRepository(feedRepository.NewFeedRepository(h))
+	shareRepo := instrumented.NewShareRepository(shareRepository.NewShareRepository(h))
+	groupRepo := instrumented.NewGroupRepository(groupRepository.NewGroupRepository(h))
+	attendanceRepo := instrumented.NewAttendanceRepository(attendanceRepository.NewAttendanceRepository(h))
+	gradeRepo := instrumented.NewGradeRepository(gradeRepository.NewGradeRepository(h))
+	examRepo := instrumented.NewExamRepository(examRepository.NewExamRepository(h))
+	checkRepo := instrumented.NewCheckRepository(checkRepository.NewCheckRepository(h))
+	institutionRepo := instrumented.NewInstitutionRepository(institutionRepository.NewInstitutionRepository(h))
+	templateRepo := instrumented.NewTemplateRepository(templateRepository.NewTemplateRepository(h))
+	languageRepo := instrumented.NewLanguageRepository(languageRepository.NewLanguageRepository(h))
 
 	task := taskController.NewTaskController(
 		credentialRepo,
@@ -39,6 +100,116 @@ func Run(c handler.Config) {
 		credentialRepo,
 		loginRepo,
 		timetablesRepo,
+		termRepo,
+		bellRepo,
+		checkRepo,
+		subjectRepo,
+		examRepo,
+		exceptionRepo,
+	)
+
+	subject := subjectController.NewSubjectController(
+		credentialRepo,
+		loginRepo,
+		subjectRepo,
+	)
+
+	term := termController.NewTermController(
+		credentialRepo,
+		loginRepo,
+		termRepo,
+		timetablesRepo,
+	)
+
+	exception := exceptionController.NewExceptionController(
+		credentialRepo,
+		loginRepo,
+		exceptionRepo,
+		timetablesRepo,
+		termRepo,
+	)
+
+	session := sessionController.NewSessionController(
+		credentialRepo,
+		loginRepo,
+		exceptionRepo,
+		timetablesRepo,
+		termRepo,
+		bellRepo,
+		taskRepo,
+		examRepo,
+		subjectRepo,
+	)
+
+	exam := examController.NewExamController(
+		credentialRepo,
+		loginRepo,
+		examRepo,
+		subjectRepo,
+		termRepo,
+		bellRepo,
+	)
+
+	attendance := attendanceController.NewAttendanceController(
+		credentialRepo,
+		loginRepo,
+		attendanceRepo,
+		exceptionRepo,
+		timetablesRepo,
+		termRepo,
+	)
+
+	grade := gradeController.NewGradeController(
+		credentialRepo,
+		loginRepo,
+		gradeRepo,
+		subjectRepo,
+		timetablesRepo,
+		termRepo,
+	)
+
+	bell := bellController.NewBellController(
+		credentialRepo,
+		loginRepo,
+		bellRepo,
+	)
+
+	calendar := calendarController.NewCalendarController(
+		credentialRepo,
+		loginRepo,
+		feedRepo,
+		termRepo,
+		timetablesRepo,
+		exceptionRepo,
+		taskRepo,
+		bellRepo,
+		examRepo,
+		subjectRepo,
+	)
+
+	share := shareController.NewShareController(
+		credentialRepo,
+		loginRepo,
+		shareRepo,
+		timetablesRepo,
+		termRepo,
+	)
+
+	group := groupController.NewGroupController(
+		credentialRepo,
+		loginRepo,
+		groupRepo,
+		timetablesRepo,
+		termRepo,
+	)
+
+	institution := institutionController.NewInstitutionController(
+		credentialRepo,
+		loginRepo,
+		institutionRepo,
+		templateRepo,
+		timetablesRepo,
+		termRepo,
 	)
 
 	login := loginController.NewLoginController(
@@ -46,27 +217,201 @@ func Run(c handler.Config) {
 		credentialRepo,
 		taskRepo,
 		timetablesRepo,
+		termRepo,
+		exceptionRepo,
+		bellRepo,
+		feedRepo,
+		shareRepo,
+		groupRepo,
+		subjectRepo,
+		attendanceRepo,
+		gradeRepo,
+		examRepo,
+		checkRepo,
+		institutionRepo,
+		templateRepo,
+		languageRepo,
 	)
 
 	credential := credentialController.NewCredentialController(
 		credentialRepo,
 		loginRepo,
+		c.TokenTTL,
 	)
 
-	e.Use(middleware.Logger())
-	e.Use(middleware.Recover())
+	language := languageController.NewLanguageController(
+		credentialRepo,
+		loginRepo,
+		languageRepo,
+	)
+
+	health := healthController.NewHealthController(h, migrator)
+
+	e.HTTPErrorHandler = errorResponse.NewHTTPErrorHandler(handler.Translate, language.Preferred)
+	e.Use(middleware.RequestID())
+	e.Use(logging.Middleware(isMonitoring))
+	e.Use(metrics.Middleware())
+	e.Use(logging.Recover())
+	e.Use(withTimeout(h.Timeout()))
+
+	e.GET(LivenessPath, health.Live)
+	e.GET(ReadinessPath, health.Ready)
+	e.GET(metrics.Path, metrics.Handler())
 
 	e.POST("/users", login.SignUp)
 	e.DELETE("/users", login.DeleteAccound)
+	e.POST("/users/language", language.Set)
+	e.GET("/users/language", language.Get)
+	e.DELETE("/users/language", language.Reset)
 
-	e.POST("/tokens", credential.SignIn)
+	e.POST("/tokens", credential.SignIn, metrics.SignIns())
 
 	e.POST("/timetables", timetables.Register)
 	e.GET("/timetables", timetables.Get)
+	e.POST("/timetables/import", timetables.Import)
+	e.POST("/timetables/check", timetables.Check)
+	e.GET("/timetables/check", timetables.GetCheck)
+	e.POST("/timetables/check/limits", timetables.SetLimits)
+	e.GET("/timetables/check/limits", timetables.GetLimits)
+	e.GET("/timetables/versions", timetables.Versions)
+	e.GET("/timetables/versions/diff", timetables.Diff)
+	e.POST("/timetables/versions/rollback", timetables.Rollback)
+	e.GET("/timetables/week", timetables.GetWeek)
+	e.GET("/timetables/effective", exception.Effective)
+	e.GET("/timetables/now", session.Now)
+
+	e.POST("/subjects", subject.Save)
+	e.GET("/subjects", subject.GetAll)
+	e.DELETE("/subjects", subject.Delete)
+
+	e.POST("/exceptions", exception.Add)
+	e.GET("/exceptions", exception.GetAll)
+	e.DELETE("/exceptions", exception.Delete)
+
+	e.POST("/exams", exam.Save)
+	e.GET("/exams", exam.GetAll)
+	e.DELETE("/exams", exam.Delete)
+	e.GET("/exams/upcoming", exam.Upcoming)
+
+	e.POST("/attendance", attendance.Record)
+	e.GET("/attendance", attendance.GetAll)
+	e.DELETE("/attendance", attendance.Delete)
+	e.GET("/attendance/summary", attendance.Summary)
+	e.POST("/attendance/policy", attendance.SetPolicy)
+	e.GET("/attendance/policy", attendance.GetPolicy)
+
+	e.GET("/grades", grade.Report)
+	e.POST("/grades/scores", grade.AddScore)
+	e.GET("/grades/scores", grade.GetScores)
+	e.DELETE("/grades/scores", grade.DeleteScore)
+	e.POST("/grades/scale", grade.SetScale)
+	e.GET("/grades/scale", grade.GetScale)
+
+	e.POST("/terms", term.Add)
+	e.GET("/terms", term.GetAll)
+	e.DELETE("/terms", term.Delete)
+
+	e.POST("/bells", bell.Set)
+	e.GET("/bells", bell.Get)
+	e.DELETE("/bells", bell.Reset)
+
+	e.GET("/calendar.ics", calendar.Export)
+	e.POST("/calendar/feed", calendar.Subscribe)
+	e.GET("/calendar/feed", calendar.Subscription)
+	e.DELETE("/calendar/feed", calendar.Unsubscribe)
+	e.GET(calendarController.FeedPath+":token", calendar.Feed)
+
+	e.POST("/shares", share.Create)
+	e.GET("/shares", share.GetAll)
+	e.DELETE("/shares", share.Revoke)
+	e.GET(shareController.SharedPath+":token", share.Shared)
+
+	e.POST("/groups", group.Create)
+	e.GET("/groups", group.GetAll)
+	e.DELETE("/groups", group.Leave)
+	e.POST("/groups/invitations", group.Invite)
+	e.POST("/groups/join", group.Join)
+	e.GET("/groups/free", group.Free)
+
+	e.POST("/institutions", institution.Create)
+	e.GET("/institutions", institution.GetAll)
+	e.DELETE("/institutions", institution.Leave)
+	e.POST("/institutions/join", institution.Join)
+	e.POST("/institutions/members", institution.SetMember)
+	e.DELETE("/institutions/members", institution.RemoveMember)
+
+	e.POST("/templates", institution.SaveTemplate)
+	e.GET("/templates", institution.Templates)
+	e.DELETE("/templates", institution.DeleteTemplate)
+	e.POST("/templates/instantiate", institution.Instantiate)
 
 	e.POST("/tasks", task.Add)
 	e.GET("/tasks", task.GetAll)
 	e.DELETE("/tasks", task.Delete)
 
-	e.Logger.Fatal(e.Start(":80"))
+	slog.Info("listening", slog.String("address", c.Listen), slog.Bool("tls", c.TLS.Enabled()))
+	go func() {
+		if err := start(e, c); err != http.ErrServerClosed {
+			fatal(err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGTERM, os.Interrupt)
+	<-quit
+
+	// The server stops accepting connections and waits for the requests in flight to finish,
+	// while probes still connected see it is no longer ready.
+	slog.Info("shutting down")
+	health.Drain()
+	ctx, cancel := context.WithTimeout(context.Background(), c.ShutdownTimeout)
+	defer cancel()
+	if err = e.Shutdown(ctx); err != nil {
+		slog.Error("shutting down", slog.Any("error", err))
+	}
+	if err = h.Db.Close(); err != nil {
+		slog.Error("closing database", slog.Any("error", err))
+	}
+}
+
+// The paths of the probes of container orchestrators.
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+)
+
+// isMonitoring skips logging the probes and the scrapes of the metrics, which are made periodically.
+func isMonitoring(ctx echo.Context) bool {
+	p := ctx.Path()
+	return p == LivenessPath || p == ReadinessPath || p == metrics.Path
+}
+
+func start(e *echo.Echo, c config.Config) error {
+	if c.TLS.Enabled() {
+		return e.StartTLS(c.Listen, c.TLS.Cert, c.TLS.Key)
+	}
+	return e.Start(c.Listen)
+}
+
+func fatal(err error) {
+	slog.Error("starting server", slog.Any("error", err))
+	os.Exit(1)
+}
+
+// withTimeout bounds each request by d, canceling its queries when it takes longer.
+// The request is restored afterwards so that the error handler may still query the database.
+func withTimeout(d time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			c, cancel := context.WithTimeout(req.Context(), d)
+			defer cancel()
+
+			ctx.SetRequest(req.WithContext(c))
+			err := next(ctx)
+			ctx.SetRequest(req)
+
+			return err
+		}
+	}
 }
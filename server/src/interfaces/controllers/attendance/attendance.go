@@ -0,0 +1,307 @@
+package attendance
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	attendanceModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/attendance"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	attendanceRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/attendance"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	attendanceUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/attendance"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type AttendanceController struct {
+	attendanceUsecase attendanceUsecase.AttendanceUsecase
+}
+
+func NewAttendanceController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	a attendanceRepository.IAttendanceRepository,
+	e exceptionRepository.IExceptionRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) *AttendanceController {
+	return &AttendanceController{
+		attendanceUsecase.NewAttendanceUsecase(c, l, a, e, t, tm),
+	}
+}
+
+var InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+
+type AttendanceResponse struct {
+	Date      string  `json:"date" validate:"required"`
+	Period    int     `json:"period" validate:"min=1,max=5"`
+	Status    string  `json:"status" validate:"required,oneof=present absent late cancelled"`
+	SubjectID *string `json:"subject_id,omitempty"`
+	Subject   string  `json:"subject"`
+}
+
+func (a AttendanceResponse) Validate() error {
+	return errorResponse.Validate(a)
+}
+
+func (a AttendanceResponse) toRecord() (attendanceModel.Record, error) {
+	status, err := attendanceModel.ParseStatus(a.Status)
+	if err != nil {
+		return attendanceModel.Record{}, err
+	}
+
+	return attendanceModel.NewRecord(a.Date, a.Period, status)
+}
+
+func toAttendanceResponse(r attendanceModel.Record) AttendanceResponse {
+	res := AttendanceResponse{
+		Date:    r.TextDate(),
+		Period:  r.Period(),
+		Status:  r.Status().String(),
+		Subject: r.Subject(),
+	}
+	if r.SubjectID() > 0 {
+		id := strconv.Itoa(r.SubjectID())
+		res.SubjectID = &id
+	}
+
+	return res
+}
+
+// Record records the attendance at the class of a period on a date,
+// replacing the one already recorded.
+func (c AttendanceController) Record(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(AttendanceResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	record, err := res.toRecord()
+	if err != nil {
+		return errorResponse.InvalidOr(err, InvalidJSONFormat)
+	}
+
+	err = c.attendanceUsecase.Record(ctx.Request().Context(), token.NewToken(t), record)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type AttendancesResponse struct {
+	Attendance []AttendanceResponse `json:"attendance"`
+}
+
+func (c AttendanceController) GetAll(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	records, err := c.attendanceUsecase.GetAll(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	res := AttendancesResponse{[]AttendanceResponse{}}
+	for _, r := range records {
+		res.Attendance = append(res.Attendance, toAttendanceResponse(r))
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+type PeriodResponse struct {
+	Date   string `json:"date" validate:"required"`
+	Period int    `json:"period" validate:"min=1,max=5"`
+}
+
+func (p PeriodResponse) Validate() error {
+	return errorResponse.Validate(p)
+}
+
+func (c AttendanceController) Delete(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(PeriodResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	date, err := time.Parse(attendanceModel.Layout, res.Date)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+
+	err = c.attendanceUsecase.Delete(ctx.Request().Context(), token.NewToken(t), date, res.Period)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// PolicyResponse gives the limit of absences as a fraction of the sessions, e.g. "1/3".
+type PolicyResponse struct {
+	Weeks  int    `json:"weeks" validate:"min=1,max=52"`
+	Limit  string `json:"limit" validate:"required"`
+	Lates  int    `json:"lates" validate:"min=0"`
+	Margin int    `json:"margin" validate:"min=0"`
+}
+
+func (p PolicyResponse) Validate() error {
+	return errorResponse.Validate(p)
+}
+
+func (p PolicyResponse) toPolicy() (attendanceModel.Policy, error) {
+	var numerator, denominator int
+	n, err := fmt.Sscanf(p.Limit, "%d/%d", &numerator, &denominator)
+	if err != nil || n != 2 || p.Limit != fmt.Sprintf("%d/%d", numerator, denominator) {
+		return attendanceModel.Policy{}, attendanceModel.InvalidPolicy
+	}
+
+	return attendanceModel.NewPolicy(p.Weeks, numerator, denominator, p.Lates, p.Margin)
+}
+
+func toPolicyResponse(p attendanceModel.Policy) PolicyResponse {
+	return PolicyResponse{
+		Weeks:  p.Weeks(),
+		Limit:  fmt.Sprintf("%d/%d", p.Numerator(), p.Denominator()),
+		Lates:  p.Lates(),
+		Margin: p.Margin(),
+	}
+}
+
+func (c AttendanceController) SetPolicy(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(PolicyResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	policy, err := res.toPolicy()
+	if err != nil {
+		return err
+	}
+
+	err = c.attendanceUsecase.SetPolicy(ctx.Request().Context(), token.NewToken(t), policy)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (c AttendanceController) GetPolicy(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	policy, err := c.attendanceUsecase.Policy(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toPolicyResponse(policy))
+}
+
+type TallyResponse struct {
+	SubjectID *string  `json:"subject_id,omitempty"`
+	Subject   string   `json:"subject"`
+	Sessions  *int     `json:"sessions"`
+	Present   int      `json:"present"`
+	Absent    int      `json:"absent"`
+	Late      int      `json:"late"`
+	Cancelled int      `json:"cancelled"`
+	Rate      *float64 `json:"rate"`
+	Absences  int      `json:"absences"`
+	Limit     *int     `json:"limit"`
+	Remaining *int     `json:"remaining"`
+	Standing  string   `json:"standing"`
+}
+
+type SummaryResponse struct {
+	Policy   PolicyResponse  `json:"policy"`
+	Subjects []TallyResponse `json:"subjects"`
+}
+
+func toTallyResponse(t attendanceModel.Tally) TallyResponse {
+	res := TallyResponse{
+		Subject:   t.Subject(),
+		Present:   t.Count(attendanceModel.Present),
+		Absent:    t.Count(attendanceModel.Absent),
+		Late:      t.Count(attendanceModel.Late),
+		Cancelled: t.Count(attendanceModel.Cancelled),
+		Absences:  t.Absences(),
+		Standing:  t.Standing().String(),
+	}
+	if t.SubjectID() > 0 {
+		id := strconv.Itoa(t.SubjectID())
+		res.SubjectID = &id
+	}
+	if rate, ok := t.Rate(); ok {
+		res.Rate = &rate
+	}
+	if limit, ok := t.Limit(); ok {
+		sessions := t.Sessions()
+		remaining, _ := t.Remaining()
+		res.Sessions, res.Limit, res.Remaining = &sessions, &limit, &remaining
+	}
+
+	return res
+}
+
+// Summary serves the attendance of the active term by subject, flagging the
+// subjects close to or over the limit of absences.
+func (c AttendanceController) Summary(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	tallies, policy, err := c.attendanceUsecase.Summary(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	res := SummaryResponse{toPolicyResponse(policy), []TallyResponse{}}
+	for _, t := range tallies {
+		res.Subjects = append(res.Subjects, toTallyResponse(t))
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,147 @@
+package bell
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	bellModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	bellUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/bell"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type BellController struct {
+	bellUsecase bellUsecase.BellUsecase
+}
+
+func NewBellController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	b bellRepository.IBellRepository,
+) *BellController {
+	return &BellController{
+		bellUsecase.NewBellUsecase(c, l, b),
+	}
+}
+
+var InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+
+type BellResponse struct {
+	TimeZone string      `json:"time_zone" validate:"required"`
+	Periods  PeriodsJSON `json:"periods" validate:"required"`
+}
+
+type PeriodsJSON struct {
+	One   PeriodJSON `json:"1" validate:"required"`
+	Two   PeriodJSON `json:"2" validate:"required"`
+	Three PeriodJSON `json:"3" validate:"required"`
+	Four  PeriodJSON `json:"4" validate:"required"`
+	Five  PeriodJSON `json:"5" validate:"required"`
+}
+
+type PeriodJSON struct {
+	Start string `json:"start" validate:"required"`
+	End   string `json:"end" validate:"required"`
+}
+
+func (b BellResponse) Validate() error {
+	return errorResponse.Validate(b)
+}
+
+func (b BellResponse) toSchedule() (bellModel.Schedule, error) {
+	ps := make([]bellModel.Period, 0)
+	for _, p := range []PeriodJSON{
+		b.Periods.One,
+		b.Periods.Two,
+		b.Periods.Three,
+		b.Periods.Four,
+		b.Periods.Five,
+	} {
+		period, err := bellModel.NewPeriod(p.Start, p.End)
+		if err != nil {
+			return bellModel.Schedule{}, err
+		}
+		ps = append(ps, period)
+	}
+
+	return bellModel.NewSchedule(b.TimeZone, ps)
+}
+
+func toPeriodJSON(p bellModel.Period) PeriodJSON {
+	return PeriodJSON{p.TextStart(), p.TextEnd()}
+}
+
+func toBellResponse(s bellModel.Schedule) BellResponse {
+	ps := s.Periods()
+	return BellResponse{
+		s.TimeZone(),
+		PeriodsJSON{
+			toPeriodJSON(ps[0]),
+			toPeriodJSON(ps[1]),
+			toPeriodJSON(ps[2]),
+			toPeriodJSON(ps[3]),
+			toPeriodJSON(ps[4]),
+		},
+	}
+}
+
+func (c BellController) Set(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(BellResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	schedule, err := res.toSchedule()
+	if err != nil {
+		return err
+	}
+
+	err = c.bellUsecase.Set(ctx.Request().Context(), token.NewToken(t), schedule)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (c BellController) Get(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	schedule, err := c.bellUsecase.Get(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toBellResponse(schedule))
+}
+
+func (c BellController) Reset(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	err := c.bellUsecase.Reset(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
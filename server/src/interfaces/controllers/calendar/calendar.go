@@ -0,0 +1,156 @@
+package calendar
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	feedModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/feed"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	feedRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/feed"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	taskRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/task"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	"github.com/team-gleam/kiwi-basket/server/src/interfaces/ics"
+	calendarUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/calendar"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type CalendarController struct {
+	calendarUsecase calendarUsecase.CalendarUsecase
+}
+
+func NewCalendarController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	f feedRepository.IFeedRepository,
+	tm termRepository.ITermRepository,
+	tt timetablesRepository.ITimetablesRepository,
+	e exceptionRepository.IExceptionRepository,
+	t taskRepository.ITaskRepository,
+	b bellRepository.IBellRepository,
+	x examRepository.IExamRepository,
+	s subjectRepository.ISubjectRepository,
+) *CalendarController {
+	return &CalendarController{
+		calendarUsecase.NewCalendarUsecase(c, l, f, tm, tt, e, t, b, x, s),
+	}
+}
+
+const (
+	// FeedPath is the path of calendar feeds, followed by their secret tokens.
+	FeedPath = "/calendar/feed/"
+)
+
+var InvalidTaskStyle = errs.Invalid("invalid_task_style", "invalid task style")
+
+// taskStyle reads the optional "tasks" query parameter, either "event" (default) or "todo".
+func taskStyle(ctx echo.Context) (ics.TaskStyle, error) {
+	switch ctx.QueryParam("tasks") {
+	case "", "event":
+		return ics.TasksAsEvents, nil
+	case "todo":
+		return ics.TasksAsTodos, nil
+	default:
+		return ics.TasksAsEvents, InvalidTaskStyle
+	}
+}
+
+// Export serves the calendar of the user signed in as an iCalendar file.
+func (c CalendarController) Export(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	style, err := taskStyle(ctx)
+	if err != nil {
+		return err
+	}
+
+	calendar, err := c.calendarUsecase.Export(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Blob(http.StatusOK, ics.ContentType, ics.Encode(calendar, c.calendarUsecase.Now(), style))
+}
+
+// Feed serves the calendar of a feed to calendar applications,
+// which authenticate with the secret token in the path instead of the Token header.
+func (c CalendarController) Feed(ctx echo.Context) error {
+	secret := strings.TrimSuffix(ctx.Param("token"), ".ics")
+
+	style, err := taskStyle(ctx)
+	if err != nil {
+		return err
+	}
+
+	calendar, err := c.calendarUsecase.ExportFeed(ctx.Request().Context(), token.NewToken(secret))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Blob(http.StatusOK, ics.ContentType, ics.Encode(calendar, c.calendarUsecase.Now(), style))
+}
+
+type FeedResponse struct {
+	URL string `json:"url"`
+}
+
+func toFeedResponse(ctx echo.Context, f feedModel.Feed) FeedResponse {
+	return FeedResponse{
+		fmt.Sprintf("%s://%s%s%s.ics", ctx.Scheme(), ctx.Request().Host, FeedPath, f.Token().Token()),
+	}
+}
+
+// Subscribe issues the URL of a new calendar feed, revoking the one issued before.
+func (c CalendarController) Subscribe(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	f, err := c.calendarUsecase.Subscribe(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toFeedResponse(ctx, f))
+}
+
+func (c CalendarController) Subscription(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	f, err := c.calendarUsecase.Subscription(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toFeedResponse(ctx, f))
+}
+
+func (c CalendarController) Unsubscribe(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	err := c.calendarUsecase.Unsubscribe(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -0,0 +1,155 @@
+package error
+
+import (
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+)
+
+// catalog holds the messages of a language: those of errors keyed by their codes,
+// and the formats of those of fields keyed by the tags of the validator, which are
+// given the name of a field and the parameter of its tag.
+type catalog struct {
+	errors map[string]string
+	fields map[string]string
+}
+
+// catalogs are the messages of the languages. The messages of errors in English are
+// those the errors are declared with, so the catalog of English has only those of fields.
+var catalogs = map[language.Language]catalog{
+	language.English: {
+		errors: map[string]string{},
+		fields: map[string]string{
+			"required":   "%s is required",
+			"numeric":    "%s must be numeric",
+			"alphanum":   "%s must consist of letters and digits",
+			"hexcolor":   "%s must be a hex color like #1e90ff",
+			"url":        "%s must be a URL",
+			"ne":         "%s must not be %s",
+			"gt":         "%s must be greater than %s",
+			"min":        "%s must be at least %s",
+			"max":        "%s must be at most %s",
+			"min_string": "%s must be at least %s characters",
+			"max_string": "%s must be at most %s characters",
+			"oneof":      "%s must be one of %s",
+			"max_85_ptr": "%s must be at most 85 characters",
+			"max_170":    "%s must be at most 170 characters",
+			"rule":       "%s is not a valid rule of weeks",
+			"nested":     "%s must not have alternates",
+			"":           "%s is invalid",
+		},
+	},
+	language.Japanese: {
+		errors: map[string]string{
+			errs.Internal:                  "サーバーでエラーが発生しました",
+			"bad_request":                  "リクエストが正しくありません",
+			"not_found":                    "見つかりません",
+			"method_not_allowed":           "許可されていないメソッドです",
+			"unsupported_media_type":       "サポートされていないメディアタイプです",
+			"request_entity_too_large":     "リクエストが大きすぎます",
+			"database_timeout":             "データベースが時間内に応答しませんでした",
+			"invalid_json_format":          "JSON の形式が正しくありません",
+			"invalid_token":                "Token が無効です",
+			"invalid_username":             "ユーザー名が空です",
+			"invalid_username_or_password": "ユーザー名またはパスワードが正しくありません",
+			"username_already_exists":      "ユーザー名はすでに使われています",
+			"username_not_found":           "ユーザー名が見つかりません",
+			"user_not_found":               "ユーザーが見つかりません",
+			"invalid_language":             "言語には en または ja を指定してください",
+			"record_not_found":             "データが見つかりません",
+			"invalid_id":                   "ID が正しくありません",
+			"id_is_not_zero":               "ID が 0 ではありません",
+			"invalid_date":                 "日付が正しくありません",
+			"invalid_date_format":          "日付の形式が正しくありません",
+			"invalid_date_range":           "開始日が終了日より後です",
+			"invalid_time_format":          "時刻の形式が正しくありません",
+			"invalid_time_range":           "開始時刻が終了時刻より前ではありません",
+			"invalid_time_zone":            "タイムゾーンが正しくありません",
+			"invalid_weekday":              "曜日が正しくありません",
+			"invalid_period":               "時限が正しくありません",
+			"invalid_span":                 "期間が正しくありません",
+			"invalid_term_id":              "学期の ID が正しくありません",
+			"invalid_term_name":            "学期の名前が空です",
+			"term_not_found":               "学期が見つかりません",
+			"active_term_not_found":        "今日の日付を含む学期が見つかりません",
+			"past_term_is_read_only":       "終了した学期の時間割は変更できません",
+			"timetables_not_found":         "時間割が見つかりません",
+			"class_not_found":              "授業が見つかりません",
+			"invalid_class":                "科目と教室は85文字以下、メモは170文字以下にしてください",
+			"empty_subject":                "科目名が空です",
+			"invalid_room":                 "教室が正しくありません",
+			"invalid_weeks":                "週の指定が正しくありません",
+			"no_dates":                     "日付を指定してください",
+			"too_many_dates":               "日付が多すぎます",
+			"invalid_version":              "版が正しくありません",
+			"version_not_found":            "版が見つかりません",
+			"invalid_import_file":          "読み込むファイルが正しくありません",
+			"invalid_import_format":        "読み込む形式には csv または ics を指定してください",
+			"import_too_large":             "読み込むファイルが大きすぎます",
+			"invalid_calendar":             "iCalendar の形式が正しくありません",
+			"invalid_limits":               "上限が正しくありません",
+			"invalid_subject":              "科目が正しくありません",
+			"invalid_subject_name":         "科目名が正しくありません",
+			"invalid_credits":              "単位数が正しくありません",
+			"invalid_color":                "色が正しくありません",
+			"invalid_syllabus":             "シラバスの URL が正しくありません",
+			"subject_already_exists":       "科目はすでに登録されています",
+			"subject_not_found":            "科目が見つかりません",
+			"invalid_kind":                 "種類が正しくありません",
+			"moved_to_itself":              "振替先が同じコマです",
+			"invalid_bell_period":          "時限の終了時刻が開始時刻より前です",
+			"invalid_periods":              "すべての時限の時刻を指定してください",
+			"periods_overlap":              "時限の時刻が重なっています",
+			"invalid_task_style":           "課題の形式が正しくありません",
+			"invalid_exam_title":           "試験の名前が正しくありません",
+			"exam_not_found":               "試験が見つかりません",
+			"exam_out_of_term":             "試験の日付が学期の期間外です",
+			"invalid_status":               "出欠の状態が正しくありません",
+			"invalid_policy":               "出席の規定が正しくありません",
+			"invalid_score_title":          "成績の名前が正しくありません",
+			"invalid_points":               "点数が正しくありません",
+			"invalid_weight":               "重みが正しくありません",
+			"invalid_scale":                "成績の評価基準が正しくありません",
+			"unknown_preset":               "評価基準のプリセットが見つかりません",
+			"score_not_found":              "成績が見つかりません",
+			"feed_not_found":               "購読用の URL が見つかりません",
+			"invalid_scope":                "共有する範囲が正しくありません",
+			"invalid_expiry":               "有効期限が正しくありません",
+			"expiry_in_past":               "有効期限には未来の日時を指定してください",
+			"share_not_found":              "共有リンクが見つかりません",
+			"share_expired":                "共有リンクの有効期限が切れています",
+			"invalid_group_name":           "グループ名は1文字以上85文字以下にしてください",
+			"invalid_minimum":              "最低人数が正しくありません",
+			"group_not_found":              "グループが見つかりません",
+			"already_member":               "すでにグループのメンバーです",
+			"not_group_owner":              "グループに招待できるのはオーナーのみです",
+			"invalid_institution_name":     "機関名は1文字以上85文字以下にしてください",
+			"invalid_role":                 "役割が正しくありません",
+			"institution_not_found":        "機関が見つかりません",
+			"not_admin":                    "機関を管理できるのは管理者のみです",
+			"last_admin":                   "他のメンバーがいる間は最後の管理者は機関から脱退できません",
+			"invalid_template_name":        "テンプレート名は1文字以上85文字以下にしてください",
+			"invalid_course":               "コース名は85文字以下にしてください",
+			"invalid_year":                 "学年は0以上10以下にしてください",
+			"template_not_found":           "テンプレートが見つかりません",
+		},
+		fields: map[string]string{
+			"required":   "%sは必須です",
+			"numeric":    "%sは数字にしてください",
+			"alphanum":   "%sは英数字にしてください",
+			"hexcolor":   "%sは #1e90ff のような16進数の色にしてください",
+			"url":        "%sは URL にしてください",
+			"ne":         "%sに %s は指定できません",
+			"gt":         "%sは%sより大きくしてください",
+			"min":        "%sは%s以上にしてください",
+			"max":        "%sは%s以下にしてください",
+			"min_string": "%sは%s文字以上にしてください",
+			"max_string": "%sは%s文字以下にしてください",
+			"oneof":      "%sは %s のいずれかにしてください",
+			"max_85_ptr": "%sは85文字以下にしてください",
+			"max_170":    "%sは170文字以下にしてください",
+			"rule":       "%sは週の指定として正しくありません",
+			"nested":     "%sの授業に alternates は指定できません",
+			"":           "%sが正しくありません",
+		},
+	},
+}
@@ -1,13 +1,64 @@
 package error
 
-type ErrorJSON struct {
-	Message string `json:"message"`
-}
+import (
+	"net/http"
+	"strings"
 
-func NewError(e error) ErrorJSON {
-	return ErrorJSON{e.Error()}
-}
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+)
+
+// MIMEProblemJSON is the media type of error responses, defined by RFC 7807.
+const MIMEProblemJSON = "application/problem+json"
+
+// The headers of the languages requested, and of the one the details of error responses are in.
+const (
+	HeaderAcceptLanguage  = "Accept-Language"
+	HeaderContentLanguage = "Content-Language"
+)
 
 const (
 	InternalServerError = "internal server error"
 )
+
+var InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+
+// ProblemJSON is an error response in the format of RFC 7807.
+// Code identifies the error regardless of the wording of Detail, which is localized,
+// and Message is the message in English for clients reading the error responses of former versions.
+type ProblemJSON struct {
+	Type      string      `json:"type"`
+	Title     string      `json:"title"`
+	Status    int         `json:"status"`
+	Detail    string      `json:"detail"`
+	Code      string      `json:"code"`
+	Message   string      `json:"message"`
+	Errors    []FieldJSON `json:"errors,omitempty"`
+	RequestID string      `json:"request_id,omitempty"`
+}
+
+// FieldJSON is an error of a field of a request, which broke the rule of the validator named Code.
+type FieldJSON struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// NewProblem returns the problem of the code, whose message in English is message,
+// with the detail in the language.
+func NewProblem(l language.Language, status int, code, message string) ProblemJSON {
+	return ProblemJSON{
+		Type:    "about:blank",
+		Title:   http.StatusText(status),
+		Status:  status,
+		Detail:  Message(l, code, message),
+		Code:    code,
+		Message: message,
+	}
+}
+
+// statusCode returns the code of the errors of echo, like "method_not_allowed".
+func statusCode(status int) string {
+	return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+}
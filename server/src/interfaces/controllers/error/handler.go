@@ -0,0 +1,103 @@
+package error
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+)
+
+var statuses = map[errs.Kind]int{
+	errs.KindInvalid:      http.StatusBadRequest,
+	errs.KindUnauthorized: http.StatusUnauthorized,
+	errs.KindForbidden:    http.StatusForbidden,
+	errs.KindNotFound:     http.StatusNotFound,
+	errs.KindConflict:     http.StatusConflict,
+	errs.KindGone:         http.StatusGone,
+	errs.KindTooLarge:     http.StatusRequestEntityTooLarge,
+	errs.KindUnavailable:  http.StatusServiceUnavailable,
+}
+
+// Status returns the status code of the response to err.
+// Errors of no kind are failures of the server.
+func Status(err error) int {
+	if s, ok := statuses[errs.KindOf(err)]; ok {
+		return s
+	}
+
+	return http.StatusInternalServerError
+}
+
+// NewHTTPErrorHandler returns the handler of the errors returned by the controllers,
+// which responds with problem details of the status of their kinds. The messages of
+// the failures of the server are not shown to clients, but logged.
+// translate converts the errors of the infrastructure, like those of records not found,
+// into those of the domain before they are classified.
+// The details are in the language the user prefers, which prefer returns if registered,
+// or otherwise in the one negotiated by Accept-Language.
+func NewHTTPErrorHandler(translate func(error) error, prefer func(echo.Context) (language.Language, bool)) echo.HTTPErrorHandler {
+	return func(err error, ctx echo.Context) {
+		if ctx.Response().Committed {
+			return
+		}
+
+		l, ok := prefer(ctx)
+		if !ok {
+			l = Negotiate(ctx.Request().Header.Get(HeaderAcceptLanguage))
+		}
+
+		err = translate(err)
+		p := problem(err, l)
+		if p.Status == http.StatusInternalServerError {
+			slog.ErrorContext(ctx.Request().Context(), InternalServerError, slog.Any("error", err))
+		}
+
+		p.RequestID = ctx.Response().Header().Get(echo.HeaderXRequestID)
+		ctx.Response().Header().Set(HeaderContentLanguage, l.Code())
+
+		if ctx.Request().Method == http.MethodHead {
+			err = ctx.NoContent(p.Status)
+		} else {
+			err = respond(ctx, p)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx.Request().Context(), "responding with error", slog.Any("error", err))
+		}
+	}
+}
+
+func problem(err error, l language.Language) ProblemJSON {
+	if he, ok := err.(*echo.HTTPError); ok {
+		if he.Code == http.StatusInternalServerError {
+			return NewProblem(l, he.Code, errs.Internal, InternalServerError)
+		}
+		return NewProblem(l, he.Code, statusCode(he.Code), fmt.Sprint(he.Message))
+	}
+
+	status := Status(err)
+	if status == http.StatusInternalServerError {
+		return NewProblem(l, status, errs.Internal, InternalServerError)
+	}
+
+	p := NewProblem(l, status, errs.CodeOf(err), err.Error())
+	var fe FieldsError
+	if errors.As(err, &fe) {
+		p.Errors = fe.Fields(l)
+	}
+
+	return p
+}
+
+func respond(ctx echo.Context, p ProblemJSON) error {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Blob(p.Status, MIMEProblemJSON, b)
+}
@@ -0,0 +1,196 @@
+package error
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+)
+
+const recordNotFound = "record not found"
+
+var notFound = errs.NotFound("record_not_found", "record not found")
+
+func noPreference(echo.Context) (language.Language, bool) {
+	return language.Language{}, false
+}
+
+func translate(err error) error {
+	if err != nil && err.Error() == recordNotFound {
+		return notFound
+	}
+	return err
+}
+
+type request struct {
+	Name  string `json:"name" validate:"required,max=5"`
+	Count int    `json:"count" validate:"min=1"`
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		status  int
+		code    string
+		message string
+	}{
+		{"invalid", errs.Invalid("invalid_date", "invalid date"), http.StatusBadRequest, "invalid_date", "invalid date"},
+		{"unauthorized", errs.Unauthorized("invalid_token", "invalid token"), http.StatusUnauthorized, "invalid_token", "invalid token"},
+		{"forbidden", errs.Forbidden("past_term_is_read_only", "past term is read only"), http.StatusForbidden, "past_term_is_read_only", "past term is read only"},
+		{"not found", errs.NotFound("term_not_found", "term not found"), http.StatusNotFound, "term_not_found", "term not found"},
+		{"wrapped", fmt.Errorf("deleting: %w", errs.NotFound("term_not_found", "term not found")), http.StatusNotFound, "term_not_found", "deleting: term not found"},
+		{"conflict", errs.Conflict("subject_already_exists", "subject already exists"), http.StatusConflict, "subject_already_exists", "subject already exists"},
+		{"gone", errs.Gone("share_expired", "share link has expired"), http.StatusGone, "share_expired", "share link has expired"},
+		{"too large", errs.TooLarge("import_too_large", "import file is too large"), http.StatusRequestEntityTooLarge, "import_too_large", "import file is too large"},
+		{"unavailable", errs.Unavailable("database_timeout", "database did not respond in time"), http.StatusServiceUnavailable, "database_timeout", "database did not respond in time"},
+		{"translated", fmt.Errorf(recordNotFound), http.StatusNotFound, "record_not_found", recordNotFound},
+		{"internal", fmt.Errorf("dial tcp: connection refused"), http.StatusInternalServerError, errs.Internal, InternalServerError},
+		{"route not found", echo.ErrNotFound, http.StatusNotFound, "not_found", "Not Found"},
+		{"echo internal", echo.NewHTTPError(http.StatusInternalServerError, "panic"), http.StatusInternalServerError, errs.Internal, InternalServerError},
+	}
+
+	e := echo.New()
+	handle := NewHTTPErrorHandler(translate, noPreference)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+			ctx.Response().Header().Set(echo.HeaderXRequestID, "abc")
+			handle(test.err, ctx)
+
+			if rec.Code != test.status {
+				t.Fatalf("expected: %v; got: %v\n", test.status, rec.Code)
+			}
+			if ct := rec.Header().Get(echo.HeaderContentType); ct != MIMEProblemJSON {
+				t.Fatalf("expected: %v; got: %v\n", MIMEProblemJSON, ct)
+			}
+			if cl := rec.Header().Get(HeaderContentLanguage); cl != language.English.Code() {
+				t.Fatalf("expected: %v; got: %v\n", language.English.Code(), cl)
+			}
+
+			res := ProblemJSON{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			expected := ProblemJSON{
+				Type:      "about:blank",
+				Title:     http.StatusText(test.status),
+				Status:    test.status,
+				Detail:    test.message,
+				Code:      test.code,
+				Message:   test.message,
+				RequestID: "abc",
+			}
+			if fmt.Sprint(res) != fmt.Sprint(expected) {
+				t.Fatalf("expected: %v; got: %v\n", expected, res)
+			}
+		})
+	}
+
+	t.Run("fields", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handle(Validate(request{"kiwi basket", 0}), e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec))
+
+		res := ProblemJSON{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if rec.Code != http.StatusBadRequest || res.Code != InvalidJSONFormat.Code() || len(res.Errors) != 2 {
+			t.Fatalf("expected: %v %v with 2 fields; got: %v %v\n", http.StatusBadRequest, InvalidJSONFormat.Code(), rec.Code, res)
+		}
+	})
+
+	t.Run("logged", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		original := slog.Default()
+		slog.SetDefault(slog.New(slog.NewJSONHandler(b, nil)))
+		defer slog.SetDefault(original)
+
+		for _, err := range []error{fmt.Errorf("dial tcp: connection refused"), errs.NotFound("term_not_found", "term not found")} {
+			handle(err, e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder()))
+		}
+
+		logs := strings.Split(strings.TrimSpace(b.String()), "\n")
+		if len(logs) != 1 || !strings.Contains(logs[0], `"error":"dial tcp: connection refused"`) {
+			t.Fatalf("expected the internal error to be logged; got: %v\n", logs)
+		}
+	})
+
+	t.Run("head", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handle(errs.NotFound("term_not_found", "term not found"), e.NewContext(httptest.NewRequest(http.MethodHead, "/", nil), rec))
+
+		if rec.Code != http.StatusNotFound || rec.Body.Len() != 0 {
+			t.Fatalf("expected: %v; got: %v %v\n", http.StatusNotFound, rec.Code, rec.Body.String())
+		}
+	})
+}
+
+func TestLocalizedHTTPErrorHandler(t *testing.T) {
+	e := echo.New()
+	japanese := func(echo.Context) (language.Language, bool) {
+		return language.Japanese, true
+	}
+
+	tests := []struct {
+		name           string
+		prefer         func(echo.Context) (language.Language, bool)
+		acceptLanguage string
+		expected       language.Language
+		detail         string
+		field          string
+	}{
+		{"english", noPreference, "", language.English, "term not found", "name must be at most 5 characters"},
+		{"accept language", noPreference, "ja-JP,ja;q=0.9,en;q=0.8", language.Japanese, "学期が見つかりません", "nameは5文字以下にしてください"},
+		{"preference", japanese, "en", language.Japanese, "学期が見つかりません", "nameは5文字以下にしてください"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handle := NewHTTPErrorHandler(translate, test.prefer)
+
+			for _, c := range []struct {
+				err      error
+				expected string
+			}{
+				{errs.NotFound("term_not_found", "term not found"), test.detail},
+				{Validate(request{"kiwi basket", 1}), test.field},
+			} {
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				req.Header.Set(HeaderAcceptLanguage, test.acceptLanguage)
+				rec := httptest.NewRecorder()
+				handle(c.err, e.NewContext(req, rec))
+
+				if cl := rec.Header().Get(HeaderContentLanguage); cl != test.expected.Code() {
+					t.Fatalf("expected: %v; got: %v\n", test.expected.Code(), cl)
+				}
+
+				res := ProblemJSON{}
+				if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+					t.Fatalf("unexpected error: %v\n", err)
+				}
+				if res.Message != c.err.Error() {
+					t.Fatalf("expected: %v; got: %v\n", c.err.Error(), res.Message)
+				}
+
+				got := res.Detail
+				if len(res.Errors) > 0 {
+					got = res.Errors[0].Message
+				}
+				if got != c.expected {
+					t.Fatalf("expected: %v; got: %v\n", c.expected, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package error
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+)
+
+// Negotiate returns the supported language of the highest quality in the value of Accept-Language,
+// like "ja-JP,ja;q=0.9,en;q=0.8", or English if none is acceptable.
+func Negotiate(acceptLanguage string) language.Language {
+	type candidate struct {
+		language language.Language
+		quality  float64
+	}
+
+	cs := make([]candidate, 0)
+	for _, r := range strings.Split(acceptLanguage, ",") {
+		params := strings.Split(r, ";")
+		tag := strings.ToLower(strings.TrimSpace(params[0]))
+		quality := 1.0
+		for _, p := range params[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				q, err := strconv.ParseFloat(p[2:], 64)
+				if err != nil {
+					q = 0
+				}
+				quality = q
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		if tag == "*" {
+			cs = append(cs, candidate{language.Supported[0], quality})
+			continue
+		}
+		l, err := language.NewLanguage(strings.SplitN(tag, "-", 2)[0])
+		if err == nil {
+			cs = append(cs, candidate{l, quality})
+		}
+	}
+
+	sort.SliceStable(cs, func(i, j int) bool {
+		return cs[i].quality > cs[j].quality
+	})
+	if len(cs) == 0 {
+		return language.English
+	}
+
+	return cs[0].language
+}
+
+// Message returns the message of the error of the code in the language,
+// or fallback, the message in English, if the catalog does not have it.
+func Message(l language.Language, code, fallback string) string {
+	if m, ok := catalogs[l].errors[code]; ok {
+		return m
+	}
+
+	return fallback
+}
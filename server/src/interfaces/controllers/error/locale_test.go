@@ -0,0 +1,51 @@
+package error
+
+import (
+	"testing"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		expected       language.Language
+	}{
+		{"", language.English},
+		{"ja", language.Japanese},
+		{"ja-JP,ja;q=0.9,en-US;q=0.8,en;q=0.7", language.Japanese},
+		{"en-US,en;q=0.9,ja;q=0.8", language.English},
+		{"fr-FR, ja;q=0.5, en;q=0.4", language.Japanese},
+		{"en;q=0.3, JA;q=0.6", language.Japanese},
+		{"ja;q=0, en", language.English},
+		{"fr, *;q=0.5", language.English},
+		{"zh-CN", language.English},
+		{"ja;q=abc, en;q=0.1", language.English},
+	}
+
+	for _, test := range tests {
+		t.Run(test.acceptLanguage, func(t *testing.T) {
+			if l := Negotiate(test.acceptLanguage); l != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, l)
+			}
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		language language.Language
+		code     string
+		expected string
+	}{
+		{language.English, "term_not_found", "term not found"},
+		{language.Japanese, "term_not_found", "学期が見つかりません"},
+		{language.Japanese, "unknown_code", "term not found"},
+	}
+
+	for _, test := range tests {
+		if m := Message(test.language, test.code, "term not found"); m != test.expected {
+			t.Fatalf("expected: %v; got: %v\n", test.expected, m)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package error
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+)
+
+// FieldsError is the error of a request whose fields broke the rules of the validator.
+// It is InvalidJSONFormat with the errors of the fields.
+type FieldsError struct {
+	fields validator.ValidationErrors
+}
+
+func (e FieldsError) Error() string {
+	return InvalidJSONFormat.Error()
+}
+
+func (e FieldsError) Unwrap() error {
+	return InvalidJSONFormat
+}
+
+// Fields returns the errors of the fields named by their JSON keys, like "timetable.mon.1.subject",
+// with the messages in the language.
+func (e FieldsError) Fields(l language.Language) []FieldJSON {
+	fs := make([]FieldJSON, 0, len(e.fields))
+	for _, f := range e.fields {
+		name := f.Namespace()
+		if i := strings.Index(name, "."); i >= 0 {
+			name = name[i+1:]
+		}
+		fs = append(fs, FieldJSON{name, f.Tag(), f.Param(), fieldMessage(l, name, f)})
+	}
+
+	return fs
+}
+
+func fieldMessage(l language.Language, name string, f validator.FieldError) string {
+	key := f.Tag()
+	if (key == "min" || key == "max") && f.Kind() == reflect.String {
+		key += "_string"
+	}
+
+	fields := catalogs[l].fields
+	m, ok := fields[key]
+	if !ok {
+		m = fields[""]
+	}
+	if strings.Count(m, "%s") == 2 {
+		return fmt.Sprintf(m, name, f.Param())
+	}
+	return fmt.Sprintf(m, name)
+}
+
+// NewValidator returns a validator which names fields by their JSON keys.
+func NewValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+		if name == "" || name == "-" {
+			return f.Name
+		}
+		return name
+	})
+
+	return v
+}
+
+// Validate checks the fields of a request by the rules of its tags.
+func Validate(s interface{}) error {
+	return Fields(NewValidator().Struct(s))
+}
+
+// Fields converts the errors of the fields reported by a validator into FieldsError.
+// The other errors, which are failures of the validator, are returned as they are.
+func Fields(err error) error {
+	if fs, ok := err.(validator.ValidationErrors); ok {
+		return FieldsError{fs}
+	}
+
+	return err
+}
+
+// InvalidOr returns err if it tells what is invalid in a request, or fallback otherwise,
+// like errors of parsing the fields which the validator already checked.
+func InvalidOr(err, fallback error) error {
+	if errs.KindOf(err) == errs.KindInvalid {
+		return err
+	}
+
+	return fallback
+}
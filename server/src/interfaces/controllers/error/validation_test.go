@@ -0,0 +1,78 @@
+package error
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+)
+
+type class struct {
+	Subject string `json:"subject" validate:"required,max=5"`
+	Weeks   string `json:"weeks,omitempty" validate:"omitempty,oneof=every odd even"`
+}
+
+type timetable struct {
+	Title   string  `json:"title" validate:"required"`
+	Credits int     `json:"credits" validate:"min=1"`
+	Classes []class `json:"classes" validate:"dive"`
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate(timetable{"first", 2, []class{{"math", "odd"}}}); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	err := Validate(timetable{"", 0, []class{{"math", ""}, {"algebra", "weekly"}}})
+	if !errors.Is(err, InvalidJSONFormat) {
+		t.Fatalf("expected: %v; got: %v\n", InvalidJSONFormat, err)
+	}
+
+	var fe FieldsError
+	if !errors.As(err, &fe) {
+		t.Fatalf("expected: %T; got: %T\n", fe, err)
+	}
+
+	tests := []struct {
+		language language.Language
+		expected []FieldJSON
+	}{
+		{
+			language.English,
+			[]FieldJSON{
+				{"title", "required", "", "title is required"},
+				{"credits", "min", "1", "credits must be at least 1"},
+				{"classes[1].subject", "max", "5", "classes[1].subject must be at most 5 characters"},
+				{"classes[1].weeks", "oneof", "every odd even", "classes[1].weeks must be one of every odd even"},
+			},
+		},
+		{
+			language.Japanese,
+			[]FieldJSON{
+				{"title", "required", "", "titleは必須です"},
+				{"credits", "min", "1", "creditsは1以上にしてください"},
+				{"classes[1].subject", "max", "5", "classes[1].subjectは5文字以下にしてください"},
+				{"classes[1].weeks", "oneof", "every odd even", "classes[1].weeksは every odd even のいずれかにしてください"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		if fs := fe.Fields(test.language); fmt.Sprint(fs) != fmt.Sprint(test.expected) {
+			t.Fatalf("expected: %v; got: %v\n", test.expected, fs)
+		}
+	}
+}
+
+func TestInvalidOr(t *testing.T) {
+	invalidDate := errs.Invalid("invalid_date", "invalid date")
+
+	if err := InvalidOr(invalidDate, InvalidJSONFormat); err != invalidDate {
+		t.Fatalf("expected: %v; got: %v\n", invalidDate, err)
+	}
+	if err := InvalidOr(fmt.Errorf("strconv.Atoi: parsing \"a\": invalid syntax"), InvalidJSONFormat); err != InvalidJSONFormat {
+		t.Fatalf("expected: %v; got: %v\n", InvalidJSONFormat, err)
+	}
+}
@@ -0,0 +1,239 @@
+package exam
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	examModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	examUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/exam"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type ExamController struct {
+	examUsecase examUsecase.ExamUsecase
+}
+
+func NewExamController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	x examRepository.IExamRepository,
+	s subjectRepository.ISubjectRepository,
+	tm termRepository.ITermRepository,
+	b bellRepository.IBellRepository,
+) *ExamController {
+	return &ExamController{
+		examUsecase.NewExamUsecase(c, l, x, s, tm, b),
+	}
+}
+
+var InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+
+// ExamResponse is an exam, whose start and end are in RFC 3339.
+// Subject is the name of the subject, which is ignored on saving.
+type ExamResponse struct {
+	ID        string  `json:"id" validate:"required,numeric,ne=0,min=-1"`
+	SubjectID string  `json:"subject_id" validate:"required,numeric,min=1"`
+	Subject   string  `json:"subject"`
+	TermID    *string `json:"term_id" validate:"omitempty,numeric"`
+	Title     *string `json:"title" validate:"omitempty,max=85"`
+	Start     string  `json:"start" validate:"required"`
+	End       string  `json:"end" validate:"required"`
+	Room      *string `json:"room" validate:"omitempty,max=85"`
+}
+
+func (e ExamResponse) Validate() error {
+	return errorResponse.Validate(e)
+}
+
+func (e ExamResponse) toExam() (examModel.Exam, error) {
+	id, err := strconv.Atoi(e.ID)
+	if err != nil {
+		return examModel.Exam{}, err
+	}
+	subjectID, err := strconv.Atoi(e.SubjectID)
+	if err != nil {
+		return examModel.Exam{}, err
+	}
+	termID := 0
+	if e.TermID != nil {
+		termID, err = strconv.Atoi(*e.TermID)
+		if err != nil {
+			return examModel.Exam{}, err
+		}
+	}
+
+	start, err := time.Parse(time.RFC3339, e.Start)
+	if err != nil {
+		return examModel.Exam{}, err
+	}
+	end, err := time.Parse(time.RFC3339, e.End)
+	if err != nil {
+		return examModel.Exam{}, err
+	}
+
+	optional := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	return examModel.NewExam(id, subjectID, termID, optional(e.Title), start, end, optional(e.Room))
+}
+
+func toExamResponse(e examModel.Exam) ExamResponse {
+	optional := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+
+	res := ExamResponse{
+		ID:        strconv.Itoa(e.ID()),
+		SubjectID: strconv.Itoa(e.SubjectID()),
+		Subject:   e.Subject(),
+		Title:     optional(e.Title()),
+		Start:     e.Start().Format(time.RFC3339),
+		End:       e.End().Format(time.RFC3339),
+		Room:      optional(e.Room()),
+	}
+	if e.TermID() > 0 {
+		res.TermID = optional(strconv.Itoa(e.TermID()))
+	}
+
+	return res
+}
+
+// Save adds an exam, or updates it if its ID is not -1.
+func (c ExamController) Save(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(ExamResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	exam, err := res.toExam()
+	if err != nil {
+		return errorResponse.InvalidOr(err, InvalidJSONFormat)
+	}
+
+	err = c.examUsecase.Save(ctx.Request().Context(), token.NewToken(t), exam)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type ExamsResponse struct {
+	Exams []ExamResponse `json:"exams"`
+}
+
+func (c ExamController) GetAll(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	exams, err := c.examUsecase.GetAll(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	res := ExamsResponse{[]ExamResponse{}}
+	for _, e := range exams {
+		res.Exams = append(res.Exams, toExamResponse(e))
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+type CountdownResponse struct {
+	ExamResponse
+	DaysUntil    int `json:"days_until"`
+	MinutesUntil int `json:"minutes_until"`
+}
+
+type UpcomingResponse struct {
+	Exams []CountdownResponse `json:"exams"`
+}
+
+// Upcoming serves the countdown of the exams which have not ended yet, sooner first.
+func (c ExamController) Upcoming(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	countdowns, err := c.examUsecase.Upcoming(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	res := UpcomingResponse{[]CountdownResponse{}}
+	for _, cd := range countdowns {
+		res.Exams = append(res.Exams, CountdownResponse{
+			toExamResponse(cd.Exam()),
+			cd.Days(),
+			cd.Minutes(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+type IDResponse struct {
+	ID string `json:"id" validate:"required,numeric,min=1"`
+}
+
+func (i IDResponse) Validate() error {
+	return errorResponse.Validate(i)
+}
+
+func (c ExamController) Delete(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(IDResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+
+	err = c.examUsecase.Delete(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -0,0 +1,271 @@
+package exception
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	exceptionModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	timetablesController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/timetables"
+	exceptionUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/exception"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type ExceptionController struct {
+	exceptionUsecase exceptionUsecase.ExceptionUsecase
+}
+
+func NewExceptionController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	e exceptionRepository.IExceptionRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) *ExceptionController {
+	return &ExceptionController{
+		exceptionUsecase.NewExceptionUsecase(c, l, e, t, tm),
+	}
+}
+
+var (
+	InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+	InvalidID         = errs.Invalid("invalid_id", "invalid ID")
+	InvalidDate       = errs.Invalid("invalid_date", "invalid date")
+	InvalidSpan       = errs.Invalid("invalid_span", "invalid span")
+)
+
+type ExceptionResponse struct {
+	ID       string  `json:"id" validate:"required,numeric,ne=0,min=-1"`
+	Kind     string  `json:"kind" validate:"required,oneof=cancelled room_changed make_up moved"`
+	Date     string  `json:"date" validate:"required"`
+	Period   int     `json:"period" validate:"required,min=1,max=5"`
+	Subject  *string `json:"subject,omitempty" validate:"omitempty,max=85"`
+	Room     *string `json:"room,omitempty" validate:"omitempty,max=85"`
+	Memo     *string `json:"memo,omitempty" validate:"omitempty,max=170"`
+	ToDate   *string `json:"to_date,omitempty"`
+	ToPeriod *int    `json:"to_period,omitempty" validate:"omitempty,min=1,max=5"`
+}
+
+func (e ExceptionResponse) Validate() error {
+	return errorResponse.Validate(e)
+}
+
+func (e ExceptionResponse) toException() (exceptionModel.Exception, error) {
+	id, err := strconv.Atoi(e.ID)
+	if err != nil {
+		return exceptionModel.Exception{}, err
+	}
+	if id == 0 {
+		return exceptionModel.Exception{}, InvalidID
+	}
+
+	kind, err := exceptionModel.ParseKind(e.Kind)
+	if err != nil {
+		return exceptionModel.Exception{}, err
+	}
+
+	switch kind {
+	case exceptionModel.RoomChanged:
+		if e.Room == nil {
+			return exceptionModel.Exception{}, InvalidJSONFormat
+		}
+		return exceptionModel.NewRoomChange(id, e.Date, e.Period, *e.Room)
+	case exceptionModel.MadeUp:
+		if e.Subject == nil {
+			return exceptionModel.Exception{}, InvalidJSONFormat
+		}
+		return exceptionModel.NewMakeUp(id, e.Date, e.Period, e.class())
+	case exceptionModel.Moved:
+		if e.ToDate == nil || e.ToPeriod == nil {
+			return exceptionModel.Exception{}, InvalidJSONFormat
+		}
+		return exceptionModel.NewMove(id, e.Date, e.Period, *e.ToDate, *e.ToPeriod)
+	default:
+		return exceptionModel.NewCancellation(id, e.Date, e.Period)
+	}
+}
+
+func (e ExceptionResponse) class() timetablesModel.Class {
+	memo := ""
+	if e.Memo != nil {
+		memo = *e.Memo
+	}
+
+	if e.Room == nil {
+		return timetablesModel.NoRoom(*e.Subject, memo)
+	}
+	return timetablesModel.NewClass(*e.Subject, *e.Room, memo)
+}
+
+func toExceptionResponse(e exceptionModel.Exception) ExceptionResponse {
+	res := ExceptionResponse{
+		ID:     strconv.Itoa(e.ID()),
+		Kind:   e.Kind().String(),
+		Date:   e.TextDate(),
+		Period: e.Period(),
+	}
+
+	switch e.Kind() {
+	case exceptionModel.RoomChanged:
+		room := e.Room()
+		res.Room = &room
+	case exceptionModel.MadeUp:
+		c := e.Class()
+		subject := c.Subject()
+		res.Subject = &subject
+		if !c.IsNoRoom() {
+			room := c.Room()
+			res.Room = &room
+		}
+		if c.Memo() != "" {
+			memo := c.Memo()
+			res.Memo = &memo
+		}
+	case exceptionModel.Moved:
+		toDate := e.TextToDate()
+		toPeriod := e.ToPeriod()
+		res.ToDate = &toDate
+		res.ToPeriod = &toPeriod
+	}
+
+	return res
+}
+
+func (c ExceptionController) Add(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(ExceptionResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	exception, err := res.toException()
+	if err != nil {
+		return errorResponse.InvalidOr(err, InvalidJSONFormat)
+	}
+
+	err = c.exceptionUsecase.Add(ctx.Request().Context(), token.NewToken(t), exception)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type IDResponse struct {
+	ID string `json:"id" validate:"required,numeric,ne=0,min=-1"`
+}
+
+func (i IDResponse) Validate() error {
+	return errorResponse.Validate(i)
+}
+
+func (c ExceptionController) Delete(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(IDResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return err
+	}
+
+	err = c.exceptionUsecase.Delete(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type ExceptionsResponse struct {
+	Exceptions []ExceptionResponse `json:"exceptions"`
+}
+
+func toExceptionsResponse(es []exceptionModel.Exception) ExceptionsResponse {
+	res := []ExceptionResponse{}
+	for _, e := range es {
+		res = append(res, toExceptionResponse(e))
+	}
+
+	return ExceptionsResponse{res}
+}
+
+func (c ExceptionController) GetAll(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	exceptions, err := c.exceptionUsecase.GetAll(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toExceptionsResponse(exceptions))
+}
+
+type DayResponse struct {
+	Date      string                             `json:"date"`
+	Timetable timetablesController.TimetableJSON `json:"timetable"`
+}
+
+// Effective serves the timetable of a day or a week with the exceptions applied.
+// The span query parameter is either "week" (default) or "day".
+func (c ExceptionController) Effective(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	d, err := time.Parse(exceptionModel.Layout, ctx.QueryParam("date"))
+	if err != nil {
+		return InvalidDate
+	}
+
+	var res interface{}
+	switch ctx.QueryParam("span") {
+	case "", "week":
+		var week timetablesModel.Timetables
+		week, err = c.exceptionUsecase.EffectiveWeek(ctx.Request().Context(), token.NewToken(t), d)
+		res = timetablesController.ToTimetablesResponse(week)
+	case "day":
+		var day timetablesModel.Timetable
+		day, err = c.exceptionUsecase.EffectiveDay(ctx.Request().Context(), token.NewToken(t), d)
+		res = DayResponse{d.Format(exceptionModel.Layout), timetablesController.ToTimetableJSON(day)}
+	default:
+		return InvalidSpan
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
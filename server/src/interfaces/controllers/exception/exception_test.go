@@ -0,0 +1,63 @@
+package exception
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExceptionResponseRoundTrip(t *testing.T) {
+	room := "202"
+	subject := "A"
+	toDate := "2020-04-11"
+	toPeriod := 3
+
+	tcs := []struct {
+		Name  string
+		Input ExceptionResponse
+	}{
+		{"cancelled", ExceptionResponse{ID: "1", Kind: "cancelled", Date: "2020-04-08", Period: 1}},
+		{"room changed", ExceptionResponse{ID: "2", Kind: "room_changed", Date: "2020-04-08", Period: 2, Room: &room}},
+		{"make up", ExceptionResponse{ID: "3", Kind: "make_up", Date: "2020-04-08", Period: 3, Subject: &subject, Room: &room}},
+		{"moved", ExceptionResponse{ID: "4", Kind: "moved", Date: "2020-04-08", Period: 4, ToDate: &toDate, ToPeriod: &toPeriod}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			if err := tc.Input.Validate(); err != nil {
+				t.Fatalf("should be valid: %v", err)
+			}
+
+			e, err := tc.Input.toException()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := toExceptionResponse(e).toException()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, e) {
+				t.Fatalf("expected: %v; got: %v\n", e, got)
+			}
+		})
+	}
+}
+
+func TestToExceptionRequiresFields(t *testing.T) {
+	tcs := []struct {
+		Name  string
+		Input ExceptionResponse
+	}{
+		{"room changed without room", ExceptionResponse{ID: "-1", Kind: "room_changed", Date: "2020-04-08", Period: 1}},
+		{"make up without subject", ExceptionResponse{ID: "-1", Kind: "make_up", Date: "2020-04-08", Period: 1}},
+		{"moved without destination", ExceptionResponse{ID: "-1", Kind: "moved", Date: "2020-04-08", Period: 1}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := tc.Input.toException(); err == nil {
+				t.Fatal("expected error but got nil")
+			}
+		})
+	}
+}
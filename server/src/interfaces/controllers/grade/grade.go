@@ -0,0 +1,319 @@
+package grade
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	gradeModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/grade"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	gradeRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/grade"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	gradeUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/grade"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type GradeController struct {
+	gradeUsecase gradeUsecase.GradeUsecase
+}
+
+func NewGradeController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	g gradeRepository.IGradeRepository,
+	s subjectRepository.ISubjectRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) *GradeController {
+	return &GradeController{
+		gradeUsecase.NewGradeUsecase(c, l, g, s, t, tm),
+	}
+}
+
+var (
+	InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+	InvalidScope      = errs.Invalid("invalid_scope", "invalid scope")
+	UnknownPreset     = errs.Invalid("unknown_preset", "unknown grading scale preset")
+)
+
+type ScoreResponse struct {
+	ID        string  `json:"id" validate:"required,numeric,ne=0,min=-1"`
+	SubjectID string  `json:"subject_id" validate:"required,numeric,min=1"`
+	Title     string  `json:"title" validate:"required,max=85"`
+	Points    float64 `json:"points" validate:"min=0"`
+	Max       float64 `json:"max" validate:"gt=0"`
+	Weight    float64 `json:"weight" validate:"gt=0"`
+}
+
+func (s ScoreResponse) Validate() error {
+	return errorResponse.Validate(s)
+}
+
+func (s ScoreResponse) toScore() (gradeModel.Score, error) {
+	id, err := strconv.Atoi(s.ID)
+	if err != nil {
+		return gradeModel.Score{}, err
+	}
+	subject, err := strconv.Atoi(s.SubjectID)
+	if err != nil {
+		return gradeModel.Score{}, err
+	}
+
+	return gradeModel.NewScore(id, subject, s.Title, s.Points, s.Max, s.Weight)
+}
+
+func toScoreResponse(s gradeModel.Score) ScoreResponse {
+	return ScoreResponse{
+		ID:        strconv.Itoa(s.ID()),
+		SubjectID: strconv.Itoa(s.SubjectID()),
+		Title:     s.Title(),
+		Points:    s.Points(),
+		Max:       s.Max(),
+		Weight:    s.Weight(),
+	}
+}
+
+func (c GradeController) AddScore(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(ScoreResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	score, err := res.toScore()
+	if err != nil {
+		return errorResponse.InvalidOr(err, InvalidJSONFormat)
+	}
+
+	err = c.gradeUsecase.AddScore(ctx.Request().Context(), token.NewToken(t), score)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type ScoresResponse struct {
+	Scores []ScoreResponse `json:"scores"`
+}
+
+func (c GradeController) GetScores(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	scores, err := c.gradeUsecase.GetScores(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	res := ScoresResponse{[]ScoreResponse{}}
+	for _, s := range scores {
+		res.Scores = append(res.Scores, toScoreResponse(s))
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+type IDResponse struct {
+	ID string `json:"id" validate:"required,numeric,min=1"`
+}
+
+func (i IDResponse) Validate() error {
+	return errorResponse.Validate(i)
+}
+
+func (c GradeController) DeleteScore(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(IDResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+
+	err = c.gradeUsecase.DeleteScore(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type BandJSON struct {
+	Grade  string  `json:"grade" validate:"required,max=8"`
+	Min    float64 `json:"min" validate:"min=0,max=100"`
+	Points float64 `json:"points" validate:"min=0"`
+}
+
+// ScaleResponse gives a grading scale either by the name of a preset or by its bands.
+type ScaleResponse struct {
+	Preset *string    `json:"preset,omitempty"`
+	Bands  []BandJSON `json:"bands" validate:"omitempty,dive"`
+}
+
+func (s ScaleResponse) Validate() error {
+	return errorResponse.Validate(s)
+}
+
+func (s ScaleResponse) toScale() (gradeModel.Scale, error) {
+	if s.Preset != nil {
+		scale, ok := gradeModel.Presets[*s.Preset]
+		if !ok {
+			return gradeModel.Scale{}, UnknownPreset
+		}
+		return scale, nil
+	}
+
+	bands := make([]gradeModel.Band, 0)
+	for _, b := range s.Bands {
+		bands = append(bands, gradeModel.NewBand(b.Grade, b.Min, b.Points))
+	}
+
+	return gradeModel.NewScale(bands)
+}
+
+func toScaleResponse(s gradeModel.Scale) ScaleResponse {
+	res := ScaleResponse{Bands: []BandJSON{}}
+	for _, b := range s.Bands() {
+		res.Bands = append(res.Bands, BandJSON{b.Grade(), b.Min(), b.Points()})
+	}
+
+	return res
+}
+
+func (c GradeController) SetScale(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(ScaleResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	scale, err := res.toScale()
+	if err != nil {
+		return err
+	}
+
+	err = c.gradeUsecase.SetScale(ctx.Request().Context(), token.NewToken(t), scale)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (c GradeController) GetScale(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	scale, err := c.gradeUsecase.Scale(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toScaleResponse(scale))
+}
+
+type ProjectionResponse struct {
+	SubjectID  string          `json:"subject_id"`
+	Subject    string          `json:"subject"`
+	Credits    int             `json:"credits"`
+	Percentage float64         `json:"percentage"`
+	Grade      string          `json:"grade"`
+	Points     float64         `json:"points"`
+	Scores     []ScoreResponse `json:"scores"`
+}
+
+type ReportResponse struct {
+	Scale    ScaleResponse        `json:"scale"`
+	GPA      *float64             `json:"gpa"`
+	Credits  int                  `json:"credits"`
+	Subjects []ProjectionResponse `json:"subjects"`
+}
+
+func toReportResponse(r gradeModel.Report, s gradeModel.Scale) ReportResponse {
+	res := ReportResponse{
+		Scale:    toScaleResponse(s),
+		Credits:  r.Credits(),
+		Subjects: []ProjectionResponse{},
+	}
+	if gpa, ok := r.GPA(); ok {
+		res.GPA = &gpa
+	}
+
+	for _, p := range r.Projections() {
+		subject := p.Subject()
+		pr := ProjectionResponse{
+			SubjectID:  strconv.Itoa(subject.ID()),
+			Subject:    subject.Name(),
+			Credits:    subject.Credits(),
+			Percentage: p.Percentage(),
+			Grade:      p.Band().Grade(),
+			Points:     p.Band().Points(),
+			Scores:     []ScoreResponse{},
+		}
+		for _, score := range p.Scores() {
+			pr.Scores = append(pr.Scores, toScoreResponse(score))
+		}
+		res.Subjects = append(res.Subjects, pr)
+	}
+
+	return res
+}
+
+// Report serves the projected grades and GPA of the subjects of the active term,
+// or of every subject with ?scope=all.
+func (c GradeController) Report(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	scope := ctx.QueryParam("scope")
+	if scope != "" && scope != "term" && scope != "all" {
+		return InvalidScope
+	}
+
+	report, scale, err := c.gradeUsecase.Report(ctx.Request().Context(), token.NewToken(t), scope == "all")
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toReportResponse(report, scale))
+}
@@ -0,0 +1,320 @@
+package group
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	groupModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/group"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	groupRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/group"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	groupUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/group"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type GroupController struct {
+	groupUsecase groupUsecase.GroupUsecase
+}
+
+func NewGroupController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	g groupRepository.IGroupRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) *GroupController {
+	return &GroupController{
+		groupUsecase.NewGroupUsecase(c, l, g, t, tm),
+	}
+}
+
+var (
+	InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+	InvalidID         = errs.Invalid("invalid_id", "invalid ID")
+	InvalidMinimum    = errs.Invalid("invalid_minimum", "invalid minimum number of members")
+)
+
+type NewGroupResponse struct {
+	Name    string `json:"name" validate:"required,max=85"`
+	Sharing bool   `json:"sharing"`
+}
+
+func (g NewGroupResponse) Validate() error {
+	return errorResponse.Validate(g)
+}
+
+func (c GroupController) Create(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(NewGroupResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	err = c.groupUsecase.Create(ctx.Request().Context(), token.NewToken(t), res.Name, res.Sharing)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type MemberJSON struct {
+	Username string `json:"username"`
+	Joined   bool   `json:"joined"`
+	Sharing  bool   `json:"sharing"`
+}
+
+type GroupResponse struct {
+	ID      string       `json:"id"`
+	Name    string       `json:"name"`
+	Owner   string       `json:"owner"`
+	Members []MemberJSON `json:"members"`
+}
+
+type GroupsResponse struct {
+	Groups []GroupResponse `json:"groups"`
+}
+
+func toGroupResponse(g groupModel.Group) GroupResponse {
+	members := []MemberJSON{}
+	for _, m := range g.Members() {
+		members = append(members, MemberJSON{m.Username().Name(), m.Joined(), m.Sharing()})
+	}
+
+	return GroupResponse{strconv.Itoa(g.ID()), g.Name(), g.Owner().Name(), members}
+}
+
+// GetAll serves the groups the user has joined or been invited to.
+func (c GroupController) GetAll(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	groups, err := c.groupUsecase.GetAll(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	res := []GroupResponse{}
+	for _, g := range groups {
+		res = append(res, toGroupResponse(g))
+	}
+
+	return ctx.JSON(http.StatusOK, GroupsResponse{res})
+}
+
+type IDResponse struct {
+	ID string `json:"id" validate:"required,numeric,min=1"`
+}
+
+func (i IDResponse) Validate() error {
+	return errorResponse.Validate(i)
+}
+
+// Leave removes the user from a group. The group is removed if the user owns it.
+func (c GroupController) Leave(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(IDResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return InvalidID
+	}
+
+	err = c.groupUsecase.Leave(ctx.Request().Context(), token.NewToken(t), id)
+
+	return c.respond(ctx, err)
+}
+
+type InvitationResponse struct {
+	ID       string `json:"id" validate:"required,numeric,min=1"`
+	Username string `json:"username" validate:"required,alphanum,max=255"`
+}
+
+func (i InvitationResponse) Validate() error {
+	return errorResponse.Validate(i)
+}
+
+// Invite invites a user to a group owned by the user signed in.
+func (c GroupController) Invite(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(InvitationResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return InvalidID
+	}
+	invitee, err := username.NewUsername(res.Username)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+
+	err = c.groupUsecase.Invite(ctx.Request().Context(), token.NewToken(t), id, invitee)
+
+	return c.respond(ctx, err)
+}
+
+type JoinResponse struct {
+	ID      string `json:"id" validate:"required,numeric,min=1"`
+	Sharing bool   `json:"sharing"`
+}
+
+func (j JoinResponse) Validate() error {
+	return errorResponse.Validate(j)
+}
+
+// Join accepts an invitation to a group, or changes the consent to sharing timetables with it.
+func (c GroupController) Join(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(JoinResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return InvalidID
+	}
+
+	err = c.groupUsecase.Join(ctx.Request().Context(), token.NewToken(t), id, res.Sharing)
+
+	return c.respond(ctx, err)
+}
+
+// respond maps the errors of changing the members of a group to responses.
+func (c GroupController) respond(ctx echo.Context, err error) error {
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type SlotJSON struct {
+	Day    string   `json:"day"`
+	Period int      `json:"period"`
+	Free   []string `json:"free"`
+	Busy   []string `json:"busy"`
+}
+
+type FreeResponse struct {
+	Members int        `json:"members"`
+	Sharing int        `json:"sharing"`
+	Slots   []SlotJSON `json:"slots"`
+}
+
+func names(us []username.Username) []string {
+	ns := make([]string, 0, len(us))
+	for _, u := range us {
+		ns = append(ns, u.Name())
+	}
+
+	return ns
+}
+
+func toFreeResponse(g groupModel.Group, slots []groupModel.Slot, min int) FreeResponse {
+	res := FreeResponse{Slots: []SlotJSON{}}
+	for _, m := range g.Members() {
+		if m.Joined() {
+			res.Members++
+		}
+		if m.IsSharing() {
+			res.Sharing++
+		}
+	}
+
+	if min == 0 {
+		min = res.Sharing
+	}
+	for _, s := range groupModel.Free(slots, min) {
+		res.Slots = append(res.Slots, SlotJSON{
+			Day:    dayName(s.Weekday()),
+			Period: s.Period(),
+			Free:   names(s.Free()),
+			Busy:   names(s.Busy()),
+		})
+	}
+
+	return res
+}
+
+func dayName(w time.Weekday) string {
+	return strings.ToLower(w.String()[:3])
+}
+
+// Free serves the periods in which at least min members sharing their
+// timetables with the group are free, or all of them if min is omitted.
+func (c GroupController) Free(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	id, err := strconv.Atoi(ctx.QueryParam("id"))
+	if err != nil || id < 1 {
+		return InvalidID
+	}
+
+	min := 0
+	if q := ctx.QueryParam("min"); q != "" {
+		min, err = strconv.Atoi(q)
+		if err != nil || min < 1 {
+			return InvalidMinimum
+		}
+	}
+
+	g, slots, err := c.groupUsecase.Overlay(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toFreeResponse(g, slots, min))
+}
@@ -0,0 +1,110 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Database is the database the server depends on.
+type Database interface {
+	Ping(ctx context.Context) error
+}
+
+// Schema tells the version of the schema of the database, and the one the server expects.
+type Schema interface {
+	Current() (int, error)
+	Latest() int
+}
+
+// HealthController answers the probes of container orchestrators.
+type HealthController struct {
+	db       Database
+	schema   Schema
+	draining *int32
+}
+
+func NewHealthController(db Database, schema Schema) *HealthController {
+	return &HealthController{db, schema, new(int32)}
+}
+
+const (
+	StatusOK       = "ok"
+	StatusReady    = "ready"
+	StatusNotReady = "not_ready"
+
+	StatusDraining    = "draining"
+	StatusUnavailable = "unavailable"
+	StatusBehind      = "behind"
+	StatusAhead       = "ahead"
+)
+
+type LivenessJSON struct {
+	Status string `json:"status"`
+}
+
+// ReadinessJSON tells whether the server accepts requests, and the status of each of its dependencies.
+type ReadinessJSON struct {
+	Status   string     `json:"status"`
+	Database string     `json:"database"`
+	Schema   SchemaJSON `json:"schema"`
+}
+
+// SchemaJSON tells the versions of the schema, whose Current is omitted when the database is unavailable.
+type SchemaJSON struct {
+	Status  string `json:"status"`
+	Current *int   `json:"current"`
+	Latest  int    `json:"latest"`
+}
+
+// Drain makes the server not ready, so that no more requests are routed to it while shutting down.
+func (c *HealthController) Drain() {
+	atomic.StoreInt32(c.draining, 1)
+}
+
+// Live responds while the server is running, regardless of its dependencies,
+// so that it is not restarted for the failures of the database.
+func (c *HealthController) Live(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, LivenessJSON{StatusOK})
+}
+
+// Ready responds with 200 if the database is reachable and its schema is the one the server expects,
+// or otherwise with 503.
+func (c *HealthController) Ready(ctx echo.Context) error {
+	res := ReadinessJSON{
+		Status:   StatusReady,
+		Database: StatusOK,
+		Schema:   SchemaJSON{Status: StatusOK, Latest: c.schema.Latest()},
+	}
+
+	if err := c.db.Ping(ctx.Request().Context()); err != nil {
+		slog.ErrorContext(ctx.Request().Context(), "database unavailable", slog.Any("error", err))
+		res.Database, res.Schema.Status = StatusUnavailable, StatusUnavailable
+	} else if current, err := c.schema.Current(); err != nil {
+		slog.ErrorContext(ctx.Request().Context(), "schema unavailable", slog.Any("error", err))
+		res.Schema.Status = StatusUnavailable
+	} else {
+		res.Schema.Current = &current
+		switch {
+		case current < res.Schema.Latest:
+			res.Schema.Status = StatusBehind
+		case current > res.Schema.Latest:
+			res.Schema.Status = StatusAhead
+		}
+	}
+
+	if atomic.LoadInt32(c.draining) == 1 {
+		res.Status = StatusDraining
+	} else if res.Database != StatusOK || res.Schema.Status != StatusOK {
+		res.Status = StatusNotReady
+	}
+
+	if res.Status != StatusReady {
+		return ctx.JSON(http.StatusServiceUnavailable, res)
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,103 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+type database struct {
+	err error
+}
+
+func (d database) Ping(context.Context) error {
+	return d.err
+}
+
+type schema struct {
+	current int
+	err     error
+}
+
+func (s schema) Current() (int, error) {
+	return s.current, s.err
+}
+
+func (s schema) Latest() int {
+	return 4
+}
+
+func TestReady(t *testing.T) {
+	refused := fmt.Errorf("dial tcp: connection refused")
+
+	tests := []struct {
+		name     string
+		db       database
+		schema   schema
+		drain    bool
+		status   int
+		expected string
+	}{
+		{"ready", database{}, schema{4, nil}, false, http.StatusOK, `{"status":"ready","database":"ok","schema":{"status":"ok","current":4,"latest":4}}`},
+		{"database unavailable", database{refused}, schema{4, nil}, false, http.StatusServiceUnavailable, `{"status":"not_ready","database":"unavailable","schema":{"status":"unavailable","current":null,"latest":4}}`},
+		{"schema unavailable", database{}, schema{0, refused}, false, http.StatusServiceUnavailable, `{"status":"not_ready","database":"ok","schema":{"status":"unavailable","current":null,"latest":4}}`},
+		{"schema behind", database{}, schema{3, nil}, false, http.StatusServiceUnavailable, `{"status":"not_ready","database":"ok","schema":{"status":"behind","current":3,"latest":4}}`},
+		{"schema ahead", database{}, schema{5, nil}, false, http.StatusServiceUnavailable, `{"status":"not_ready","database":"ok","schema":{"status":"ahead","current":5,"latest":4}}`},
+		{"draining", database{}, schema{4, nil}, true, http.StatusServiceUnavailable, `{"status":"draining","database":"ok","schema":{"status":"ok","current":4,"latest":4}}`},
+	}
+
+	e := echo.New()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewHealthController(test.db, test.schema)
+			if test.drain {
+				c.Drain()
+			}
+
+			rec := httptest.NewRecorder()
+			if err := c.Ready(e.NewContext(httptest.NewRequest(http.MethodGet, "/readyz", nil), rec)); err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+
+			if rec.Code != test.status {
+				t.Fatalf("expected: %v; got: %v\n", test.status, rec.Code)
+			}
+			if body := compact(t, rec.Body.Bytes()); body != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, body)
+			}
+		})
+	}
+}
+
+func TestLive(t *testing.T) {
+	c := NewHealthController(database{fmt.Errorf("dial tcp: connection refused")}, schema{})
+	c.Drain()
+
+	rec := httptest.NewRecorder()
+	if err := c.Live(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/healthz", nil), rec)); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected: %v; got: %v\n", http.StatusOK, rec.Code)
+	}
+}
+
+func compact(t *testing.T, b []byte) string {
+	v := ReadinessJSON{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	c, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	return string(c)
+}
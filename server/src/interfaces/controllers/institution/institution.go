@@ -0,0 +1,426 @@
+package institution
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	institutionModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/institution"
+	templateModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/template"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	institutionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/institution"
+	templateRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/template"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	timetablesController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/timetables"
+	institutionUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/institution"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type InstitutionController struct {
+	institutionUsecase institutionUsecase.InstitutionUsecase
+}
+
+func NewInstitutionController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	i institutionRepository.IInstitutionRepository,
+	tp templateRepository.ITemplateRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) *InstitutionController {
+	return &InstitutionController{
+		institutionUsecase.NewInstitutionUsecase(c, l, i, tp, t, tm),
+	}
+}
+
+var (
+	InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+	InvalidID         = errs.Invalid("invalid_id", "invalid ID")
+)
+
+type NewInstitutionResponse struct {
+	Name string `json:"name" validate:"required,max=85"`
+}
+
+func (i NewInstitutionResponse) Validate() error {
+	return errorResponse.Validate(i)
+}
+
+// Create makes an institution administered by the user signed in.
+func (c InstitutionController) Create(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(NewInstitutionResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	err = c.institutionUsecase.Create(ctx.Request().Context(), token.NewToken(t), res.Name)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type MemberJSON struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	Joined   bool   `json:"joined"`
+}
+
+type InstitutionResponse struct {
+	ID      string       `json:"id"`
+	Name    string       `json:"name"`
+	Members []MemberJSON `json:"members"`
+}
+
+type InstitutionsResponse struct {
+	Institutions []InstitutionResponse `json:"institutions"`
+}
+
+func toInstitutionResponse(i institutionModel.Institution) InstitutionResponse {
+	members := []MemberJSON{}
+	for _, m := range i.Members() {
+		members = append(members, MemberJSON{m.Username().Name(), m.Role().String(), m.Joined()})
+	}
+
+	return InstitutionResponse{strconv.Itoa(i.ID()), i.Name(), members}
+}
+
+// GetAll serves the institutions the user has joined or been invited to.
+func (c InstitutionController) GetAll(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	institutions, err := c.institutionUsecase.GetAll(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	res := []InstitutionResponse{}
+	for _, i := range institutions {
+		res = append(res, toInstitutionResponse(i))
+	}
+
+	return ctx.JSON(http.StatusOK, InstitutionsResponse{res})
+}
+
+type IDResponse struct {
+	ID string `json:"id" validate:"required,numeric,min=1"`
+}
+
+func (i IDResponse) Validate() error {
+	return errorResponse.Validate(i)
+}
+
+// bindID reads the ID of the body.
+func bindID(ctx echo.Context) (int, error) {
+	res := new(IDResponse)
+	if err := ctx.Bind(res); err != nil {
+		return 0, InvalidJSONFormat
+	}
+	if err := res.Validate(); err != nil {
+		return 0, err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return 0, InvalidID
+	}
+
+	return id, nil
+}
+
+// Join accepts an invitation to an institution.
+func (c InstitutionController) Join(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	id, err := bindID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = c.institutionUsecase.Join(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Leave removes the user from an institution, or declines an invitation to it.
+// The institution is removed if the user is its last member.
+func (c InstitutionController) Leave(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	id, err := bindID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = c.institutionUsecase.Leave(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type MemberResponse struct {
+	ID       string `json:"id" validate:"required,numeric,min=1"`
+	Username string `json:"username" validate:"required,alphanum,max=255"`
+	Role     string `json:"role" validate:"omitempty,oneof=student admin"`
+}
+
+func (m MemberResponse) Validate() error {
+	return errorResponse.Validate(m)
+}
+
+// SetMember invites a user to an institution administered by the user signed in,
+// or changes the role of a member. The role is student if omitted.
+func (c InstitutionController) SetMember(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(MemberResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return InvalidID
+	}
+	u, err := username.NewUsername(res.Username)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	role := institutionModel.Student
+	if res.Role != "" {
+		role, err = institutionModel.ParseRole(res.Role)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = c.institutionUsecase.SetMember(ctx.Request().Context(), token.NewToken(t), id, u, role)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// RemoveMember removes a member from an institution administered by the user signed in.
+func (c InstitutionController) RemoveMember(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(MemberResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return InvalidID
+	}
+	u, err := username.NewUsername(res.Username)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+
+	err = c.institutionUsecase.RemoveMember(ctx.Request().Context(), token.NewToken(t), id, u)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// TemplateJSON is a template of timetables. A template without an ID is published as a new one.
+type TemplateJSON struct {
+	ID          string                              `json:"id,omitempty" validate:"omitempty,numeric,min=1"`
+	Institution string                              `json:"institution" validate:"required,numeric,min=1"`
+	Name        string                              `json:"name" validate:"required,max=85"`
+	Course      string                              `json:"course" validate:"max=85"`
+	Year        int                                 `json:"year" validate:"min=0,max=10"`
+	Timetables  timetablesController.TimetablesJSON `json:"timetable" validate:"-"`
+}
+
+// Validate checks the timetables of the template with the same limits as registered timetables.
+func (t TemplateJSON) Validate() error {
+	if err := errorResponse.Validate(t); err != nil {
+		return err
+	}
+
+	return timetablesController.TimetablesResponse{Timetables: t.Timetables}.Validate()
+}
+
+func (t TemplateJSON) toTemplate() (templateModel.Template, error) {
+	id := -1
+	if t.ID != "" {
+		n, err := strconv.Atoi(t.ID)
+		if err != nil {
+			return templateModel.Template{}, InvalidID
+		}
+		id = n
+	}
+	institution, err := strconv.Atoi(t.Institution)
+	if err != nil {
+		return templateModel.Template{}, InvalidID
+	}
+
+	ts := timetablesController.TimetablesResponse{Timetables: t.Timetables}.ToTimetables()
+
+	return templateModel.NewTemplate(id, institution, t.Name, t.Course, t.Year, ts)
+}
+
+func toTemplateJSON(t templateModel.Template) TemplateJSON {
+	return TemplateJSON{
+		ID:          strconv.Itoa(t.ID()),
+		Institution: strconv.Itoa(t.InstitutionID()),
+		Name:        t.Name(),
+		Course:      t.Course(),
+		Year:        t.Year(),
+		Timetables:  timetablesController.ToTimetablesResponse(t.Timetables()).Timetables,
+	}
+}
+
+type TemplatesResponse struct {
+	Templates []TemplateJSON `json:"templates"`
+}
+
+// SaveTemplate publishes a template to an institution administered by the user signed in, or updates it.
+func (c InstitutionController) SaveTemplate(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(TemplateJSON)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	tmpl, err := res.toTemplate()
+	if err != nil {
+		return err
+	}
+
+	err = c.institutionUsecase.SaveTemplate(ctx.Request().Context(), token.NewToken(t), tmpl)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Templates serves the templates of the institution of the "institution" query parameter.
+func (c InstitutionController) Templates(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	id, err := strconv.Atoi(ctx.QueryParam("institution"))
+	if err != nil || id < 1 {
+		return InvalidID
+	}
+
+	templates, err := c.institutionUsecase.Templates(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	res := []TemplateJSON{}
+	for _, tmpl := range templates {
+		res = append(res, toTemplateJSON(tmpl))
+	}
+
+	return ctx.JSON(http.StatusOK, TemplatesResponse{res})
+}
+
+// DeleteTemplate removes a template of an institution administered by the user signed in.
+func (c InstitutionController) DeleteTemplate(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	id, err := bindID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = c.institutionUsecase.DeleteTemplate(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Instantiate registers a template as the timetables of the term of the
+// "term" query parameter, or the active term. They can be customized
+// afterwards as any timetables, and the timetables replaced can be rolled back to.
+func (c InstitutionController) Instantiate(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	id, err := bindID(ctx)
+	if err != nil {
+		return err
+	}
+	term, specified, err := timetablesController.TermID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = c.institutionUsecase.Instantiate(ctx.Request().Context(), token.NewToken(t), id, term, specified)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
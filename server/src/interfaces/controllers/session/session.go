@@ -0,0 +1,152 @@
+package session
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	bellModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	sessionModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/session"
+	taskModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	taskRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/task"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	taskController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/task"
+	timetablesController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/timetables"
+	sessionUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/session"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type SessionController struct {
+	sessionUsecase sessionUsecase.SessionUsecase
+}
+
+func NewSessionController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	e exceptionRepository.IExceptionRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+	b bellRepository.IBellRepository,
+	tk taskRepository.ITaskRepository,
+	x examRepository.IExamRepository,
+	s subjectRepository.ISubjectRepository,
+) *SessionController {
+	return &SessionController{
+		sessionUsecase.NewSessionUsecase(c, l, e, t, tm, b, tk, x, s),
+	}
+}
+
+// SessionJSON is a class or an exam, told apart by Kind.
+// Exams have the ID and the title of the exam.
+type SessionJSON struct {
+	Kind   string  `json:"kind"`
+	Date   string  `json:"date"`
+	Period int     `json:"period"`
+	Start  string  `json:"start"`
+	End    string  `json:"end"`
+	ExamID *string `json:"exam_id,omitempty"`
+	Title  *string `json:"title,omitempty"`
+	timetablesController.ClassJSON
+}
+
+const (
+	ClassKind = "class"
+	ExamKind  = "exam"
+)
+
+type NowResponse struct {
+	Now              string                        `json:"now"`
+	Current          *SessionJSON                  `json:"current"`
+	Next             *SessionJSON                  `json:"next"`
+	MinutesUntilNext *int                          `json:"minutes_until_next"`
+	Tasks            []taskController.TaskResponse `json:"tasks"`
+}
+
+func toSessionJSON(s sessionModel.Session) *SessionJSON {
+	c := s.Class()
+	res := &SessionJSON{
+		Kind:      ClassKind,
+		Date:      s.Date().Format(timetablesController.DateLayout),
+		Period:    s.Period(),
+		Start:     s.Start().Format(bellModel.Layout),
+		End:       s.End().Format(bellModel.Layout),
+		ClassJSON: timetablesController.ClassJSON{Subject: c.Subject()},
+	}
+	if c.SubjectID() > 0 {
+		id := c.SubjectID()
+		res.SubjectID = &id
+	}
+	if !c.IsNoRoom() {
+		room := c.Room()
+		res.Room = &room
+	}
+	if c.Memo() != "" {
+		memo := c.Memo()
+		res.Memo = &memo
+	}
+	if e, ok := s.Exam(); ok {
+		res.Kind = ExamKind
+		id := strconv.Itoa(e.ID())
+		res.ExamID = &id
+		if e.Title() != "" {
+			title := e.Title()
+			res.Title = &title
+		}
+	}
+
+	return res
+}
+
+func toNowResponse(n sessionModel.Now) NowResponse {
+	res := NowResponse{
+		Now:   n.At().Format(time.RFC3339),
+		Tasks: []taskController.TaskResponse{},
+	}
+	if s, ok := n.Current(); ok {
+		res.Current = toSessionJSON(s)
+	}
+	if s, ok := n.Next(); ok {
+		res.Next = toSessionJSON(s)
+	}
+	if m, ok := n.MinutesUntilNext(); ok {
+		res.MinutesUntilNext = &m
+	}
+	for _, t := range n.Tasks() {
+		res.Tasks = append(res.Tasks, toTaskResponse(t))
+	}
+
+	return res
+}
+
+func toTaskResponse(t taskModel.Task) taskController.TaskResponse {
+	return taskController.TaskResponse{
+		ID:    strconv.Itoa(t.ID()),
+		Date:  t.TextDate(),
+		Title: t.Title(),
+	}
+}
+
+// Now serves the class taking place now and the one coming next, for widgets
+// and watches, with the tasks of their subjects due today.
+func (c SessionController) Now(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	n, err := c.sessionUsecase.Now(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toNowResponse(n))
+}
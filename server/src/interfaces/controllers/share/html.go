@@ -0,0 +1,60 @@
+package share
+
+import (
+	"bytes"
+	"html/template"
+
+	timetablesController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/timetables"
+)
+
+var page = template.Must(template.New("shared").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>時間割</title>
+<style>
+table { border-collapse: collapse; width: 100%; table-layout: fixed; }
+th, td { border: 1px solid #ccc; padding: 4px; vertical-align: top; }
+.room, .memo, .weeks { font-size: small; color: #555; }
+.alternate { border-top: 1px dashed #ccc; margin-top: 4px; }
+</style>
+</head>
+<body>
+<table>
+<tr><th></th><th>月</th><th>火</th><th>水</th><th>木</th><th>金</th></tr>
+{{range .Rows}}<tr><th>{{.Period}}</th>{{range .Days}}<td>{{with .}}{{template "class" .}}{{range .Alternates}}<div class="alternate">{{template "class" .}}</div>{{end}}{{end}}</td>{{end}}</tr>
+{{end}}</table>
+{{with .ExpiresAt}}<p class="memo">有効期限: {{.}}</p>{{end}}
+</body>
+</html>
+{{define "class"}}<div>{{.Subject}}</div>{{with .Room}}<div class="room">{{.}}</div>{{end}}{{with .Memo}}<div class="memo">{{.}}</div>{{end}}{{with .Weeks}}<div class="weeks">{{.}}</div>{{end}}{{end}}`))
+
+type row struct {
+	Period int
+	Days   []*timetablesController.ClassJSON
+}
+
+// render renders the shared timetables as a web page with a row for each period.
+func render(res SharedResponse) ([]byte, error) {
+	ts := res.Timetables
+	days := []timetablesController.TimetableJSON{ts.Mon, ts.Tue, ts.Wed, ts.Thu, ts.Fri}
+
+	rows := make([]row, 0, 5)
+	for n := 1; n <= 5; n++ {
+		r := row{Period: n}
+		for _, d := range days {
+			r.Days = append(r.Days, []*timetablesController.ClassJSON{d.One, d.Two, d.Three, d.Four, d.Five}[n-1])
+		}
+		rows = append(rows, r)
+	}
+
+	var b bytes.Buffer
+	err := page.Execute(&b, struct {
+		Rows      []row
+		ExpiresAt *string
+	}{rows, res.ExpiresAt})
+
+	return b.Bytes(), err
+}
@@ -0,0 +1,221 @@
+package share
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	shareModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/share"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	shareRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/share"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	timetablesController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/timetables"
+	shareUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/share"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type ShareController struct {
+	shareUsecase shareUsecase.ShareUsecase
+}
+
+func NewShareController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	s shareRepository.IShareRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) *ShareController {
+	return &ShareController{
+		shareUsecase.NewShareUsecase(c, l, s, t, tm),
+	}
+}
+
+const (
+	// SharedPath is the path of share links, followed by their secret tokens.
+	SharedPath = "/shared/"
+)
+
+var (
+	InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+	InvalidExpiry     = errs.Invalid("invalid_expiry", "invalid expiry")
+)
+
+// ShareResponse is a share link. Only hide_room, hide_memo and expires_at
+// are read when creating one, and expires_at may be null for links which never expire.
+type ShareResponse struct {
+	ID        string  `json:"id"`
+	URL       string  `json:"url"`
+	HideRoom  bool    `json:"hide_room"`
+	HideMemo  bool    `json:"hide_memo"`
+	ExpiresAt *string `json:"expires_at"`
+	Expired   bool    `json:"expired"`
+}
+
+func (s ShareResponse) toShare() (shareModel.Visibility, time.Time, error) {
+	v := shareModel.NewVisibility(!s.HideRoom, !s.HideMemo)
+	if s.ExpiresAt == nil {
+		return v, time.Time{}, nil
+	}
+
+	expires, err := time.Parse(time.RFC3339, *s.ExpiresAt)
+	if err != nil {
+		return v, time.Time{}, InvalidExpiry
+	}
+
+	return v, expires, nil
+}
+
+func toShareResponse(ctx echo.Context, s shareModel.Share, now time.Time) ShareResponse {
+	res := ShareResponse{
+		ID:       strconv.Itoa(s.ID()),
+		URL:      fmt.Sprintf("%s://%s%s%s", ctx.Scheme(), ctx.Request().Host, SharedPath, s.Token().Token()),
+		HideRoom: !s.Visibility().Room(),
+		HideMemo: !s.Visibility().Memo(),
+		Expired:  s.IsExpired(now),
+	}
+	if expires, ok := s.Expires(); ok {
+		e := expires.Format(time.RFC3339)
+		res.ExpiresAt = &e
+	}
+
+	return res
+}
+
+// Create issues a new share link of the timetables of the user.
+func (c ShareController) Create(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	req := new(ShareResponse)
+	err := ctx.Bind(req)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+
+	v, expires, err := req.toShare()
+	if err != nil {
+		return err
+	}
+
+	s, err := c.shareUsecase.Create(ctx.Request().Context(), token.NewToken(t), v, expires)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toShareResponse(ctx, s, time.Now()))
+}
+
+type SharesResponse struct {
+	Shares []ShareResponse `json:"shares"`
+}
+
+func (c ShareController) GetAll(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	shares, err := c.shareUsecase.GetAll(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	res := []ShareResponse{}
+	for _, s := range shares {
+		res = append(res, toShareResponse(ctx, s, now))
+	}
+
+	return ctx.JSON(http.StatusOK, SharesResponse{res})
+}
+
+type IDResponse struct {
+	ID string `json:"id" validate:"required,numeric,min=1"`
+}
+
+func (i IDResponse) Validate() error {
+	return errorResponse.Validate(i)
+}
+
+// Revoke removes a share link so that it stops working.
+func (c ShareController) Revoke(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(IDResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+
+	err = c.shareUsecase.Revoke(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type SharedResponse struct {
+	Timetables timetablesController.TimetablesJSON `json:"timetable"`
+	ExpiresAt  *string                             `json:"expires_at"`
+}
+
+// wantsHTML reports whether the shared timetables should be rendered as a web page,
+// either by the format query parameter or by the Accept header of browsers.
+func wantsHTML(ctx echo.Context) bool {
+	switch ctx.QueryParam("format") {
+	case "html":
+		return true
+	case "json":
+		return false
+	}
+
+	return strings.Contains(ctx.Request().Header.Get(echo.HeaderAccept), echo.MIMETextHTML)
+}
+
+// Shared serves the timetables of a share link to anyone who has its URL,
+// which authenticates with the secret token in the path instead of the Token header.
+func (c ShareController) Shared(ctx echo.Context) error {
+	s, ts, err := c.shareUsecase.Shared(ctx.Request().Context(), token.NewToken(ctx.Param("token")))
+	if err != nil {
+		return err
+	}
+
+	res := SharedResponse{Timetables: timetablesController.ToTimetablesResponse(ts).Timetables}
+	if expires, ok := s.Expires(); ok {
+		e := expires.Format(time.RFC3339)
+		res.ExpiresAt = &e
+	}
+
+	if !wantsHTML(ctx) {
+		return ctx.JSON(http.StatusOK, res)
+	}
+
+	page, err := render(res)
+	if err != nil {
+		return err
+	}
+
+	return ctx.HTMLBlob(http.StatusOK, page)
+}
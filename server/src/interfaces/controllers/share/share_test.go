@@ -0,0 +1,71 @@
+package share
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	timetablesController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/timetables"
+)
+
+func TestToShare(t *testing.T) {
+	valid := "2020-04-30T00:00:00+09:00"
+	invalid := "2020-04-30"
+
+	tests := []struct {
+		name       string
+		res        ShareResponse
+		room       bool
+		memo       bool
+		expires    bool
+		shouldFail bool
+	}{
+		{"never expires", ShareResponse{HideMemo: true}, true, false, false, false},
+		{"expires", ShareResponse{HideRoom: true, ExpiresAt: &valid}, false, true, true, false},
+		{"invalid expiry", ShareResponse{ExpiresAt: &invalid}, true, true, false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v, expires, err := test.res.toShare()
+			if test.shouldFail {
+				if !errors.Is(err, InvalidExpiry) {
+					t.Fatalf("expected: %v; got: %v\n", InvalidExpiry, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v.Room() != test.room || v.Memo() != test.memo || expires.IsZero() == test.expires {
+				t.Fatalf("unexpected share: %v %v\n", v, expires)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	room := "<b>101</b>"
+	class := &timetablesController.ClassJSON{Subject: "A & B", Room: &room}
+	res := SharedResponse{
+		Timetables: timetablesController.TimetablesJSON{
+			Wed: timetablesController.TimetableJSON{Three: class},
+		},
+	}
+
+	page, err := render(res)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	html := string(page)
+	for _, expected := range []string{"A &amp; B", "&lt;b&gt;101&lt;/b&gt;"} {
+		if !strings.Contains(html, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, html)
+		}
+	}
+	if strings.Contains(html, "<b>") {
+		t.Fatalf("room should be escaped: %v\n", html)
+	}
+}
@@ -0,0 +1,177 @@
+package subject
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	subjectModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	subjectUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/subject"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type SubjectController struct {
+	subjectUsecase subjectUsecase.SubjectUsecase
+}
+
+func NewSubjectController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	s subjectRepository.ISubjectRepository,
+) *SubjectController {
+	return &SubjectController{
+		subjectUsecase.NewSubjectUsecase(c, l, s),
+	}
+}
+
+var InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+
+type SubjectResponse struct {
+	ID         string  `json:"id" validate:"required,numeric,ne=0,min=-1"`
+	Name       string  `json:"name" validate:"required,max=85"`
+	Instructor *string `json:"instructor" validate:"omitempty,max=85"`
+	Credits    int     `json:"credits" validate:"min=0"`
+	Color      *string `json:"color" validate:"omitempty,hexcolor"`
+	Syllabus   *string `json:"syllabus" validate:"omitempty,url,max=510"`
+	Room       *string `json:"room" validate:"omitempty,max=85"`
+}
+
+func (s SubjectResponse) Validate() error {
+	return errorResponse.Validate(s)
+}
+
+func (s SubjectResponse) toSubject() (subjectModel.Subject, error) {
+	id, err := strconv.Atoi(s.ID)
+	if err != nil {
+		return subjectModel.Subject{}, err
+	}
+
+	optional := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	return subjectModel.NewSubject(
+		id,
+		s.Name,
+		optional(s.Instructor),
+		s.Credits,
+		optional(s.Color),
+		optional(s.Syllabus),
+		optional(s.Room),
+	)
+}
+
+func toSubjectResponse(s subjectModel.Subject) SubjectResponse {
+	optional := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+
+	return SubjectResponse{
+		ID:         strconv.Itoa(s.ID()),
+		Name:       s.Name(),
+		Instructor: optional(s.Instructor()),
+		Credits:    s.Credits(),
+		Color:      optional(s.Color()),
+		Syllabus:   optional(s.Syllabus()),
+		Room:       optional(s.Room()),
+	}
+}
+
+func (c SubjectController) Save(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(SubjectResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	subject, err := res.toSubject()
+	if err != nil {
+		return errorResponse.InvalidOr(err, InvalidJSONFormat)
+	}
+
+	err = c.subjectUsecase.Save(ctx.Request().Context(), token.NewToken(t), subject)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type SubjectsResponse struct {
+	Subjects []SubjectResponse `json:"subjects"`
+}
+
+func (c SubjectController) GetAll(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	subjects, err := c.subjectUsecase.GetAll(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	res := SubjectsResponse{[]SubjectResponse{}}
+	for _, s := range subjects {
+		res.Subjects = append(res.Subjects, toSubjectResponse(s))
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+type IDResponse struct {
+	ID string `json:"id" validate:"required,numeric,min=1"`
+}
+
+func (i IDResponse) Validate() error {
+	return errorResponse.Validate(i)
+}
+
+func (c SubjectController) Delete(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(IDResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+
+	err = c.subjectUsecase.Delete(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -1,12 +1,11 @@
 package task
 
 import (
-	"fmt"
 	"net/http"
 	"strconv"
 
-	"github.com/go-playground/validator"
 	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 	TaskModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
 	taskRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/task"
@@ -31,9 +30,9 @@ func NewTaskController(
 	}
 }
 
-const (
-	InvalidJSONFormat = "invalid JSON format"
-	InvalidID         = "invalid ID"
+var (
+	InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+	InvalidID         = errs.Invalid("invalid_id", "invalid ID")
 )
 
 type TaskResponse struct {
@@ -42,8 +41,8 @@ type TaskResponse struct {
 	Title string `json:"title" validate:"required,max=85"`
 }
 
-func (t TaskResponse) Validates() bool {
-	return validator.New().Struct(t) == nil
+func (t TaskResponse) Validate() error {
+	return errorResponse.Validate(t)
 }
 
 func (t TaskResponse) toTask() (TaskModel.Task, error) {
@@ -52,7 +51,7 @@ func (t TaskResponse) toTask() (TaskModel.Task, error) {
 		return TaskModel.Task{}, err
 	}
 	if id == 0 {
-		return TaskModel.Task{}, fmt.Errorf(InvalidID)
+		return TaskModel.Task{}, InvalidID
 	}
 
 	return TaskModel.NewTask(id, t.Date, t.Title)
@@ -61,48 +60,27 @@ func (t TaskResponse) toTask() (TaskModel.Task, error) {
 func (c TaskController) Add(ctx echo.Context) error {
 	t := ctx.Request().Header.Get("Token")
 	if t == "" {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(fmt.Errorf(credentialUsecase.InvalidToken)),
-		)
+		return credentialUsecase.InvalidToken
 	}
 
 	res := new(TaskResponse)
 	err := ctx.Bind(res)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(InvalidJSONFormat)),
-		)
+		return InvalidJSONFormat
 	}
 
-	if !res.Validates() {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(InvalidJSONFormat)),
-		)
+	if err := res.Validate(); err != nil {
+		return err
 	}
 
 	task, err := res.toTask()
 	if err != nil {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(InvalidJSONFormat)),
-		)
+		return errorResponse.InvalidOr(err, InvalidJSONFormat)
 	}
 
-	err = c.taskUsecase.Add(token.NewToken(t), task)
-	if err != nil && err.Error() == credentialUsecase.InvalidToken {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(err),
-		)
-	}
+	err = c.taskUsecase.Add(ctx.Request().Context(), token.NewToken(t), task)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
 	return ctx.NoContent(http.StatusOK)
@@ -112,55 +90,33 @@ type IDResponse struct {
 	ID string `json:"id" validate:"required,numeric,ne=0,min=-1"`
 }
 
-func (i IDResponse) Validates() bool {
-	return validator.New().Struct(i) == nil
+func (i IDResponse) Validate() error {
+	return errorResponse.Validate(i)
 }
 
 func (c TaskController) Delete(ctx echo.Context) error {
 	t := ctx.Request().Header.Get("Token")
 	if t == "" {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(fmt.Errorf(credentialUsecase.InvalidToken)),
-		)
+		return credentialUsecase.InvalidToken
 	}
 
 	res := new(IDResponse)
 	err := ctx.Bind(res)
-	if err != nil || !res.Validates() {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(InvalidJSONFormat)),
-		)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
 	}
 
 	id, err := strconv.Atoi(res.ID)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
-	}
-
-	err = c.taskUsecase.Delete(token.NewToken(t), id)
-	if err != nil && err.Error() == credentialUsecase.InvalidToken {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(fmt.Errorf(credentialUsecase.InvalidToken)),
-		)
-	}
-	if err != nil && (err.Error() == taskUsecase.IDIsNotZero ||
-		err.Error() == taskUsecase.InvalidID) {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(err),
-		)
+		return err
 	}
+
+	err = c.taskUsecase.Delete(ctx.Request().Context(), token.NewToken(t), id)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
 	return ctx.NoContent(http.StatusOK)
@@ -186,24 +142,12 @@ func toTasksResponse(ts []TaskModel.Task) TasksResponse {
 func (c TaskController) GetAll(ctx echo.Context) error {
 	t := ctx.Request().Header.Get("Token")
 	if t == "" {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(fmt.Errorf(credentialUsecase.InvalidToken)),
-		)
+		return credentialUsecase.InvalidToken
 	}
 
-	tasks, err := c.taskUsecase.GetAll(token.NewToken(t))
-	if err != nil && err.Error() == credentialUsecase.InvalidToken {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(err),
-		)
-	}
+	tasks, err := c.taskUsecase.GetAll(ctx.Request().Context(), token.NewToken(t))
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
 	return ctx.JSON(http.StatusOK, toTasksResponse(tasks))
@@ -0,0 +1,176 @@
+package term
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	termModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type TermController struct {
+	termUsecase termUsecase.TermUsecase
+}
+
+func NewTermController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	t termRepository.ITermRepository,
+	tt timetablesRepository.ITimetablesRepository,
+) *TermController {
+	return &TermController{
+		termUsecase.NewTermUsecase(c, l, t, tt),
+	}
+}
+
+var (
+	InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+	InvalidID         = errs.Invalid("invalid_id", "invalid ID")
+)
+
+type TermResponse struct {
+	ID    string `json:"id" validate:"required,numeric,ne=0,min=-1"`
+	Name  string `json:"name" validate:"required,max=85"`
+	Start string `json:"start" validate:"required"`
+	End   string `json:"end" validate:"required"`
+}
+
+func (t TermResponse) Validate() error {
+	return errorResponse.Validate(t)
+}
+
+func (t TermResponse) toTerm() (termModel.Term, error) {
+	id, err := strconv.Atoi(t.ID)
+	if err != nil {
+		return termModel.Term{}, err
+	}
+	if id == 0 {
+		return termModel.Term{}, InvalidID
+	}
+
+	return termModel.NewTerm(id, t.Name, t.Start, t.End)
+}
+
+func toTermResponse(t termModel.Term) TermResponse {
+	return TermResponse{
+		ID:    strconv.Itoa(t.ID()),
+		Name:  t.Name(),
+		Start: t.TextStart(),
+		End:   t.TextEnd(),
+	}
+}
+
+func (c TermController) Add(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(TermResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	term, err := res.toTerm()
+	if err != nil {
+		return errorResponse.InvalidOr(err, InvalidJSONFormat)
+	}
+
+	err = c.termUsecase.Add(ctx.Request().Context(), token.NewToken(t), term)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type IDResponse struct {
+	ID string `json:"id" validate:"required,numeric,ne=0,min=-1"`
+}
+
+func (i IDResponse) Validate() error {
+	return errorResponse.Validate(i)
+}
+
+func (c TermController) Delete(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(IDResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return err
+	}
+
+	err = c.termUsecase.Delete(ctx.Request().Context(), token.NewToken(t), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type TermsResponse struct {
+	Terms  []TermResponse `json:"terms"`
+	Active *string        `json:"active"`
+}
+
+func toTermsResponse(ts []termModel.Term, active *termModel.Term) TermsResponse {
+	res := []TermResponse{}
+	for _, t := range ts {
+		res = append(res, toTermResponse(t))
+	}
+
+	if active == nil {
+		return TermsResponse{res, nil}
+	}
+
+	id := strconv.Itoa(active.ID())
+	return TermsResponse{res, &id}
+}
+
+func (c TermController) GetAll(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	terms, err := c.termUsecase.GetAll(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	active, err := c.termUsecase.Active(ctx.Request().Context(), token.NewToken(t))
+	if errors.Is(err, termUsecase.ActiveTermNotFound) {
+		return ctx.JSON(http.StatusOK, toTermsResponse(terms, nil))
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toTermsResponse(terms, &active))
+}
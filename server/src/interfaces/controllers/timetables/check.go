@@ -0,0 +1,168 @@
+package timetables
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	checkModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/check"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	loginController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/user/login"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+// WarningJSON is a warning of a check. Fields which do not apply to its kind are omitted.
+type WarningJSON struct {
+	Kind        string `json:"kind"`
+	Day         string `json:"day,omitempty"`
+	Period      int    `json:"period,omitempty"`
+	Date        string `json:"date,omitempty"`
+	Subject     string `json:"subject,omitempty"`
+	Count       int    `json:"count,omitempty"`
+	ExamID      string `json:"exam_id,omitempty"`
+	ExceptionID string `json:"exception_id,omitempty"`
+}
+
+type CheckResponse struct {
+	Warnings []WarningJSON `json:"warnings"`
+	Credits  int           `json:"credits"`
+	Limits   LimitsJSON    `json:"limits"`
+}
+
+type LimitsJSON struct {
+	Slots   int `json:"slots" validate:"min=1"`
+	Credits int `json:"credits" validate:"min=1"`
+}
+
+func (l LimitsJSON) Validate() error {
+	return errorResponse.Validate(l)
+}
+
+func toWarningJSON(w checkModel.Warning) WarningJSON {
+	res := WarningJSON{
+		Kind:    w.Kind().String(),
+		Period:  w.Period(),
+		Subject: w.Subject(),
+		Count:   w.Count(),
+	}
+	if w.Period() > 0 {
+		res.Day = dayName(w.Day())
+	}
+	if !w.Date().IsZero() {
+		res.Date = w.Date().Format(DateLayout)
+	}
+	if w.ExamID() > 0 {
+		res.ExamID = strconv.Itoa(w.ExamID())
+	}
+	if w.ExceptionID() > 0 {
+		res.ExceptionID = strconv.Itoa(w.ExceptionID())
+	}
+
+	return res
+}
+
+func toCheckResponse(r checkModel.Report) CheckResponse {
+	res := CheckResponse{
+		Warnings: []WarningJSON{},
+		Credits:  r.Credits(),
+		Limits:   LimitsJSON{r.Limits().Slots(), r.Limits().Credits()},
+	}
+	for _, w := range r.Warnings() {
+		res.Warnings = append(res.Warnings, toWarningJSON(w))
+	}
+
+	return res
+}
+
+// Check reviews the timetables of the body without registering them.
+// They are checked against the term of the "term" query parameter, or the active term.
+func (c TimetablesController) Check(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(TimetablesResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return loginController.InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	id, specified, err := TermID(ctx)
+	if err != nil {
+		return err
+	}
+
+	report, err := c.checkUsecase.Check(ctx.Request().Context(), token.NewToken(t), id, specified, res.ToTimetables())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toCheckResponse(report))
+}
+
+// GetCheck reviews the registered timetables of the term of the "term" query parameter, or the active term.
+func (c TimetablesController) GetCheck(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	id, specified, err := TermID(ctx)
+	if err != nil {
+		return err
+	}
+
+	report, err := c.checkUsecase.Current(ctx.Request().Context(), token.NewToken(t), id, specified)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, toCheckResponse(report))
+}
+
+func (c TimetablesController) SetLimits(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(LimitsJSON)
+	err := ctx.Bind(res)
+	if err != nil {
+		return loginController.InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	limits, err := checkModel.NewLimits(res.Slots, res.Credits)
+	if err != nil {
+		return err
+	}
+
+	err = c.checkUsecase.SetLimits(ctx.Request().Context(), token.NewToken(t), limits)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (c TimetablesController) GetLimits(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	limits, err := c.checkUsecase.Limits(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, LimitsJSON{limits.Slots(), limits.Credits()})
+}
@@ -0,0 +1,327 @@
+package timetables
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	bellModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	"github.com/team-gleam/kiwi-basket/server/src/interfaces/ics"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+const (
+	// MaxImportSize is the largest file accepted by Import in bytes.
+	MaxImportSize = 1 << 20
+)
+
+var (
+	InvalidImportFormat = errs.Invalid("invalid_import_format", "import format must be csv or ics")
+	InvalidImportFile   = errs.Invalid("invalid_import_file", "invalid import file")
+	ImportTooLarge      = errs.TooLarge("import_too_large", "import file is too large")
+)
+
+// Reasons why a row or an event of an imported file is skipped.
+const (
+	MissingColumns   = "day, period and subject are required"
+	InvalidDay       = "invalid day"
+	InvalidPeriodNum = "invalid period"
+	AllDayEvent      = "all-day event"
+	OutOfPeriods     = "not within any period of the bell schedule"
+)
+
+// Errors of the classes read from a row or an event, which are also reasons why it is skipped.
+var (
+	EmptySubject = errs.Invalid("empty_subject", "empty subject")
+	InvalidClass = errs.Invalid("invalid_class", "subject and room must be at most 85 characters, memo at most 170")
+)
+
+type ImportResponse struct {
+	Timetables TimetablesJSON `json:"timetable"`
+	Conflicts  []ConflictJSON `json:"conflicts"`
+	Skipped    []SkippedJSON  `json:"skipped"`
+	Committed  bool           `json:"committed"`
+}
+
+// ConflictJSON is a period to which different classes are imported.
+// The first class is the one in the timetable of the preview.
+type ConflictJSON struct {
+	Day     string      `json:"day"`
+	Period  int         `json:"period"`
+	Classes []ClassJSON `json:"classes"`
+	Sources []string    `json:"sources"`
+}
+
+type SkippedJSON struct {
+	Source string `json:"source"`
+	Reason string `json:"reason"`
+}
+
+func importFormat(ctx echo.Context) (string, error) {
+	if f := ctx.QueryParam("format"); f != "" {
+		if f != "csv" && f != "ics" {
+			return "", InvalidImportFormat
+		}
+		return f, nil
+	}
+
+	t, _, err := mime.ParseMediaType(ctx.Request().Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return "", InvalidImportFormat
+	}
+
+	switch t {
+	case "text/csv":
+		return "csv", nil
+	case "text/calendar":
+		return "ics", nil
+	default:
+		return "", InvalidImportFormat
+	}
+}
+
+var days = map[string]time.Weekday{
+	"mon": time.Monday, "monday": time.Monday, "月": time.Monday, "月曜": time.Monday, "月曜日": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday, "火": time.Tuesday, "火曜": time.Tuesday, "火曜日": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday, "水": time.Wednesday, "水曜": time.Wednesday, "水曜日": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday, "木": time.Thursday, "木曜": time.Thursday, "木曜日": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday, "金": time.Friday, "金曜": time.Friday, "金曜日": time.Friday,
+}
+
+func dayName(w time.Weekday) string {
+	return strings.ToLower(w.String()[:3])
+}
+
+// importedClass makes a class of the values of an imported file,
+// which must fit in the same limits as ClassJSON.
+func importedClass(subject, room, memo string) (ClassJSON, error) {
+	c := ClassJSON{Subject: subject}
+	if subject == "" {
+		return c, EmptySubject
+	}
+	if room != "" {
+		c.Room = &room
+	}
+	if memo != "" {
+		c.Memo = &memo
+	}
+
+	err := c.Validate()
+	if errors.Is(err, errorResponse.InvalidJSONFormat) {
+		return c, InvalidClass
+	}
+	if err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// readCSV reads rows of day, period, subject, room and memo.
+// The room and the memo may be omitted, and the first row may be a header.
+func readCSV(r io.Reader) ([]timetablesModel.Entry, []SkippedJSON, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, InvalidImportFile
+	}
+
+	entries := make([]timetablesModel.Entry, 0)
+	skipped := make([]SkippedJSON, 0)
+	for i, rec := range records {
+		source := fmt.Sprintf("row %d", i+1)
+		for j := range rec {
+			rec[j] = strings.TrimSpace(strings.TrimPrefix(rec[j], "\ufeff"))
+		}
+
+		if strings.Join(rec, "") == "" {
+			continue
+		}
+		if i == 0 && (strings.ToLower(rec[0]) == "day" || rec[0] == "曜日") {
+			continue
+		}
+		if len(rec) < 3 {
+			skipped = append(skipped, SkippedJSON{source, MissingColumns})
+			continue
+		}
+
+		w, ok := days[strings.ToLower(rec[0])]
+		if !ok {
+			skipped = append(skipped, SkippedJSON{source, InvalidDay})
+			continue
+		}
+		period, err := strconv.Atoi(rec[1])
+		if err != nil {
+			skipped = append(skipped, SkippedJSON{source, InvalidPeriodNum})
+			continue
+		}
+
+		room, memo := "", ""
+		if len(rec) > 3 {
+			room = rec[3]
+		}
+		if len(rec) > 4 {
+			memo = rec[4]
+		}
+		c, err := importedClass(rec[2], room, memo)
+		if err != nil {
+			skipped = append(skipped, SkippedJSON{source, err.Error()})
+			continue
+		}
+
+		e, err := timetablesModel.NewEntry(w, period, c.toClass(), source)
+		if err != nil {
+			skipped = append(skipped, SkippedJSON{source, err.Error()})
+			continue
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, skipped, nil
+}
+
+// readICS reads the events of an iCalendar file, which are mapped onto
+// periods of the bell schedule. Occurrences of the same class are merged later.
+func readICS(r io.Reader, schedule bellModel.Schedule) ([]timetablesModel.Entry, []SkippedJSON, error) {
+	events, err := ics.Decode(r, schedule.Location())
+	if err != nil {
+		return nil, nil, InvalidImportFile
+	}
+
+	entries := make([]timetablesModel.Entry, 0)
+	skipped := make([]SkippedJSON, 0)
+	for i, e := range events {
+		source := fmt.Sprintf("event %d (%s)", i+1, e.Summary)
+
+		if e.AllDay {
+			skipped = append(skipped, SkippedJSON{source, AllDayEvent})
+			continue
+		}
+		period, ok := schedule.PeriodOf(e.Start, e.End)
+		if !ok {
+			skipped = append(skipped, SkippedJSON{source, OutOfPeriods})
+			continue
+		}
+		c, err := importedClass(e.Summary, e.Location, e.Description)
+		if err != nil {
+			skipped = append(skipped, SkippedJSON{source, err.Error()})
+			continue
+		}
+
+		for _, w := range e.Weekdays {
+			entry, err := timetablesModel.NewEntry(w, period, c.toClass(), source)
+			if err != nil {
+				skipped = append(skipped, SkippedJSON{source, err.Error()})
+				continue
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, skipped, nil
+}
+
+func toImportResponse(
+	ts timetablesModel.Timetables,
+	conflicts []timetablesModel.Conflict,
+	skipped []SkippedJSON,
+) ImportResponse {
+	cs := make([]ConflictJSON, 0)
+	for _, c := range conflicts {
+		conflict := ConflictJSON{Day: dayName(c.Weekday()), Period: c.Period()}
+		for _, e := range c.Entries() {
+			conflict.Classes = append(conflict.Classes, *toClassJSON(e.Class()))
+			conflict.Sources = append(conflict.Sources, e.Source())
+		}
+		cs = append(cs, conflict)
+	}
+
+	return ImportResponse{
+		Timetables: ToTimetablesResponse(ts).Timetables,
+		Conflicts:  cs,
+		Skipped:    skipped,
+	}
+}
+
+// Import reads timetables from a CSV or an iCalendar file and returns a preview
+// of them with the conflicts and the skipped rows. The timetables are registered
+// only if the commit query parameter is true and there are no conflicts.
+func (c TimetablesController) Import(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	format, err := importFormat(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, specified, err := TermID(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(ctx.Request().Body, MaxImportSize+1))
+	if err != nil {
+		return InvalidImportFile
+	}
+	if len(body) > MaxImportSize {
+		return ImportTooLarge
+	}
+
+	var (
+		entries []timetablesModel.Entry
+		skipped []SkippedJSON
+	)
+	if format == "csv" {
+		entries, skipped, err = readCSV(bytes.NewReader(body))
+	} else {
+		var schedule bellModel.Schedule
+		schedule, err = c.bellUsecase.Get(ctx.Request().Context(), token.NewToken(t))
+		if err == nil {
+			entries, skipped, err = readICS(bytes.NewReader(body), schedule)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	timetables, conflicts := timetablesModel.Merge(entries)
+	res := toImportResponse(timetables, conflicts, skipped)
+
+	if ctx.QueryParam("commit") != "true" {
+		return ctx.JSON(http.StatusOK, res)
+	}
+	if len(conflicts) > 0 {
+		return ctx.JSON(http.StatusConflict, res)
+	}
+
+	if specified {
+		err = c.timetablesUsecase.AddToTerm(ctx.Request().Context(), token.NewToken(t), id, timetables)
+	} else {
+		err = c.timetablesUsecase.Add(ctx.Request().Context(), token.NewToken(t), timetables)
+	}
+	if err != nil {
+		return err
+	}
+
+	res.Committed = true
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,143 @@
+package timetables
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	bellModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+func TestReadCSV(t *testing.T) {
+	file := "\ufeffday,period,subject,room,memo\n" +
+		"mon,1,Algebra,A101,\n" +
+		"月曜日,2,Physics,,bring a calculator\n" +
+		"Sat,1,Club,,\n" +
+		"tue,6,Late,,\n" +
+		"wed,1\n" +
+		"thu,3," + strings.Repeat("a", 86) + ",,\n" +
+		"mon,1,Geometry,A102,\n"
+
+	entries, skipped, err := readCSV(strings.NewReader(file))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("expected: %v; got: %v\n", 3, len(entries))
+	}
+
+	expected := []SkippedJSON{
+		{"row 4", InvalidDay},
+		{"row 5", timetablesModel.InvalidPeriod.Error()},
+		{"row 6", MissingColumns},
+		{"row 7", InvalidClass.Error()},
+	}
+	if len(skipped) != len(expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, skipped)
+	}
+	for i := range expected {
+		if skipped[i] != expected[i] {
+			t.Fatalf("expected: %v; got: %v\n", expected[i], skipped[i])
+		}
+	}
+
+	ts, conflicts := timetablesModel.Merge(entries)
+	res := toImportResponse(ts, conflicts, skipped)
+
+	if res.Timetables.Mon.One == nil || res.Timetables.Mon.One.Subject != "Algebra" {
+		t.Fatalf("expected: %v; got: %v\n", "Algebra", res.Timetables.Mon.One)
+	}
+	if res.Timetables.Mon.Two == nil || res.Timetables.Mon.Two.Room != nil {
+		t.Fatalf("expected a class without room; got: %v\n", res.Timetables.Mon.Two)
+	}
+	if len(res.Conflicts) != 1 {
+		t.Fatalf("expected: %v; got: %v\n", 1, len(res.Conflicts))
+	}
+
+	c := res.Conflicts[0]
+	if c.Day != "mon" || c.Period != 1 || len(c.Classes) != 2 {
+		t.Fatalf("unexpected conflict: %v", c)
+	}
+	if c.Sources[0] != "row 2" || c.Sources[1] != "row 8" {
+		t.Fatalf("expected: %v; got: %v\n", []string{"row 2", "row 8"}, c.Sources)
+	}
+}
+
+func TestReadCSVWithInvalidFile(t *testing.T) {
+	_, _, err := readCSV(strings.NewReader("mon,1,\"Algebra\n"))
+	if !errors.Is(err, InvalidImportFile) {
+		t.Fatalf("expected: %v; got: %v\n", InvalidImportFile, err)
+	}
+}
+
+func TestReadICS(t *testing.T) {
+	file := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"SUMMARY:Algebra",
+		"LOCATION:A101",
+		"DTSTART;TZID=Asia/Tokyo:20200406T090000",
+		"DTEND;TZID=Asia/Tokyo:20200406T103000",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO,TH",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Physics",
+		"DTSTART:20200407T011000Z",
+		"DTEND:20200407T024000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Holiday",
+		"DTSTART;VALUE=DATE:20200429",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Party",
+		"DTSTART;TZID=Asia/Tokyo:20200408T190000",
+		"DTEND;TZID=Asia/Tokyo:20200408T210000",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Club",
+		"DTSTART;TZID=Asia/Tokyo:20200411T090000",
+		"DTEND;TZID=Asia/Tokyo:20200411T103000",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	entries, skipped, err := readICS(strings.NewReader(file), bellModel.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ts, conflicts := timetablesModel.Merge(entries)
+	if len(conflicts) != 0 {
+		t.Fatalf("expected no conflicts; got: %v\n", conflicts)
+	}
+
+	tests := []struct {
+		name     string
+		class    timetablesModel.Class
+		expected string
+	}{
+		{"monday", ts.Mon().First().Classes()[0], "Algebra"},
+		{"thursday", ts.Thu().First().Classes()[0], "Algebra"},
+		{"tuesday", ts.Tue().Second().Classes()[0], "Physics"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.class.Subject() != test.expected {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, test.class.Subject())
+			}
+		})
+	}
+
+	expected := []string{AllDayEvent, OutOfPeriods, timetablesModel.InvalidWeekday.Error()}
+	if len(skipped) != len(expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, skipped)
+	}
+	for i := range expected {
+		if skipped[i].Reason != expected[i] {
+			t.Fatalf("expected: %v; got: %v\n", expected[i], skipped[i].Reason)
+		}
+	}
+}
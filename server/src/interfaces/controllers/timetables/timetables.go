@@ -1,37 +1,79 @@
 package timetables
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 	"unicode/utf8"
 
 	"github.com/go-playground/validator"
 	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	checkRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/check"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
 	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
 	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
 	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
 	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
 	loginController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/user/login"
+	bellUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/bell"
+	checkUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/check"
 	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
 	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
 )
 
 type TimetablesController struct {
 	timetablesUsecase timetablesUsecase.TimetablesUsecase
+	bellUsecase       bellUsecase.BellUsecase
+	checkUsecase      checkUsecase.CheckUsecase
 }
 
 func NewTimetablesController(
 	c credentialRepository.ICredentialRepository,
 	l loginRepository.ILoginRepository,
 	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+	b bellRepository.IBellRepository,
+	ch checkRepository.ICheckRepository,
+	s subjectRepository.ISubjectRepository,
+	x examRepository.IExamRepository,
+	e exceptionRepository.IExceptionRepository,
 ) *TimetablesController {
 	return &TimetablesController{
-		timetablesUsecase.NewTimetablesUsecase(c, l, t),
+		timetablesUsecase.NewTimetablesUsecase(c, l, t, tm),
+		bellUsecase.NewBellUsecase(c, l, b),
+		checkUsecase.NewCheckUsecase(c, l, ch, t, tm, s, x, e, b),
 	}
 }
 
+var (
+	InvalidTermID = errs.Invalid("invalid_term_id", "invalid term ID")
+	InvalidDate   = errs.Invalid("invalid_date", "invalid date")
+)
+
+// TermID reads the optional "term" query parameter.
+// It returns false if the parameter is absent, which selects the active term.
+func TermID(ctx echo.Context) (int, bool, error) {
+	q := ctx.QueryParam("term")
+	if q == "" {
+		return 0, false, nil
+	}
+
+	id, err := strconv.Atoi(q)
+	if err != nil || id < 1 {
+		return 0, false, InvalidTermID
+	}
+
+	return id, true, nil
+}
+
 type TimetablesResponse struct {
 	Timetables TimetablesJSON `json:"timetable" validate:"required"`
 }
@@ -53,23 +95,51 @@ type TimetableJSON struct {
 }
 
 type ClassJSON struct {
-	Subject string  `json:"subject" validate:"max=85"`
-	Room    *string `json:"room" validate:"omitempty,max_85_ptr|isdefault"`
-	Memo    *string `json:"memo" validate:"omitempty,max_170|isdefault"`
+	SubjectID  *int        `json:"subject_id,omitempty" validate:"omitempty,min=1"`
+	Subject    string      `json:"subject" validate:"max=85"`
+	Room       *string     `json:"room" validate:"omitempty,max_85_ptr|isdefault"`
+	Memo       *string     `json:"memo" validate:"omitempty,max_170|isdefault"`
+	Weeks      *string     `json:"weeks,omitempty" validate:"omitempty,oneof=every odd even dates"`
+	Dates      []string    `json:"dates,omitempty"`
+	Alternates []ClassJSON `json:"alternates,omitempty" validate:"omitempty,dive"`
+}
+
+const (
+	DateLayout = "2006-01-02"
+)
+
+func (t TimetablesResponse) Validate() error {
+	v, err := newValidator()
+	if err != nil {
+		return err
+	}
+
+	return errorResponse.Fields(v.Struct(t))
 }
 
-func (t TimetablesResponse) Validates() (bool, error) {
-	v := validator.New()
+// Validate checks a single class with the same limits as TimetablesResponse.
+func (c ClassJSON) Validate() error {
+	v, err := newValidator()
+	if err != nil {
+		return err
+	}
+
+	return errorResponse.Fields(v.Struct(c))
+}
+
+func newValidator() (*validator.Validate, error) {
+	v := errorResponse.NewValidator()
 	err := v.RegisterValidation("max_85_ptr", Max85Ptr)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	err = v.RegisterValidation("max_170", Max170)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
+	v.RegisterStructValidation(ValidClass, ClassJSON{})
 
-	return v.Struct(t) == nil, nil
+	return v, nil
 }
 
 func Max85Ptr(validate validator.FieldLevel) bool {
@@ -80,7 +150,25 @@ func Max170(validate validator.FieldLevel) bool {
 	return utf8.RuneCountInString(validate.Field().String()) < 171
 }
 
-func (t TimetablesResponse) toTimetables() timetablesModel.Timetables {
+// ValidClass checks the rule of a class and that its alternates have no alternates,
+// which a slot cannot hold.
+func ValidClass(sl validator.StructLevel) {
+	c := sl.Current().Interface().(ClassJSON)
+	if _, err := c.toRule(); errors.Is(err, timetablesModel.TooManyDates) {
+		sl.ReportError(c.Dates, "dates", "Dates", "max", strconv.Itoa(timetablesModel.MaxDates))
+	} else if err != nil {
+		sl.ReportError(c.Weeks, "weeks", "Weeks", "rule", "")
+	}
+
+	for _, a := range c.Alternates {
+		if len(a.Alternates) > 0 {
+			sl.ReportError(c.Alternates, "alternates", "Alternates", "nested", "")
+			return
+		}
+	}
+}
+
+func (t TimetablesResponse) ToTimetables() timetablesModel.Timetables {
 	return timetablesModel.NewTimetables(
 		t.Timetables.Mon.toTimetable(),
 		t.Timetables.Tue.toTimetable(),
@@ -91,172 +179,239 @@ func (t TimetablesResponse) toTimetables() timetablesModel.Timetables {
 }
 
 func (t TimetableJSON) toTimetable() timetablesModel.Timetable {
-	return timetablesModel.NewTimetable(
-		t.One.toClass(),
-		t.Two.toClass(),
-		t.Three.toClass(),
-		t.Four.toClass(),
-		t.Five.toClass(),
+	return timetablesModel.NewTimetableOfSlots(
+		t.One.toSlot(),
+		t.Two.toSlot(),
+		t.Three.toSlot(),
+		t.Four.toSlot(),
+		t.Five.toSlot(),
 	)
 }
 
+// toSlot makes a slot of the class followed by its alternates.
+// The class must have been validated.
+func (t *ClassJSON) toSlot() timetablesModel.Slot {
+	if t == nil {
+		return timetablesModel.EmptySlot()
+	}
+
+	classes := []timetablesModel.Class{t.toClass()}
+	for _, a := range t.Alternates {
+		classes = append(classes, a.toClass())
+	}
+
+	return timetablesModel.NewSlot(classes...)
+}
+
 func (t *ClassJSON) toClass() timetablesModel.Class {
 	if t == nil {
 		return timetablesModel.NoClass()
 	}
 
+	rule, err := t.toRule()
+	if err != nil {
+		rule = timetablesModel.Every()
+	}
+
+	// A class refers to a subject of the catalog by its ID if given, or by its name otherwise.
+	var subject int
+	if t.SubjectID != nil {
+		subject = *t.SubjectID
+	}
+
+	memo := ""
+	if t.Memo != nil {
+		memo = *t.Memo
+	}
+
 	if t.Room == nil {
-		if t.Memo == nil {
-			return timetablesModel.NoRoom(t.Subject, "")
-		}
-		return timetablesModel.NoRoom(t.Subject, *t.Memo)
+		return timetablesModel.NoRoom(t.Subject, memo).WithRule(rule).WithSubject(subject)
 	}
+	return timetablesModel.NewClass(t.Subject, *t.Room, memo).WithRule(rule).WithSubject(subject)
+}
 
-	if t.Memo == nil {
-		return timetablesModel.NewClass(t.Subject, *t.Room, "")
+func (t ClassJSON) toRule() (timetablesModel.Rule, error) {
+	if t.Weeks == nil {
+		return timetablesModel.Every(), nil
+	}
+
+	w, err := timetablesModel.ParseWeeks(*t.Weeks)
+	if err != nil {
+		return timetablesModel.Rule{}, err
+	}
+
+	switch w {
+	case timetablesModel.OddWeeks:
+		return timetablesModel.Odd(), nil
+	case timetablesModel.EvenWeeks:
+		return timetablesModel.Even(), nil
+	case timetablesModel.OnDates:
+		ds := make([]time.Time, 0)
+		for _, d := range t.Dates {
+			date, err := time.Parse(DateLayout, d)
+			if err != nil {
+				return timetablesModel.Rule{}, err
+			}
+			ds = append(ds, date)
+		}
+		return timetablesModel.Only(ds)
+	default:
+		return timetablesModel.Every(), nil
 	}
-	return timetablesModel.NewClass(t.Subject, *t.Room, *t.Memo)
 }
 
-func toTimetablesResponse(t timetablesModel.Timetables) TimetablesResponse {
+func ToTimetablesResponse(t timetablesModel.Timetables) TimetablesResponse {
 	return TimetablesResponse{
 		Timetables: TimetablesJSON{
-			Mon: toTimetableJSON(t.Mon()),
-			Tue: toTimetableJSON(t.Tue()),
-			Wed: toTimetableJSON(t.Wed()),
-			Thu: toTimetableJSON(t.Thu()),
-			Fri: toTimetableJSON(t.Fri()),
+			Mon: ToTimetableJSON(t.Mon()),
+			Tue: ToTimetableJSON(t.Tue()),
+			Wed: ToTimetableJSON(t.Wed()),
+			Thu: ToTimetableJSON(t.Thu()),
+			Fri: ToTimetableJSON(t.Fri()),
 		},
 	}
 }
 
-func toTimetableJSON(t timetablesModel.Timetable) TimetableJSON {
+func ToTimetableJSON(t timetablesModel.Timetable) TimetableJSON {
 	return TimetableJSON{
-		One:   toClassJSON(t.First()),
-		Two:   toClassJSON(t.Second()),
-		Three: toClassJSON(t.Third()),
-		Four:  toClassJSON(t.Fourth()),
-		Five:  toClassJSON(t.Fifth()),
+		One:   toSlotJSON(t.First()),
+		Two:   toSlotJSON(t.Second()),
+		Three: toSlotJSON(t.Third()),
+		Four:  toSlotJSON(t.Fourth()),
+		Five:  toSlotJSON(t.Fifth()),
 	}
 }
 
+func toSlotJSON(s timetablesModel.Slot) *ClassJSON {
+	if s.IsNoClass() {
+		return nil
+	}
+
+	classes := s.Classes()
+	c := toClassJSON(classes[0])
+	for _, a := range classes[1:] {
+		c.Alternates = append(c.Alternates, *toClassJSON(a))
+	}
+
+	return c
+}
+
 func toClassJSON(c timetablesModel.Class) *ClassJSON {
 	if c.IsNoClass() {
 		return nil
 	}
 
-	memo := c.Memo()
-	if c.IsNoRoom() {
-		if c.Memo() == "" {
-			return &ClassJSON{
-				Subject: c.Subject(),
-				Room:    nil,
-				Memo:    nil,
-			}
-		}
-		return &ClassJSON{
-			Subject: c.Subject(),
-			Room:    nil,
-			Memo:    &memo,
-		}
+	res := &ClassJSON{
+		Subject: c.Subject(),
+		Room:    nil,
+		Memo:    nil,
 	}
 
-	room := c.Room()
-	if c.Memo() == "" {
-		return &ClassJSON{
-			Subject: c.Subject(),
-			Room:    &room,
-			Memo:    nil,
-		}
+	if c.SubjectID() > 0 {
+		id := c.SubjectID()
+		res.SubjectID = &id
 	}
 
-	return &ClassJSON{
-		Subject: c.Subject(),
-		Room:    &room,
-		Memo:    &memo,
+	if !c.IsNoRoom() {
+		room := c.Room()
+		res.Room = &room
+	}
+
+	if c.Memo() != "" {
+		memo := c.Memo()
+		res.Memo = &memo
 	}
+
+	rule := c.Rule()
+	if rule.Weeks() != timetablesModel.EveryWeek {
+		weeks := rule.Weeks().String()
+		res.Weeks = &weeks
+	}
+	for _, d := range rule.Dates() {
+		res.Dates = append(res.Dates, d.Format(DateLayout))
+	}
+
+	return res
 }
 
 func (c TimetablesController) Register(ctx echo.Context) error {
 	t := ctx.Request().Header.Get("Token")
 	if t == "" {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(fmt.Errorf(credentialUsecase.InvalidToken)),
-		)
+		return credentialUsecase.InvalidToken
 	}
 
 	res := new(TimetablesResponse)
 	err := ctx.Bind(res)
 	if err != nil || res.Timetables.Mon.One == new(ClassJSON) {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(loginController.InvalidJSONFormat)),
-		)
+		return loginController.InvalidJSONFormat
 	}
-	validates, err := res.Validates()
-	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+	if err = res.Validate(); err != nil {
+		return err
 	}
-	if !validates {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(loginController.InvalidJSONFormat)),
-		)
+
+	id, specified, err := TermID(ctx)
+	if err != nil {
+		return err
 	}
 
-	timetables := res.toTimetables()
+	timetables := res.ToTimetables()
 
-	err = c.timetablesUsecase.Add(token.NewToken(t), timetables)
-	if err != nil && err.Error() == credentialUsecase.InvalidToken {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(err),
-		)
+	// The timetables are checked before being registered, so that nothing is
+	// registered unless the warnings can be returned.
+	report, err := c.checkUsecase.Check(ctx.Request().Context(), token.NewToken(t), id, specified, timetables)
+	if err == nil && specified {
+		err = c.timetablesUsecase.AddToTerm(ctx.Request().Context(), token.NewToken(t), id, timetables)
+	} else if err == nil {
+		err = c.timetablesUsecase.Add(ctx.Request().Context(), token.NewToken(t), timetables)
 	}
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
-	return ctx.NoContent(http.StatusOK)
+	return ctx.JSON(http.StatusOK, toCheckResponse(report))
 }
 
 func (c TimetablesController) Get(ctx echo.Context) error {
 	t := ctx.Request().Header.Get("Token")
 	if t == "" {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(fmt.Errorf(credentialUsecase.InvalidToken)),
-		)
+		return credentialUsecase.InvalidToken
 	}
 
-	timetables, err := c.timetablesUsecase.Get(token.NewToken(t))
-	if err != nil && err.Error() == credentialUsecase.InvalidToken {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(err),
-		)
+	id, specified, err := TermID(ctx)
+	if err != nil {
+		return err
 	}
-	if err != nil && err.Error() == timetablesUsecase.TimetablesNotFound {
-		return ctx.JSON(
-			http.StatusNotFound,
-			errorResponse.NewError(err),
-		)
+
+	var timetables timetablesModel.Timetables
+	if specified {
+		timetables, err = c.timetablesUsecase.GetByTerm(ctx.Request().Context(), token.NewToken(t), id)
+	} else {
+		timetables, err = c.timetablesUsecase.Get(ctx.Request().Context(), token.NewToken(t))
 	}
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
-	res := toTimetablesResponse(timetables)
+	res := ToTimetablesResponse(timetables)
 
 	return ctx.JSON(http.StatusOK, res)
 }
+
+func (c TimetablesController) GetWeek(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	d, err := time.Parse(DateLayout, ctx.QueryParam("date"))
+	if err != nil {
+		return InvalidDate
+	}
+
+	timetables, err := c.timetablesUsecase.GetWeek(ctx.Request().Context(), token.NewToken(t), d)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, ToTimetablesResponse(timetables))
+}
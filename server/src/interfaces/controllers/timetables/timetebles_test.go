@@ -1,23 +1,27 @@
 package timetables
 
 import (
-	"fmt"
+	"errors"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
 )
 
 func newClassJSON(s, r, m string) *ClassJSON {
-	return &ClassJSON{s, &r, &m}
+	return &ClassJSON{Subject: s, Room: &r, Memo: &m}
 }
 
 func newNoRoomClassJSON(s, m string) *ClassJSON {
-	return &ClassJSON{s, nil, &m}
+	return &ClassJSON{Subject: s, Room: nil, Memo: &m}
 }
 
 func newNoMemoClassJSON(s, r string) *ClassJSON {
-	return &ClassJSON{s, &r, nil}
+	return &ClassJSON{Subject: s, Room: &r, Memo: nil}
 }
 
 var noNullTimetablesResponse = TimetablesResponse{
@@ -372,8 +376,8 @@ func TestTimetablesResponseToTimetables(t *testing.T) {
 
 	for _, tc := range tcs {
 		t.Run(tc.Name, func(t *testing.T) {
-			tt := tc.Input.toTimetables()
-			if tt != tc.Expected {
+			tt := tc.Input.ToTimetables()
+			if !reflect.DeepEqual(tt, tc.Expected) {
 				t.Fatalf("expected: %v; got: %v\n", tc.Expected, tt)
 			}
 		})
@@ -420,8 +424,8 @@ func TestTimetablesToTimetablesResponse(t *testing.T) {
 
 	for _, tc := range tcs {
 		t.Run(tc.Name, func(t *testing.T) {
-			tt := toTimetablesResponse(tc.Input)
-			if tt.toTimetables() != tc.Expected.toTimetables() {
+			tt := ToTimetablesResponse(tc.Input)
+			if !reflect.DeepEqual(tt.ToTimetables(), tc.Expected.ToTimetables()) {
 				t.Fatalf("expected: %v; got: %v\n", tc.Expected, tt)
 			}
 		})
@@ -501,12 +505,11 @@ func TestValidates(t *testing.T) {
 
 	for _, tc := range tcs {
 		t.Run(tc.Name, func(t *testing.T) {
-			b, err := tc.Input.Validates()
-			if err != nil {
-				fmt.Println(tc.Input.Timetables.Mon.One.Subject)
+			err := tc.Input.Validate()
+			if err != nil && !errors.Is(err, errorResponse.InvalidJSONFormat) {
 				t.Fatalf("unexpected error occured: %v", err)
 			}
-			if b != tc.Expected {
+			if b := err == nil; b != tc.Expected {
 				t.Fatalf("expected: %v; got: %v\n", tc.Expected, b)
 			}
 		})
@@ -552,14 +555,207 @@ func (t ClassJSON) copy() ClassJSON {
 		r = *t.Room
 		if t.Memo != nil {
 			m = *t.Memo
-			return ClassJSON{s, &r, &m}
+			return ClassJSON{Subject: s, Room: &r, Memo: &m}
 		}
-		return ClassJSON{s, &r, nil}
+		return ClassJSON{Subject: s, Room: &r, Memo: nil}
 	}
 
 	if t.Memo != nil {
 		m = *t.Memo
-		return ClassJSON{s, nil, &m}
+		return ClassJSON{Subject: s, Room: nil, Memo: &m}
+	}
+	return ClassJSON{Subject: s, Room: nil, Memo: nil}
+}
+
+func (t TimetablesResponse) withRule(weeks string, dates []string) TimetablesResponse {
+	tr := t.copy()
+	tr.Timetables.Mon.One.Weeks = &weeks
+	tr.Timetables.Mon.One.Dates = dates
+	return tr
+}
+
+func TestValidatesRule(t *testing.T) {
+	tcs := []TimetablesResponseValidation{
+		{
+			Name:     "valid odd weeks",
+			Input:    noNullTimetablesResponse.withRule("odd", nil),
+			Expected: true,
+		},
+		{
+			Name:     "valid dates",
+			Input:    noNullTimetablesResponse.withRule("dates", []string{"2020-04-08"}),
+			Expected: true,
+		},
+		{
+			Name:     "invalid weeks",
+			Input:    noNullTimetablesResponse.withRule("monthly", nil),
+			Expected: false,
+		},
+		{
+			Name:     "invalid dates without dates",
+			Input:    noNullTimetablesResponse.withRule("dates", nil),
+			Expected: false,
+		},
+		{
+			Name:     "invalid date format",
+			Input:    noNullTimetablesResponse.withRule("dates", []string{"2020/04/08"}),
+			Expected: false,
+		},
+		{
+			Name:     "valid most dates",
+			Input:    noNullTimetablesResponse.withRule("dates", dates(timetablesModel.MaxDates)),
+			Expected: true,
+		},
+		{
+			Name:     "invalid too many dates",
+			Input:    noNullTimetablesResponse.withRule("dates", dates(timetablesModel.MaxDates+1)),
+			Expected: false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Input.Validate()
+			if err != nil && !errors.Is(err, errorResponse.InvalidJSONFormat) {
+				t.Fatalf("unexpected error occured: %v", err)
+			}
+			if b := err == nil; b != tc.Expected {
+				t.Fatalf("expected: %v; got: %v\n", tc.Expected, b)
+			}
+		})
+	}
+}
+
+func dates(n int) []string {
+	ds := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		ds = append(ds, time.Date(2020, time.April, 1+i, 0, 0, 0, 0, time.UTC).Format(DateLayout))
+	}
+	return ds
+}
+
+func TestValidatesAlternates(t *testing.T) {
+	odd, even, on := "odd", "even", "dates"
+	lab := func(alternates ...ClassJSON) TimetablesResponse {
+		tr := noNullTimetablesResponse.copy()
+		tr.Timetables.Mon.One = &ClassJSON{Subject: "Lab", Weeks: &odd, Alternates: alternates}
+		return tr
+	}
+	tcs := []struct {
+		Name     string
+		Input    TimetablesResponse
+		Expected []errorResponse.FieldJSON
+	}{
+		{
+			Name:  "valid alternates",
+			Input: lab(ClassJSON{Subject: "Lecture", Weeks: &even}),
+		},
+		{
+			Name:  "invalid nested alternates",
+			Input: lab(ClassJSON{Subject: "Lecture", Weeks: &even, Alternates: []ClassJSON{{Subject: "Seminar"}}}),
+			Expected: []errorResponse.FieldJSON{
+				{Field: "timetable.mon.1.alternates", Code: "nested", Message: "timetable.mon.1.alternates must not have alternates"},
+			},
+		},
+		{
+			Name:  "invalid dates of alternates",
+			Input: lab(ClassJSON{Subject: "Lecture", Weeks: &on, Dates: dates(timetablesModel.MaxDates + 1)}),
+			Expected: []errorResponse.FieldJSON{
+				{Field: "timetable.mon.1.alternates[0].dates", Code: "max", Param: "46", Message: "timetable.mon.1.alternates[0].dates must be at most 46"},
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Input.Validate()
+			if tc.Expected == nil {
+				if err != nil {
+					t.Fatalf("unexpected error occured: %v", err)
+				}
+				return
+			}
+
+			var fs errorResponse.FieldsError
+			if !errors.As(err, &fs) {
+				t.Fatalf("expected: %v; got: %v\n", errorResponse.InvalidJSONFormat, err)
+			}
+			if got := fs.Fields(language.English); !reflect.DeepEqual(got, tc.Expected) {
+				t.Fatalf("expected: %v; got: %v\n", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestValidatesSubjectID(t *testing.T) {
+	math := func(id int) TimetablesResponse {
+		tr := noNullTimetablesResponse.copy()
+		tr.Timetables.Mon.One = &ClassJSON{SubjectID: &id, Subject: "Math"}
+		return tr
+	}
+	tcs := []struct {
+		Name     string
+		Input    TimetablesResponse
+		Expected []errorResponse.FieldJSON
+	}{
+		{
+			Name:  "valid subject",
+			Input: math(1),
+		},
+		{
+			Name:  "invalid subject",
+			Input: math(0),
+			Expected: []errorResponse.FieldJSON{
+				{Field: "timetable.mon.1.subject_id", Code: "min", Param: "1", Message: "timetable.mon.1.subject_id must be at least 1"},
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Input.Validate()
+			if tc.Expected == nil {
+				if err != nil {
+					t.Fatalf("unexpected error occured: %v", err)
+				}
+				return
+			}
+
+			var fs errorResponse.FieldsError
+			if !errors.As(err, &fs) {
+				t.Fatalf("expected: %v; got: %v\n", errorResponse.InvalidJSONFormat, err)
+			}
+			if got := fs.Fields(language.English); !reflect.DeepEqual(got, tc.Expected) {
+				t.Fatalf("expected: %v; got: %v\n", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestAlternatesRoundTrip(t *testing.T) {
+	odd, even := "odd", "even"
+	room1, room2 := "101", "202"
+	tr := noNullTimetablesResponse.copy()
+	tr.Timetables.Mon.One = &ClassJSON{
+		Subject: "Lab",
+		Room:    &room1,
+		Weeks:   &odd,
+		Alternates: []ClassJSON{
+			{Subject: "Lab", Room: &room2, Weeks: &even},
+		},
+	}
+
+	tt := tr.ToTimetables()
+	classes := tt.Mon().First().Classes()
+	if len(classes) != 2 {
+		t.Fatalf("expected: %v; got: %v\n", 2, len(classes))
+	}
+	if classes[1].Rule().Weeks() != timetablesModel.EvenWeeks {
+		t.Fatalf("expected: %v; got: %v\n", timetablesModel.EvenWeeks, classes[1].Rule().Weeks())
+	}
+
+	res := ToTimetablesResponse(tt)
+	if !reflect.DeepEqual(res.ToTimetables(), tt) {
+		t.Fatalf("expected: %v; got: %v\n", tt, res.ToTimetables())
 	}
-	return ClassJSON{s, nil, nil}
 }
@@ -0,0 +1,169 @@
+package timetables
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	loginController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/user/login"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+var InvalidVersion = errs.Invalid("invalid_version", "invalid version")
+
+type VersionJSON struct {
+	Version int    `json:"version"`
+	SavedAt string `json:"saved_at"`
+}
+
+type VersionsResponse struct {
+	Versions []VersionJSON `json:"versions"`
+}
+
+// ChangeJSON is a changed slot. Before and after are the slots as in
+// TimetablesResponse, and fields are given only to changed slots.
+type ChangeJSON struct {
+	Kind   string     `json:"kind"`
+	Day    string     `json:"day"`
+	Period int        `json:"period"`
+	Fields []string   `json:"fields,omitempty"`
+	Before *ClassJSON `json:"before"`
+	After  *ClassJSON `json:"after"`
+}
+
+type DiffResponse struct {
+	Changes []ChangeJSON `json:"changes"`
+}
+
+type RollbackResponse struct {
+	Version string `json:"version" validate:"required,numeric,min=1"`
+}
+
+func (r RollbackResponse) Validate() error {
+	return errorResponse.Validate(r)
+}
+
+// version reads a version number from the query parameter of the name,
+// which selects the latest version if it is absent and optional.
+func version(ctx echo.Context, name string, optional bool) (int, error) {
+	q := ctx.QueryParam(name)
+	if q == "" && optional {
+		return timetablesUsecase.Latest, nil
+	}
+
+	n, err := strconv.Atoi(q)
+	if err != nil || n < 1 {
+		return 0, InvalidVersion
+	}
+
+	return n, nil
+}
+
+func toChangeJSON(c timetablesModel.Change) ChangeJSON {
+	return ChangeJSON{
+		Kind:   c.Kind().String(),
+		Day:    dayName(c.Day()),
+		Period: c.Period(),
+		Fields: c.Fields(),
+		Before: toSlotJSON(c.Before()),
+		After:  toSlotJSON(c.After()),
+	}
+}
+
+// Versions lists the versions of the timetables of the term of the "term" query parameter, or the active term.
+func (c TimetablesController) Versions(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	id, specified, err := TermID(ctx)
+	if err != nil {
+		return err
+	}
+
+	versions, err := c.timetablesUsecase.Versions(ctx.Request().Context(), token.NewToken(t), id, specified)
+	if err != nil {
+		return err
+	}
+
+	res := VersionsResponse{[]VersionJSON{}}
+	for _, v := range versions {
+		res.Versions = append(res.Versions, VersionJSON{v.Number(), v.SavedAt().Format(time.RFC3339)})
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Diff lists the slots changed from the version of the "from" query parameter
+// to the version of the "to" query parameter, or to the latest version.
+func (c TimetablesController) Diff(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	id, specified, err := TermID(ctx)
+	if err != nil {
+		return err
+	}
+	from, err := version(ctx, "from", false)
+	if err != nil {
+		return err
+	}
+	to, err := version(ctx, "to", true)
+	if err != nil {
+		return err
+	}
+
+	changes, err := c.timetablesUsecase.Diff(ctx.Request().Context(), token.NewToken(t), id, specified, from, to)
+	if err != nil {
+		return err
+	}
+
+	res := DiffResponse{[]ChangeJSON{}}
+	for _, change := range changes {
+		res.Changes = append(res.Changes, toChangeJSON(change))
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Rollback registers a version of the timetables again as the latest version.
+func (c TimetablesController) Rollback(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(RollbackResponse)
+	err := ctx.Bind(res)
+	if err != nil {
+		return loginController.InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+	number, err := strconv.Atoi(res.Version)
+	if err != nil {
+		return loginController.InvalidJSONFormat
+	}
+
+	id, specified, err := TermID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = c.timetablesUsecase.Rollback(ctx.Request().Context(), token.NewToken(t), id, specified, number)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -1,14 +1,13 @@
 package credential
 
 import (
-	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
 	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
 	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
-	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
 	loginController "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/user/login"
 	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
 )
@@ -20,9 +19,10 @@ type CredentialController struct {
 func NewCredentialController(
 	c credentialRepository.ICredentialRepository,
 	l loginRepository.ILoginRepository,
+	ttl time.Duration,
 ) *CredentialController {
 	return &CredentialController{
-		credentialUsecase.NewCredentialUsecase(c, l),
+		credentialUsecase.NewCredentialUsecase(c, l).Expiring(ttl),
 	}
 }
 
@@ -38,45 +38,21 @@ func (c CredentialController) SignIn(ctx echo.Context) error {
 	login := new(loginController.LoginResponse)
 	err := ctx.Bind(login)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(loginController.InvalidJSONFormat)),
-		)
+		return loginController.InvalidJSONFormat
 	}
 
-	if !login.Validates() {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(fmt.Errorf(loginController.InvalidUsernameOrPassword)),
-		)
+	if login.Validate() != nil {
+		return loginController.InvalidUsernameOrPassword
 	}
 
 	l, err := login.ToLogin()
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
-	token, err := c.credentialUsecase.Generate(l)
-	if err != nil && err.Error() == credentialUsecase.UserNotFound {
-		return ctx.JSON(
-			http.StatusNotFound,
-			errorResponse.NewError(err),
-		)
-	}
-	if err != nil && err.Error() == credentialUsecase.InvalidUsernameOrPassword {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(err),
-		)
-	}
+	token, err := c.credentialUsecase.Generate(ctx.Request().Context(), l)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
 	return ctx.JSON(http.StatusOK, toTokenResponse(token))
@@ -0,0 +1,119 @@
+package language
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	languageModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	languageRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/language"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+	languageUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/language"
+)
+
+type LanguageController struct {
+	languageUsecase languageUsecase.LanguageUsecase
+}
+
+func NewLanguageController(
+	c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	lg languageRepository.ILanguageRepository,
+) *LanguageController {
+	return &LanguageController{
+		languageUsecase.NewLanguageUsecase(c, l, lg),
+	}
+}
+
+var InvalidJSONFormat = errs.Invalid("invalid_json_format", "invalid JSON format")
+
+type LanguageJSON struct {
+	Language *string `json:"language" validate:"required"`
+}
+
+func (l LanguageJSON) Validate() error {
+	return errorResponse.Validate(l)
+}
+
+func (c LanguageController) Set(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	res := new(LanguageJSON)
+	err := ctx.Bind(res)
+	if err != nil {
+		return InvalidJSONFormat
+	}
+	if err = res.Validate(); err != nil {
+		return err
+	}
+
+	l, err := languageModel.NewLanguage(*res.Language)
+	if err != nil {
+		return err
+	}
+
+	err = c.languageUsecase.Set(ctx.Request().Context(), token.NewToken(t), l)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Get responds with the language the user prefers, which is null if not registered.
+func (c LanguageController) Get(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	l, set, err := c.languageUsecase.Get(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	res := LanguageJSON{}
+	if set {
+		code := l.Code()
+		res.Language = &code
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func (c LanguageController) Reset(ctx echo.Context) error {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return credentialUsecase.InvalidToken
+	}
+
+	err := c.languageUsecase.Reset(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Preferred returns the language the user of the token of a request prefers,
+// and false if the request has no valid token or the user has not registered one.
+func (c LanguageController) Preferred(ctx echo.Context) (languageModel.Language, bool) {
+	t := ctx.Request().Header.Get("Token")
+	if t == "" {
+		return languageModel.Language{}, false
+	}
+
+	l, set, err := c.languageUsecase.Get(ctx.Request().Context(), token.NewToken(t))
+	if err != nil {
+		return languageModel.Language{}, false
+	}
+
+	return l, set
+}
@@ -3,29 +3,69 @@ package login
 import (
 	"crypto/sha256"
 	"encoding/hex"
-	"fmt"
+	"errors"
 	"net/http"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 	loginModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/login"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	attendanceRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/attendance"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	checkRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/check"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	feedRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/feed"
+	gradeRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/grade"
+	groupRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/group"
+	institutionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/institution"
+	shareRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/share"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
 	taskRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/task"
+	templateRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/template"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
 	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
 	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	languageRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/language"
 	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
 	errorResponse "github.com/team-gleam/kiwi-basket/server/src/interfaces/controllers/error"
+	attendanceUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/attendance"
+	bellUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/bell"
+	calendarUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/calendar"
+	checkUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/check"
+	examUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/exam"
+	exceptionUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/exception"
+	gradeUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/grade"
+	groupUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/group"
+	institutionUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/institution"
+	shareUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/share"
+	subjectUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/subject"
 	taskUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/task"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
 	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
 	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+	languageUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/language"
 	loginUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/login"
 )
 
 type LoginController struct {
-	loginUsecase      loginUsecase.LoginUsecase
-	credentialUsecase credentialUsecase.CredentialUsecase
-	taskUsecase       taskUsecase.TaskUsecase
-	timetablesUsecase timetablesUsecase.TimetablesUsecase
+	loginUsecase       loginUsecase.LoginUsecase
+	credentialUsecase  credentialUsecase.CredentialUsecase
+	taskUsecase        taskUsecase.TaskUsecase
+	timetablesUsecase  timetablesUsecase.TimetablesUsecase
+	termUsecase        termUsecase.TermUsecase
+	exceptionUsecase   exceptionUsecase.ExceptionUsecase
+	bellUsecase        bellUsecase.BellUsecase
+	calendarUsecase    calendarUsecase.CalendarUsecase
+	shareUsecase       shareUsecase.ShareUsecase
+	groupUsecase       groupUsecase.GroupUsecase
+	subjectUsecase     subjectUsecase.SubjectUsecase
+	attendanceUsecase  attendanceUsecase.AttendanceUsecase
+	gradeUsecase       gradeUsecase.GradeUsecase
+	examUsecase        examUsecase.ExamUsecase
+	checkUsecase       checkUsecase.CheckUsecase
+	institutionUsecase institutionUsecase.InstitutionUsecase
+	languageUsecase    languageUsecase.LanguageUsecase
 }
 
 func NewLoginController(
@@ -33,18 +73,45 @@ func NewLoginController(
 	c credentialRepository.ICredentialRepository,
 	t taskRepository.ITaskRepository,
 	tt timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+	e exceptionRepository.IExceptionRepository,
+	b bellRepository.IBellRepository,
+	f feedRepository.IFeedRepository,
+	s shareRepository.IShareRepository,
+	g groupRepository.IGroupRepository,
+	sb subjectRepository.ISubjectRepository,
+	a attendanceRepository.IAttendanceRepository,
+	gr gradeRepository.IGradeRepository,
+	x examRepository.IExamRepository,
+	ch checkRepository.ICheckRepository,
+	i institutionRepository.IInstitutionRepository,
+	tp templateRepository.ITemplateRepository,
+	lg languageRepository.ILanguageRepository,
 ) *LoginController {
 	return &LoginController{
 		loginUsecase.NewLoginUsecase(l),
 		credentialUsecase.NewCredentialUsecase(c, l),
 		taskUsecase.NewTaskUsecase(c, l, t),
-		timetablesUsecase.NewTimetablesUsecase(c, l, tt),
+		timetablesUsecase.NewTimetablesUsecase(c, l, tt, tm),
+		termUsecase.NewTermUsecase(c, l, tm, tt),
+		exceptionUsecase.NewExceptionUsecase(c, l, e, tt, tm),
+		bellUsecase.NewBellUsecase(c, l, b),
+		calendarUsecase.NewCalendarUsecase(c, l, f, tm, tt, e, t, b, x, sb),
+		shareUsecase.NewShareUsecase(c, l, s, tt, tm),
+		groupUsecase.NewGroupUsecase(c, l, g, tt, tm),
+		subjectUsecase.NewSubjectUsecase(c, l, sb),
+		attendanceUsecase.NewAttendanceUsecase(c, l, a, e, tt, tm),
+		gradeUsecase.NewGradeUsecase(c, l, gr, sb, tt, tm),
+		examUsecase.NewExamUsecase(c, l, x, sb, tm, b),
+		checkUsecase.NewCheckUsecase(c, l, ch, tt, tm, sb, x, e, b),
+		institutionUsecase.NewInstitutionUsecase(c, l, i, tp, tt, tm),
+		languageUsecase.NewLanguageUsecase(c, l, lg),
 	}
 }
 
-const (
-	InvalidUsernameOrPassword = "invalid username or password"
-	InvalidJSONFormat         = "invalid JSON format"
+var (
+	InvalidUsernameOrPassword = errs.Unauthorized("invalid_username_or_password", "invalid username or password")
+	InvalidJSONFormat         = errs.Invalid("invalid_json_format", "invalid JSON format")
 )
 
 type LoginResponse struct {
@@ -52,8 +119,8 @@ type LoginResponse struct {
 	Password string `json:"password" validate:"required,alphanum,min=8,max=72"`
 }
 
-func (l LoginResponse) Validates() bool {
-	return validator.New().Struct(l) == nil
+func (l LoginResponse) Validate() error {
+	return errorResponse.Validate(l)
 }
 
 func (l LoginResponse) ToLogin() (loginModel.Login, error) {
@@ -76,39 +143,21 @@ func (c LoginController) SignUp(ctx echo.Context) error {
 	login := new(LoginResponse)
 	err := ctx.Bind(login)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(InvalidJSONFormat)),
-		)
+		return InvalidJSONFormat
 	}
 
-	if !login.Validates() {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(InvalidUsernameOrPassword)),
-		)
+	if login.Validate() != nil {
+		return errs.Invalid(InvalidUsernameOrPassword.Code(), InvalidUsernameOrPassword.Error())
 	}
 
 	l, err := login.ToLogin()
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
-	err = c.loginUsecase.Add(l)
-	if err != nil && err.Error() == loginUsecase.UsernameAlreadyExists {
-		return ctx.JSON(
-			http.StatusConflict,
-			errorResponse.NewError(err),
-		)
-	}
+	err = c.loginUsecase.Add(ctx.Request().Context(), l)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
 	return ctx.NoContent(http.StatusOK)
@@ -118,84 +167,103 @@ func (c LoginController) DeleteAccound(ctx echo.Context) error {
 	login := new(LoginResponse)
 	err := ctx.Bind(login)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(InvalidJSONFormat)),
-		)
+		return InvalidJSONFormat
 	}
 
-	if !login.Validates() {
-		return ctx.JSON(
-			http.StatusBadRequest,
-			errorResponse.NewError(fmt.Errorf(InvalidJSONFormat)),
-		)
+	if err := login.Validate(); err != nil {
+		return err
 	}
 
 	l, err := login.ToLogin()
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
-	verified, err := c.loginUsecase.Verify(l)
+	verified, err := c.loginUsecase.Verify(ctx.Request().Context(), l)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 	if !verified {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(fmt.Errorf(InvalidUsernameOrPassword)),
-		)
+		return InvalidUsernameOrPassword
 	}
 
-	auth, err := c.credentialUsecase.Get(l)
+	auth, err := c.credentialUsecase.Get(ctx.Request().Context(), l)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
 	token := auth.Token()
 
-	if err = c.taskUsecase.DeleteAll(token); err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+	if err = c.taskUsecase.DeleteAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.timetablesUsecase.DeleteAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.checkUsecase.DeleteAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.examUsecase.DeleteAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.gradeUsecase.DeleteAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.attendanceUsecase.DeleteAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.subjectUsecase.DeleteAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.exceptionUsecase.DeleteAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.calendarUsecase.Unsubscribe(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.shareUsecase.RevokeAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.groupUsecase.LeaveAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.institutionUsecase.LeaveAll(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.languageUsecase.Reset(ctx.Request().Context(), token); err != nil {
+		return err
+	}
+
+	if err = c.bellUsecase.Reset(ctx.Request().Context(), token); err != nil {
+		return err
 	}
 
-	if err = c.timetablesUsecase.Delete(token); err != nil && err.Error() != timetablesUsecase.TimetablesNotFound {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+	if err = c.termUsecase.DeleteAll(ctx.Request().Context(), token); err != nil {
+		return err
 	}
 
-	if err = c.credentialUsecase.Delete(l); err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+	if err = c.credentialUsecase.Delete(ctx.Request().Context(), l); err != nil {
+		return err
 	}
 
-	err = c.loginUsecase.Delete(l)
-	if err != nil && err.Error() == loginUsecase.UsernameNotFound {
-		return ctx.JSON(
-			http.StatusUnauthorized,
-			errorResponse.NewError(fmt.Errorf(InvalidUsernameOrPassword)),
-		)
+	err = c.loginUsecase.Delete(ctx.Request().Context(), l)
+	if errors.Is(err, loginUsecase.UsernameNotFound) {
+		return InvalidUsernameOrPassword
 	}
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError,
-			errorResponse.NewError(fmt.Errorf(errorResponse.InternalServerError)),
-		)
+		return err
 	}
 
 	return ctx.NoContent(http.StatusOK)
@@ -0,0 +1,269 @@
+package ics
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+)
+
+var InvalidCalendar = errs.Invalid("invalid_calendar", "invalid iCalendar")
+
+// VEvent is an event read from an iCalendar object.
+type VEvent struct {
+	Summary     string
+	Location    string
+	Description string
+	Start       time.Time
+	End         time.Time
+	// AllDay is true if the event starts on a date rather than a date-time.
+	AllDay bool
+	// Weekdays are the days of the week the event repeats on,
+	// which defaults to the day of its start.
+	Weekdays []time.Weekday
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Decode reads the events of an iCalendar object. Floating date-times and
+// those of unknown time zones are read in loc.
+func Decode(r io.Reader, loc *time.Location) ([]VEvent, error) {
+	props, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(props) == 0 || props[0].name != "BEGIN" || props[0].value != "VCALENDAR" {
+		return nil, InvalidCalendar
+	}
+
+	events := make([]VEvent, 0)
+	var (
+		event *VEvent
+		depth int
+	)
+	for _, p := range props {
+		switch {
+		case p.name == "BEGIN" && p.value == "VEVENT" && event == nil:
+			event = &VEvent{}
+			depth = 0
+			continue
+		case event == nil:
+			continue
+		case p.name == "BEGIN":
+			depth++
+			continue
+		case p.name == "END" && depth > 0:
+			depth--
+			continue
+		case p.name == "END" && p.value == "VEVENT":
+			if event.End.IsZero() {
+				event.End = event.Start
+			}
+			if len(event.Weekdays) == 0 {
+				event.Weekdays = []time.Weekday{event.Start.In(loc).Weekday()}
+			}
+			events = append(events, *event)
+			event = nil
+			continue
+		case depth > 0:
+			// properties of alarms and other nested components
+			continue
+		}
+
+		switch p.name {
+		case "SUMMARY":
+			event.Summary = unescape(p.value)
+		case "LOCATION":
+			event.Location = unescape(p.value)
+		case "DESCRIPTION":
+			event.Description = unescape(p.value)
+		case "DTSTART":
+			event.Start, event.AllDay, err = parseTime(p, loc)
+		case "DTEND":
+			event.End, _, err = parseTime(p, loc)
+		case "RRULE":
+			event.Weekdays = byDay(p.value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// unfold reads the content lines, joining folded lines.
+func unfold(r io.Reader) ([]property, error) {
+	lines := make([]string, 0)
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	for s.Scan() {
+		l := strings.TrimRight(s.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t")) {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+		if l == "" {
+			continue
+		}
+		lines = append(lines, strings.TrimPrefix(l, "\ufeff"))
+	}
+	if err := s.Err(); err != nil {
+		return nil, InvalidCalendar
+	}
+
+	props := make([]property, 0, len(lines))
+	for _, l := range lines {
+		p, err := parseProperty(l)
+		if err != nil {
+			return nil, err
+		}
+		props = append(props, p)
+	}
+
+	return props, nil
+}
+
+// parseProperty splits a content line into its name, parameters and value.
+// Colons and semicolons in quoted parameter values are not separators.
+func parseProperty(l string) (property, error) {
+	quoted := false
+	colon := -1
+	for i, r := range l {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return property{}, InvalidCalendar
+	}
+
+	p := property{params: map[string]string{}, value: l[colon+1:]}
+	parts := splitUnquoted(l[:colon], ';')
+	p.name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return property{}, InvalidCalendar
+		}
+		p.params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+	}
+
+	return p, nil
+}
+
+func splitUnquoted(s string, sep rune) []string {
+	parts := make([]string, 0)
+	quoted := false
+	last := 0
+	for i, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == sep && !quoted:
+			parts = append(parts, s[last:i])
+			last = i + 1
+		}
+	}
+
+	return append(parts, s[last:])
+}
+
+func parseTime(p property, loc *time.Location) (time.Time, bool, error) {
+	if p.params["VALUE"] == "DATE" || len(p.value) == len(dateLayout) {
+		d, err := time.ParseInLocation(dateLayout, p.value, loc)
+		if err != nil {
+			return time.Time{}, false, InvalidCalendar
+		}
+		return d, true, nil
+	}
+
+	if strings.HasSuffix(p.value, "Z") {
+		t, err := time.Parse(dateTimeLayout, strings.TrimSuffix(p.value, "Z"))
+		if err != nil {
+			return time.Time{}, false, InvalidCalendar
+		}
+		return t, false, nil
+	}
+
+	in := loc
+	if tzid, ok := p.params["TZID"]; ok {
+		if l, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			in = l
+		}
+	}
+
+	t, err := time.ParseInLocation(dateTimeLayout, p.value, in)
+	if err != nil {
+		return time.Time{}, false, InvalidCalendar
+	}
+
+	return t, false, nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// byDay reads the BYDAY part of a weekly recurrence rule.
+func byDay(rule string) []time.Weekday {
+	days := make([]time.Weekday, 0)
+	weekly := false
+	for _, part := range strings.Split(rule, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.ToUpper(kv[0]) {
+		case "FREQ":
+			weekly = strings.ToUpper(kv[1]) == "WEEKLY"
+		case "BYDAY":
+			for _, d := range strings.Split(kv[1], ",") {
+				if w, ok := weekdays[strings.ToUpper(d)]; ok {
+					days = append(days, w)
+				}
+			}
+		}
+	}
+
+	if !weekly {
+		return nil
+	}
+	return days
+}
+
+// unescape reverses escape.
+func unescape(s string) string {
+	var b strings.Builder
+	escaped := false
+	for _, r := range s {
+		if !escaped && r == '\\' {
+			escaped = true
+			continue
+		}
+		if escaped && (r == 'n' || r == 'N') {
+			b.WriteRune('\n')
+		} else {
+			b.WriteRune(r)
+		}
+		escaped = false
+	}
+
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package ics
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/calendar"
+)
+
+func TestDecode(t *testing.T) {
+	f, err := os.Open("testdata/portal.ics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	events, err := Decode(f, jst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []VEvent{
+		{
+			Summary:     "線形代数, 演習",
+			Location:    "101",
+			Description: "教科書を持参すること\n第1回はオンライン",
+			Start:       time.Date(2020, 4, 6, 9, 0, 0, 0, jst),
+			End:         time.Date(2020, 4, 6, 10, 30, 0, 0, jst),
+			Weekdays:    []time.Weekday{time.Monday, time.Thursday},
+		},
+		{
+			Summary:  "英語",
+			Start:    time.Date(2020, 4, 7, 4, 0, 0, 0, time.UTC),
+			End:      time.Date(2020, 4, 7, 5, 30, 0, 0, time.UTC),
+			Weekdays: []time.Weekday{time.Tuesday},
+		},
+		{
+			Summary:  "休講日",
+			Start:    time.Date(2020, 4, 10, 0, 0, 0, 0, jst),
+			End:      time.Date(2020, 4, 10, 0, 0, 0, 0, jst),
+			AllDay:   true,
+			Weekdays: []time.Weekday{time.Friday},
+		},
+	}
+
+	if len(events) != len(expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, events)
+	}
+	for i := range expected {
+		e, g := expected[i], events[i]
+		if e.Summary != g.Summary || e.Location != g.Location || e.Description != g.Description ||
+			!e.Start.Equal(g.Start) || !e.End.Equal(g.End) || e.AllDay != g.AllDay ||
+			!reflect.DeepEqual(e.Weekdays, g.Weekdays) {
+			t.Fatalf("expected: %v; got: %v\n", e, g)
+		}
+	}
+}
+
+func TestDecodeEncoded(t *testing.T) {
+	c := calendar.NewCalendar("user", schedule("Asia/Tokyo"), []calendar.Span{spring()}, nil, nil, nil)
+
+	events, err := Decode(bytes.NewReader(Encode(c, now, TasksAsEvents)), time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expected := len(c.Events()); len(events) != expected {
+		t.Fatalf("expected: %v; got: %v\n", expected, len(events))
+	}
+	for i, e := range c.Events() {
+		if !events[i].Start.Equal(e.Start()) || events[i].Summary != e.Class().Subject() ||
+			events[i].Description != e.Class().Memo() {
+			t.Fatalf("expected: %v; got: %v\n", e, events[i])
+		}
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"not a calendar", "BEGIN:VCARD\r\nEND:VCARD\r\n"},
+		{"no colon", "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nSUMMARY\r\n"},
+		{"invalid date", "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART:2020-04-06\r\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Decode(strings.NewReader(test.input), time.UTC); err == nil {
+				t.Fatalf("expected error but got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+// Package ics encodes calendars into iCalendar (RFC 5545) objects.
+package ics
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/calendar"
+)
+
+const (
+	ContentType = "text/calendar; charset=utf-8"
+
+	ProductID = "-//team-gleam//kiwi-basket//JA"
+	Name      = "kiwi-basket"
+
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+
+	// maxLineOctets is the length a content line is folded at, excluding CRLF.
+	maxLineOctets = 75
+)
+
+// TaskStyle decides how tasks are exported.
+// Google Calendar ignores to-dos, so tasks are all-day events by default.
+type TaskStyle int
+
+const (
+	TasksAsEvents TaskStyle = iota
+	TasksAsTodos
+)
+
+// Encode renders the calendar as an iCalendar object.
+// now is written as the DTSTAMP of every component.
+func Encode(c calendar.Calendar, now time.Time, style TaskStyle) []byte {
+	w := new(writer)
+	loc := c.Schedule().Location()
+	stamp := now.UTC().Format(dateTimeLayout) + "Z"
+	events := c.Events()
+
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", ProductID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	w.line("X-WR-CALNAME", escape(Name))
+	w.line("X-WR-TIMEZONE", loc.String())
+
+	if loc != time.UTC && len(events) > 0 {
+		from, to := bounds(events)
+		writeTimeZone(w, loc, from, to)
+	}
+
+	for _, e := range events {
+		writeEvent(w, e, stamp)
+	}
+
+	for _, t := range c.Tasks() {
+		uid := c.TaskUID(t)
+		day := t.Date().Format(dateLayout)
+
+		if style == TasksAsTodos {
+			w.line("BEGIN", "VTODO")
+			w.line("UID", uid)
+			w.line("DTSTAMP", stamp)
+			w.line("DUE;VALUE=DATE", day)
+			w.line("SUMMARY", escape(t.Title()))
+			w.line("END", "VTODO")
+			continue
+		}
+
+		w.line("BEGIN", "VEVENT")
+		w.line("UID", uid)
+		w.line("DTSTAMP", stamp)
+		w.line("DTSTART;VALUE=DATE", day)
+		w.line("DTEND;VALUE=DATE", t.Date().AddDate(0, 0, 1).Format(dateLayout))
+		w.line("SUMMARY", escape(t.Title()))
+		w.line("TRANSP", "TRANSPARENT")
+		w.line("END", "VEVENT")
+	}
+
+	w.line("END", "VCALENDAR")
+
+	return w.Bytes()
+}
+
+func writeEvent(w *writer, e calendar.Event, stamp string) {
+	class := e.Class()
+
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", e.UID())
+	w.line("DTSTAMP", stamp)
+	if !e.RecurrenceID().IsZero() {
+		w.dateTime("RECURRENCE-ID", e.RecurrenceID())
+	}
+	w.dateTime("DTSTART", e.Start())
+	w.dateTime("DTEND", e.End())
+	if e.Interval() > 0 {
+		rule := "FREQ=WEEKLY"
+		if e.Interval() > 1 {
+			rule += fmt.Sprintf(";INTERVAL=%d", e.Interval())
+		}
+		rule += ";UNTIL=" + e.Until().UTC().Format(dateTimeLayout) + "Z"
+		w.line("RRULE", rule)
+	}
+	if len(e.Dates()) > 0 {
+		w.dateTime("RDATE", e.Dates()...)
+	}
+	if len(e.Excluded()) > 0 {
+		w.dateTime("EXDATE", e.Excluded()...)
+	}
+	w.line("SUMMARY", escape(class.Subject()))
+	if e.IsExam() {
+		w.line("CATEGORIES", "EXAM")
+	}
+	if !class.IsNoRoom() && class.Room() != "" {
+		w.line("LOCATION", escape(class.Room()))
+	}
+	if class.Memo() != "" {
+		w.line("DESCRIPTION", escape(class.Memo()))
+	}
+	w.line("END", "VEVENT")
+}
+
+// bounds returns the earliest and latest moments the events take place.
+func bounds(events []calendar.Event) (time.Time, time.Time) {
+	from, to := events[0].Start(), events[0].End()
+	for _, e := range events {
+		if e.Start().Before(from) {
+			from = e.Start()
+		}
+		last := e.End()
+		if e.Until().After(last) {
+			last = e.Until()
+		}
+		for _, d := range e.Dates() {
+			if d.After(last) {
+				last = d
+			}
+		}
+		if last.After(to) {
+			to = last
+		}
+	}
+
+	return from, to
+}
+
+// writeTimeZone writes the VTIMEZONE of loc. Go does not expose the rules of
+// time zones, so it lists the transitions from one day before from until one
+// day after to, following an observance of the offset in effect at from.
+func writeTimeZone(w *writer, loc *time.Location, from, to time.Time) {
+	start := from.AddDate(0, 0, -1).In(loc)
+	name, offset := start.Zone()
+
+	w.line("BEGIN", "VTIMEZONE")
+	w.line("TZID", loc.String())
+	writeObservance(w, start.IsDST(), "19700101T000000", offset, offset, name)
+
+	prev := start
+	for d := start.AddDate(0, 0, 1); !prev.After(to.AddDate(0, 0, 1)); d = d.AddDate(0, 0, 1) {
+		if _, o := d.Zone(); o != offset {
+			t := transition(prev, d)
+			name, o := t.Zone()
+			onset := t.In(time.FixedZone("", offset)).Format(dateTimeLayout)
+			writeObservance(w, t.IsDST(), onset, offset, o, name)
+			offset = o
+		}
+		prev = d
+	}
+
+	w.line("END", "VTIMEZONE")
+}
+
+// transition finds the first second after a at which the offset of b is in effect.
+func transition(a, b time.Time) time.Time {
+	_, offset := b.Zone()
+	for b.Sub(a) > time.Second {
+		mid := a.Add(b.Sub(a) / 2).Truncate(time.Second)
+		if _, o := mid.Zone(); o == offset {
+			b = mid
+		} else {
+			a = mid
+		}
+	}
+
+	return b
+}
+
+func writeObservance(w *writer, dst bool, onset string, from, to int, name string) {
+	kind := "STANDARD"
+	if dst {
+		kind = "DAYLIGHT"
+	}
+
+	w.line("BEGIN", kind)
+	w.line("DTSTART", onset)
+	w.line("TZOFFSETFROM", utcOffset(from))
+	w.line("TZOFFSETTO", utcOffset(to))
+	if name != "" {
+		w.line("TZNAME", escape(name))
+	}
+	w.line("END", kind)
+}
+
+func utcOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign = '-'
+		seconds = -seconds
+	}
+
+	return fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+// escape escapes a TEXT value.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`;`, `\;`,
+		`,`, `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+type writer struct {
+	bytes.Buffer
+}
+
+// dateTime writes a property of local date-times, which refer to the VTIMEZONE
+// of their location unless they are in UTC.
+func (w *writer) dateTime(name string, ts ...time.Time) {
+	values := make([]string, 0, len(ts))
+	for _, t := range ts {
+		if t.Location() == time.UTC {
+			values = append(values, t.Format(dateTimeLayout)+"Z")
+		} else {
+			values = append(values, t.Format(dateTimeLayout))
+		}
+	}
+
+	if ts[0].Location() != time.UTC {
+		name += ";TZID=" + ts[0].Location().String()
+	}
+	w.line(name, strings.Join(values, ","))
+}
+
+// line writes a content line, folding it so that no line exceeds 75 octets
+// without splitting a UTF-8 sequence.
+func (w *writer) line(name, value string) {
+	l := name + ":" + value
+	limit := maxLineOctets
+
+	for len(l) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(l[i]) {
+			i--
+		}
+		w.WriteString(l[:i])
+		w.WriteString("\r\n ")
+		l = l[i:]
+		limit = maxLineOctets - 1
+	}
+
+	w.WriteString(l)
+	w.WriteString("\r\n")
+}
@@ -0,0 +1,263 @@
+package ics
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/calendar"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+var now = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+
+func schedule(timeZone string) bell.Schedule {
+	ps := make([]bell.Period, 0)
+	for _, times := range [][2]string{
+		{"09:00", "10:30"},
+		{"10:40", "12:10"},
+		{"13:00", "14:30"},
+		{"14:40", "16:10"},
+		{"16:20", "17:50"},
+	} {
+		p, _ := bell.NewPeriod(times[0], times[1])
+		ps = append(ps, p)
+	}
+
+	s, _ := bell.NewSchedule(timeZone, ps)
+	return s
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func timetable(slots ...timetables.Slot) timetables.Timetable {
+	for len(slots) < timetables.Periods {
+		slots = append(slots, timetables.EmptySlot())
+	}
+
+	return timetables.NewTimetableOfSlots(slots[0], slots[1], slots[2], slots[3], slots[4])
+}
+
+func spring() calendar.Span {
+	only, _ := timetables.Only([]time.Time{date(2020, 4, 17), date(2020, 5, 15)})
+	empty := timetable()
+
+	return calendar.NewSpan("term1", date(2020, 4, 6), date(2020, 5, 31), timetables.NewTimetables(
+		timetable(
+			timetables.NewSlot(timetables.NewClass("線形代数", "101", "https://example.com/zoom?id=1,2;3")),
+			timetables.NewSlot(timetables.NoRoom("英語", "")),
+		),
+		empty,
+		timetable(
+			timetables.EmptySlot(),
+			timetables.EmptySlot(),
+			timetables.NewSlot(
+				timetables.NewClass("物理実験", "実験棟", "").WithRule(timetables.Odd()),
+				timetables.NewClass("物理学", "202", "").WithRule(timetables.Even()),
+			),
+		),
+		empty,
+		timetable(
+			timetables.NewSlot(timetables.NewClass(
+				"特別講義",
+				"大講義室",
+				"持ち物: 教科書、ノート、電卓\n事前課題を必ず提出すること。提出がない場合は出席を認めない。",
+			).WithRule(only)),
+		),
+	))
+}
+
+func TestEncode(t *testing.T) {
+	cancelled, _ := exception.NewCancellation(1, "2020-04-13", 1)
+	roomChanged, _ := exception.NewRoomChange(2, "2020-04-20", 1, "303")
+	madeUp, _ := exception.NewMakeUp(3, "2020-04-25", 2, timetables.NewClass("線形代数", "101", "補講"))
+	moved, _ := exception.NewMove(4, "2020-04-27", 2, "2020-04-28", 3)
+
+	report, _ := task.NewTask(1, "2020-04-10", "レポート, 第1回")
+	quiz, _ := task.NewTask(2, "2020-05-01", "小テスト")
+
+	// It takes the place of the first and the second periods of the day.
+	final, _ := exam.NewExam(
+		1, 1, 1, "期末試験",
+		time.Date(2020, 5, 18, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 5, 18, 3, 0, 0, 0, time.UTC),
+		"大講義室",
+	)
+	final = final.WithSubject("線形代数")
+
+	fall := timetables.NewTimetables(
+		timetable(timetables.NewSlot(timetables.NewClass("Calculus", "A1", ""))),
+		timetable(),
+		timetable(),
+		timetable(),
+		timetable(),
+	)
+
+	tests := []struct {
+		name     string
+		calendar calendar.Calendar
+		style    TaskStyle
+	}{
+		{
+			"timetable",
+			calendar.NewCalendar("user", schedule("Asia/Tokyo"), []calendar.Span{spring()}, nil, nil, nil),
+			TasksAsEvents,
+		},
+		{
+			"exceptions",
+			calendar.NewCalendar(
+				"user",
+				schedule("Asia/Tokyo"),
+				[]calendar.Span{spring()},
+				[]exception.Exception{cancelled, roomChanged, madeUp, moved},
+				nil,
+				nil,
+			),
+			TasksAsEvents,
+		},
+		{
+			"tasks",
+			calendar.NewCalendar("user", schedule("UTC"), nil, nil, []task.Task{report, quiz}, nil),
+			TasksAsEvents,
+		},
+		{
+			"todos",
+			calendar.NewCalendar("user", schedule("UTC"), nil, nil, []task.Task{report, quiz}, nil),
+			TasksAsTodos,
+		},
+		{
+			"daylight saving time",
+			calendar.NewCalendar(
+				"user",
+				schedule("America/New_York"),
+				[]calendar.Span{calendar.NewSpan("term2", date(2020, 9, 7), date(2020, 12, 18), fall)},
+				nil,
+				nil,
+				nil,
+			),
+			TasksAsEvents,
+		},
+		{
+			"exams",
+			calendar.NewCalendar(
+				"user",
+				schedule("Asia/Tokyo"),
+				[]calendar.Span{spring()},
+				nil,
+				nil,
+				[]exam.Exam{final},
+			),
+			TasksAsEvents,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Encode(test.calendar, now, test.style)
+			wellFormed(t, got)
+
+			golden := filepath.Join("testdata", strings.Replace(test.name, " ", "_", -1)+".ics")
+			if *update {
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(expected, got) {
+				t.Fatalf("expected: %s; got: %s\n", expected, got)
+			}
+		})
+	}
+}
+
+// wellFormed checks the rules of RFC 5545 which are easy to break:
+// CRLF line endings, lines folded at 75 octets and balanced components.
+func wellFormed(t *testing.T, b []byte) {
+	t.Helper()
+
+	if !bytes.HasSuffix(b, []byte("\r\n")) {
+		t.Fatalf("should end with CRLF")
+	}
+
+	components := make([]string, 0)
+	for _, l := range strings.Split(strings.TrimSuffix(string(b), "\r\n"), "\r\n") {
+		if strings.ContainsAny(l, "\r\n") {
+			t.Fatalf("bare line break in %q", l)
+		}
+		if len(l) > maxLineOctets {
+			t.Fatalf("line longer than 75 octets: %q", l)
+		}
+
+		switch {
+		case strings.HasPrefix(l, "BEGIN:"):
+			components = append(components, strings.TrimPrefix(l, "BEGIN:"))
+		case strings.HasPrefix(l, "END:"):
+			last := len(components) - 1
+			if last < 0 || components[last] != strings.TrimPrefix(l, "END:") {
+				t.Fatalf("unbalanced %q", l)
+			}
+			components = components[:last]
+		}
+	}
+
+	if len(components) != 0 {
+		t.Fatalf("unclosed components: %v", components)
+	}
+}
+
+func TestFolding(t *testing.T) {
+	w := new(writer)
+	value := strings.Repeat("あいうえお", 20)
+	w.line("DESCRIPTION", value)
+
+	lines := strings.Split(strings.TrimSuffix(w.String(), "\r\n"), "\r\n")
+	if len(lines) < 2 {
+		t.Fatalf("should be folded: %q", w.String())
+	}
+
+	unfolded := lines[0]
+	for _, l := range lines[1:] {
+		if !strings.HasPrefix(l, " ") {
+			t.Fatalf("continuation should start with a space: %q", l)
+		}
+		unfolded += l[1:]
+	}
+
+	if expected := "DESCRIPTION:" + value; unfolded != expected {
+		t.Fatalf("expected: %v; got: %v\n", expected, unfolded)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"plain", "plain"},
+		{`a\b`, `a\\b`},
+		{"a;b,c", `a\;b\,c`},
+		{"a\nb\r\nc", `a\nb\nc`},
+	}
+
+	for _, test := range tests {
+		if got := escape(test.input); got != test.expected {
+			t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+		}
+	}
+}
@@ -1,26 +1,48 @@
 package main
 
 import (
-	"io/ioutil"
+	"fmt"
 	"log"
+	"os"
 
-	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/config"
 	"github.com/team-gleam/kiwi-basket/server/src/infra/router"
-	"gopkg.in/yaml.v3"
 )
 
+const usage = "usage: kiwi-basket [flags] [migrate up | migrate down [steps] | migrate status | config print]"
+
 func main() {
-	b, err := ioutil.ReadFile("./config.yaml")
+	c, args, err := config.Load(os.Args[1:], os.Getenv)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	var c handler.Config
+	// The configuration is printed even if it is invalid, so that it can be checked
+	// together with what is wrong with it.
+	if len(args) == 2 && args[0] == "config" && args[1] == "print" {
+		s, err := c.Print()
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Print(s)
+		if err = c.Validate(); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
-	err = yaml.Unmarshal(b, &c)
-	if err != nil {
+	if err = c.Validate(); err != nil {
 		log.Fatal(err)
 	}
 
-	router.Run(c)
+	switch {
+	case len(args) == 0:
+		router.Run(c)
+	case args[0] == "migrate":
+		if err = migrate(c.DB, args[1:]); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatal(usage)
+	}
 }
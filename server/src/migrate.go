@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/handler"
+	"github.com/team-gleam/kiwi-basket/server/src/infra/db/migration"
+)
+
+// migrate runs the migrate command, which applies or reverts the migrations
+// of the schema, or shows the versions of the schema.
+func migrate(c handler.Config, args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return fmt.Errorf(usage)
+	}
+
+	h, err := handler.NewDbHandler(c)
+	if err != nil {
+		return err
+	}
+	defer h.Db.Close()
+
+	m := migration.NewMigrator(h, migration.Migrations)
+
+	switch {
+	case args[0] == "up" && len(args) == 1:
+		applied, err := m.Up()
+		for _, mg := range applied {
+			fmt.Printf("applied %d %s\n", mg.Version, mg.Name)
+		}
+		return err
+	case args[0] == "down":
+		steps := 1
+		if len(args) == 2 {
+			if steps, err = strconv.Atoi(args[1]); err != nil {
+				return fmt.Errorf(migration.InvalidSteps)
+			}
+		}
+
+		reverted, err := m.Down(steps)
+		for _, mg := range reverted {
+			fmt.Printf("reverted %d %s\n", mg.Version, mg.Name)
+		}
+		return err
+	case args[0] == "status" && len(args) == 1:
+		current, err := m.Current()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("database: %d\nserver: %d\n", current, m.Latest())
+		return nil
+	}
+
+	return fmt.Errorf(usage)
+}
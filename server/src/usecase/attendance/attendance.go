@@ -0,0 +1,214 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	attendanceModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/attendance"
+	termModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	attendanceRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/attendance"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	exceptionUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/exception"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type AttendanceUsecase struct {
+	credentialUsecase    credentialUsecase.CredentialUsecase
+	exceptionUsecase     exceptionUsecase.ExceptionUsecase
+	timetablesUsecase    timetablesUsecase.TimetablesUsecase
+	attendanceRepository attendanceRepository.IAttendanceRepository
+	termRepository       termRepository.ITermRepository
+	now                  func() time.Time
+}
+
+func NewAttendanceUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	a attendanceRepository.IAttendanceRepository,
+	e exceptionRepository.IExceptionRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) AttendanceUsecase {
+	return AttendanceUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		exceptionUsecase.NewExceptionUsecase(c, l, e, t, tm),
+		timetablesUsecase.NewTimetablesUsecase(c, l, t, tm),
+		a,
+		tm,
+		time.Now,
+	}
+}
+
+var (
+	ClassNotFound  = errs.NotFound("class_not_found", "class not found")
+	RecordNotFound = errs.NotFound("record_not_found", "attendance not found")
+)
+
+// Record records the attendance at the class taking place in the period on
+// the date, with exceptions applied. A record of the same period is replaced.
+func (u AttendanceUsecase) Record(ctx context.Context, t token.Token, r attendanceModel.Record) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	day, err := u.exceptionUsecase.EffectiveDay(ctx, t, r.Date())
+	if err != nil && (errors.Is(err, timetablesUsecase.TimetablesNotFound) ||
+		errors.Is(err, termUsecase.TermNotFound)) {
+		return ClassNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	s, ok := day.Period(r.Period())
+	if !ok || s.IsNoClass() {
+		return ClassNotFound
+	}
+
+	return u.attendanceRepository.Set(ctx, user, r.Of(s.Classes()[0]))
+}
+
+func (u AttendanceUsecase) GetAll(ctx context.Context, t token.Token) ([]attendanceModel.Record, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.attendanceRepository.GetAll(ctx, user)
+}
+
+func (u AttendanceUsecase) Delete(ctx context.Context, t token.Token, date time.Time, period int) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	exists, err := u.attendanceRepository.Exists(ctx, user, date, period)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return RecordNotFound
+	}
+
+	return u.attendanceRepository.Remove(ctx, user, date, period)
+}
+
+// DeleteAll removes the records and the policy of the user.
+func (u AttendanceUsecase) DeleteAll(ctx context.Context, t token.Token) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	if err = u.attendanceRepository.RemoveAll(ctx, user); err != nil {
+		return err
+	}
+
+	return u.attendanceRepository.RemovePolicy(ctx, user)
+}
+
+func (u AttendanceUsecase) SetPolicy(ctx context.Context, t token.Token, p attendanceModel.Policy) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.attendanceRepository.SetPolicy(ctx, user, p)
+}
+
+// Policy returns the policy of the user, or the default one if not set.
+func (u AttendanceUsecase) Policy(ctx context.Context, t token.Token) (attendanceModel.Policy, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return attendanceModel.Policy{}, err
+	}
+
+	return u.policyOf(ctx, user)
+}
+
+// Summary tallies the attendance of the active term by subject of its timetables.
+// The sessions of the subjects are counted from the start of the term, so a term is required.
+func (u AttendanceUsecase) Summary(ctx context.Context, t token.Token) ([]attendanceModel.Tally, attendanceModel.Policy, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return nil, attendanceModel.Policy{}, err
+	}
+
+	policy, err := u.policyOf(ctx, user)
+	if err != nil {
+		return nil, attendanceModel.Policy{}, err
+	}
+
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return nil, attendanceModel.Policy{}, err
+	}
+
+	active, found := termModel.Active(terms, u.now())
+	if !found {
+		return nil, attendanceModel.Policy{}, termUsecase.ActiveTermNotFound
+	}
+
+	ts, err := u.timetablesUsecase.GetByTerm(ctx, t, active.ID())
+	if errors.Is(err, timetablesUsecase.TimetablesNotFound) {
+		ts, err = timetablesModel.Timetables{}, nil
+	}
+	if err != nil {
+		return nil, attendanceModel.Policy{}, err
+	}
+
+	exceptions, err := u.exceptionUsecase.GetAll(ctx, t)
+	if err != nil {
+		return nil, attendanceModel.Policy{}, err
+	}
+
+	all, err := u.attendanceRepository.GetAll(ctx, user)
+	if err != nil {
+		return nil, attendanceModel.Policy{}, err
+	}
+
+	records := make([]attendanceModel.Record, 0, len(all))
+	for _, r := range all {
+		if active.Contains(r.Date()) {
+			records = append(records, r)
+		}
+	}
+
+	return attendanceModel.Summarize(ts, active.Start(), exceptions, records, policy), policy, nil
+}
+
+func (u AttendanceUsecase) policyOf(ctx context.Context, user username.Username) (attendanceModel.Policy, error) {
+	exists, err := u.attendanceRepository.PolicyExists(ctx, user)
+	if err != nil {
+		return attendanceModel.Policy{}, err
+	}
+	if !exists {
+		return attendanceModel.DefaultPolicy(), nil
+	}
+
+	return u.attendanceRepository.GetPolicy(ctx, user)
+}
+
+func (u AttendanceUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
@@ -0,0 +1,197 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	attendanceModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/attendance"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type repositories struct {
+	credential *mocks.MockICredentialRepository
+	login      *mocks.MockILoginRepository
+	attendance *mocks.MockIAttendanceRepository
+	exception  *mocks.MockIExceptionRepository
+	timetables *mocks.MockITimetablesRepository
+	term       *mocks.MockITermRepository
+}
+
+func newUsecase(ctrl *gomock.Controller) (AttendanceUsecase, repositories) {
+	r := repositories{
+		mocks.NewMockICredentialRepository(ctrl),
+		mocks.NewMockILoginRepository(ctrl),
+		mocks.NewMockIAttendanceRepository(ctrl),
+		mocks.NewMockIExceptionRepository(ctrl),
+		mocks.NewMockITimetablesRepository(ctrl),
+		mocks.NewMockITermRepository(ctrl),
+	}
+
+	return NewAttendanceUsecase(r.credential, r.login, r.attendance, r.exception, r.timetables, r.term), r
+}
+
+var (
+	user, _   = username.NewUsername("user")
+	userToken = token.NewToken("123")
+	auth      = credential.NewAuth(user, userToken)
+)
+
+func signedIn(r repositories) {
+	r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+	r.credential.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil).AnyTimes()
+}
+
+func weekly() timetables.Timetables {
+	day := timetables.NewTimetable(
+		timetables.NewClass("A", "101", "").WithSubject(1),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	return timetables.NewTimetables(day, day, day, day, day)
+}
+
+func TestRecord(t *testing.T) {
+	tests := []struct {
+		name     string
+		period   int
+		expected error
+	}{
+		{"class", 1, nil},
+		{"no class", 2, ClassNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase, r := newUsecase(ctrl)
+			signedIn(r)
+			r.exception.EXPECT().GetAll(gomock.Any(), user).Return([]exception.Exception{}, nil)
+			r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{}, nil)
+			r.timetables.EXPECT().Exists(gomock.Any(), user, timetablesUsecase.NoTerm).Return(true, nil)
+			r.timetables.EXPECT().Get(gomock.Any(), user, timetablesUsecase.NoTerm).Return(weekly(), nil)
+
+			record, _ := attendanceModel.NewRecord("2020-04-08", test.period, attendanceModel.Absent)
+			if test.expected == nil {
+				expected := record.WithSubject(1, "A")
+				r.attendance.EXPECT().Set(gomock.Any(), user, expected).Return(nil)
+			}
+
+			err := usecase.Record(context.Background(), userToken, record)
+			if test.expected == nil && err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if test.expected != nil && !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+
+	t.Run("has no credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		record, _ := attendanceModel.NewRecord("2020-04-08", 1, attendanceModel.Absent)
+		err := usecase.Record(context.Background(), token.NewToken(""), record)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+	usecase.now = func() time.Time { return time.Date(2020, time.April, 8, 12, 0, 0, 0, time.UTC) }
+	signedIn(r)
+
+	policy, _ := attendanceModel.NewPolicy(3, 1, 3, 0, 1)
+	spring, _ := term.NewTerm(1, "spring", "2020-04-06", "2020-09-30")
+	cancelled, _ := exception.NewCancellation(1, "2020-04-09", 1)
+	record := func(date string, s attendanceModel.Status) attendanceModel.Record {
+		a, _ := attendanceModel.NewRecord(date, 1, s)
+		return a.WithSubject(1, "A")
+	}
+
+	r.attendance.EXPECT().PolicyExists(gomock.Any(), user).Return(true, nil)
+	r.attendance.EXPECT().GetPolicy(gomock.Any(), user).Return(policy, nil)
+	r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{spring}, nil).Times(2)
+	r.timetables.EXPECT().Exists(gomock.Any(), user, spring.ID()).Return(true, nil)
+	r.timetables.EXPECT().Get(gomock.Any(), user, spring.ID()).Return(weekly(), nil)
+	r.exception.EXPECT().GetAll(gomock.Any(), user).Return([]exception.Exception{cancelled}, nil)
+	r.attendance.EXPECT().GetAll(gomock.Any(), user).Return([]attendanceModel.Record{
+		record("2020-04-06", attendanceModel.Absent),
+		record("2020-04-07", attendanceModel.Absent),
+		record("2020-04-08", attendanceModel.Present),
+		record("2020-04-10", attendanceModel.Cancelled),
+	}, nil)
+
+	tallies, got, err := usecase.Summary(context.Background(), userToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if got != policy {
+		t.Fatalf("expected: %v; got: %v\n", policy, got)
+	}
+	if len(tallies) != 1 {
+		t.Fatalf("expected: %v; got: %v\n", 1, len(tallies))
+	}
+
+	// 5 sessions a week for 3 weeks but a cancelled one and another recorded as cancelled,
+	// failed at 5 absences.
+	a := tallies[0]
+	if limit, _ := a.Limit(); a.Sessions() != 13 || limit != 5 || a.Absences() != 2 || a.Standing() != attendanceModel.Safe {
+		t.Fatalf("unexpected tally: %v %v %v %v\n", a.Sessions(), limit, a.Absences(), a.Standing())
+	}
+
+	t.Run("without terms", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		signedIn(r)
+		r.attendance.EXPECT().PolicyExists(gomock.Any(), user).Return(false, nil)
+		r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{}, nil)
+
+		_, _, err := usecase.Summary(context.Background(), userToken)
+		if expected := termUsecase.ActiveTermNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+	signedIn(r)
+
+	record, _ := attendanceModel.NewRecord("2020-04-08", 1, attendanceModel.Absent)
+	r.attendance.EXPECT().Exists(gomock.Any(), user, record.Date(), 1).Return(false, nil)
+
+	err := usecase.Delete(context.Background(), userToken, record.Date(), 1)
+	if expected := RecordNotFound; !errors.Is(err, expected) {
+		t.Fatalf("expected: %v; got: %v\n", expected, err)
+	}
+}
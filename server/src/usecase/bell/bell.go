@@ -0,0 +1,95 @@
+package bell
+
+import (
+	"context"
+
+	bellModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type BellUsecase struct {
+	credentialUsecase credentialUsecase.CredentialUsecase
+	bellRepository    bellRepository.IBellRepository
+}
+
+func NewBellUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	b bellRepository.IBellRepository,
+) BellUsecase {
+	return BellUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		b,
+	}
+}
+
+// Set registers the bell schedule of the user, replacing the one registered before.
+func (u BellUsecase) Set(ctx context.Context, token token.Token, schedule bellModel.Schedule) error {
+	user, err := u.whose(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	if err = u.Reset(ctx, token); err != nil {
+		return err
+	}
+
+	return u.bellRepository.Create(ctx, user, schedule)
+}
+
+// Get returns the bell schedule of the user, or the default one if not registered.
+func (u BellUsecase) Get(ctx context.Context, token token.Token) (bellModel.Schedule, error) {
+	user, err := u.whose(ctx, token)
+	if err != nil {
+		return bellModel.Schedule{}, err
+	}
+
+	return u.Of(ctx, user)
+}
+
+// Of returns the bell schedule of a user who has already been authorized.
+func (u BellUsecase) Of(ctx context.Context, user username.Username) (bellModel.Schedule, error) {
+	exist, err := u.bellRepository.Exists(ctx, user)
+	if err != nil {
+		return bellModel.Schedule{}, err
+	}
+	if !exist {
+		return bellModel.Default(), nil
+	}
+
+	return u.bellRepository.Get(ctx, user)
+}
+
+// Reset removes the bell schedule of the user so that the default one is used.
+func (u BellUsecase) Reset(ctx context.Context, token token.Token) error {
+	user, err := u.whose(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	exist, err := u.bellRepository.Exists(ctx, user)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return nil
+	}
+
+	return u.bellRepository.Delete(ctx, user)
+}
+
+func (u BellUsecase) whose(ctx context.Context, token token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, token)
+}
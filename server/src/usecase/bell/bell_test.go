@@ -0,0 +1,113 @@
+package bell
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/bell"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+func schedule() bell.Schedule {
+	ps := make([]bell.Period, 0)
+	for _, times := range [][2]string{
+		{"08:50", "10:20"},
+		{"10:30", "12:00"},
+		{"13:00", "14:30"},
+		{"14:40", "16:10"},
+		{"16:20", "17:50"},
+	} {
+		p, _ := bell.NewPeriod(times[0], times[1])
+		ps = append(ps, p)
+	}
+
+	s, _ := bell.NewSchedule("UTC", ps)
+	return s
+}
+
+func TestSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	bellRepository := mocks.NewMockIBellRepository(ctrl)
+
+	usecase := NewBellUsecase(credentialRepository, loginRepository, bellRepository)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+
+	t.Run("replaces the registered schedule", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil).Times(2)
+		bellRepository.EXPECT().Exists(gomock.Any(), username).Return(true, nil)
+		bellRepository.EXPECT().Delete(gomock.Any(), username).Return(nil)
+		bellRepository.EXPECT().Create(gomock.Any(), username, gomock.Any()).Return(nil)
+
+		err := usecase.Set(context.Background(), userToken, schedule())
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("has no credential", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		err := usecase.Set(context.Background(), token.NewToken(""), schedule())
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	bellRepository := mocks.NewMockIBellRepository(ctrl)
+
+	usecase := NewBellUsecase(credentialRepository, loginRepository, bellRepository)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+
+	t.Run("registered", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		bellRepository.EXPECT().Exists(gomock.Any(), username).Return(true, nil)
+		bellRepository.EXPECT().Get(gomock.Any(), username).Return(schedule(), nil)
+
+		s, err := usecase.Get(context.Background(), userToken)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if s.TimeZone() != "UTC" {
+			t.Fatalf("expected: %v; got: %v\n", "UTC", s.TimeZone())
+		}
+	})
+
+	t.Run("falls back to the default", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		bellRepository.EXPECT().Exists(gomock.Any(), username).Return(false, nil)
+
+		s, err := usecase.Get(context.Background(), userToken)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if s.TimeZone() != bell.DefaultTimeZone {
+			t.Fatalf("expected: %v; got: %v\n", bell.DefaultTimeZone, s.TimeZone())
+		}
+	})
+}
@@ -0,0 +1,235 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	calendarModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/calendar"
+	feedModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/feed"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	feedRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/feed"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	taskRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/task"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	bellUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/bell"
+	examUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/exam"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type CalendarUsecase struct {
+	credentialUsecase    credentialUsecase.CredentialUsecase
+	feedRepository       feedRepository.IFeedRepository
+	termRepository       termRepository.ITermRepository
+	timetablesRepository timetablesRepository.ITimetablesRepository
+	exceptionRepository  exceptionRepository.IExceptionRepository
+	taskRepository       taskRepository.ITaskRepository
+	bellUsecase          bellUsecase.BellUsecase
+	examUsecase          examUsecase.ExamUsecase
+	now                  func() time.Time
+}
+
+func NewCalendarUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	f feedRepository.IFeedRepository,
+	tm termRepository.ITermRepository,
+	tt timetablesRepository.ITimetablesRepository,
+	e exceptionRepository.IExceptionRepository,
+	t taskRepository.ITaskRepository,
+	b bellRepository.IBellRepository,
+	x examRepository.IExamRepository,
+	s subjectRepository.ISubjectRepository,
+) CalendarUsecase {
+	return CalendarUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		f,
+		tm,
+		tt,
+		e,
+		t,
+		bellUsecase.NewBellUsecase(c, l, b),
+		examUsecase.NewExamUsecase(c, l, x, s, tm, b),
+		time.Now,
+	}
+}
+
+var FeedNotFound = errs.NotFound("feed_not_found", "feed not found")
+
+// Subscribe issues a new secret token of the calendar feed of the user.
+// The token issued before, if any, stops working.
+func (u CalendarUsecase) Subscribe(ctx context.Context, t token.Token) (feedModel.Feed, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return feedModel.Feed{}, err
+	}
+
+	secret, err := token.GenToken()
+	if err != nil {
+		return feedModel.Feed{}, err
+	}
+
+	err = u.feedRepository.Remove(ctx, user)
+	if err != nil {
+		return feedModel.Feed{}, err
+	}
+
+	f := feedModel.NewFeed(user, secret)
+	return f, u.feedRepository.Append(ctx, f)
+}
+
+// Subscription returns the calendar feed of the user.
+func (u CalendarUsecase) Subscription(ctx context.Context, t token.Token) (feedModel.Feed, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return feedModel.Feed{}, err
+	}
+
+	f, err := u.feedRepository.GetByUsername(ctx, user)
+	if err != nil {
+		return feedModel.Feed{}, FeedNotFound
+	}
+
+	return f, nil
+}
+
+// Unsubscribe revokes the calendar feed of the user.
+func (u CalendarUsecase) Unsubscribe(ctx context.Context, t token.Token) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.feedRepository.Remove(ctx, user)
+}
+
+// Export returns the calendar of the user signed in with the token.
+func (u CalendarUsecase) Export(ctx context.Context, t token.Token) (calendarModel.Calendar, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return calendarModel.Calendar{}, err
+	}
+
+	return u.calendar(ctx, user)
+}
+
+// ExportFeed returns the calendar of the feed whose secret token is t.
+func (u CalendarUsecase) ExportFeed(ctx context.Context, t token.Token) (calendarModel.Calendar, error) {
+	exist, err := u.feedRepository.Exists(ctx, t)
+	if err != nil {
+		return calendarModel.Calendar{}, err
+	}
+	if !exist {
+		return calendarModel.Calendar{}, FeedNotFound
+	}
+
+	f, err := u.feedRepository.GetByToken(ctx, t)
+	if err != nil {
+		return calendarModel.Calendar{}, err
+	}
+
+	return u.calendar(ctx, f.Username())
+}
+
+func (u CalendarUsecase) calendar(ctx context.Context, user username.Username) (calendarModel.Calendar, error) {
+	spans, err := u.spans(ctx, user)
+	if err != nil {
+		return calendarModel.Calendar{}, err
+	}
+
+	exceptions, err := u.exceptionRepository.GetAll(ctx, user)
+	if err != nil {
+		return calendarModel.Calendar{}, err
+	}
+
+	tasks, err := u.taskRepository.GetAll(ctx, user)
+	if err != nil {
+		return calendarModel.Calendar{}, err
+	}
+
+	schedule, err := u.bellUsecase.Of(ctx, user)
+	if err != nil {
+		return calendarModel.Calendar{}, err
+	}
+
+	exams, err := u.examUsecase.Of(ctx, user)
+	if err != nil {
+		return calendarModel.Calendar{}, err
+	}
+
+	return calendarModel.NewCalendar(user.Name(), schedule, spans, exceptions, tasks, exams), nil
+}
+
+// spans returns the terms of the user which have timetables. Timetables of
+// a user without terms are held throughout the ISO week-numbering year of today.
+func (u CalendarUsecase) spans(ctx context.Context, user username.Username) ([]calendarModel.Span, error) {
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	spans := make([]calendarModel.Span, 0)
+
+	if len(terms) == 0 {
+		ts, found, err := u.timetables(ctx, user, timetablesUsecase.NoTerm)
+		if err != nil || !found {
+			return spans, err
+		}
+
+		year, _ := u.now().ISOWeek()
+		start := timetablesModel.Monday(time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC))
+		end := timetablesModel.Monday(time.Date(year+1, time.January, 4, 0, 0, 0, 0, time.UTC)).AddDate(0, 0, -1)
+
+		return append(spans, calendarModel.NewSpan(fmt.Sprintf("year%d", year), start, end, ts)), nil
+	}
+
+	for _, t := range terms {
+		ts, found, err := u.timetables(ctx, user, t.ID())
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		spans = append(spans, calendarModel.NewSpan(fmt.Sprintf("term%d", t.ID()), t.Start(), t.End(), ts))
+	}
+
+	return spans, nil
+}
+
+func (u CalendarUsecase) timetables(ctx context.Context, user username.Username, term int) (timetablesModel.Timetables, bool, error) {
+	exist, err := u.timetablesRepository.Exists(ctx, user, term)
+	if err != nil || !exist {
+		return timetablesModel.Timetables{}, false, err
+	}
+
+	ts, err := u.timetablesRepository.Get(ctx, user, term)
+	return ts, err == nil, err
+}
+
+func (u CalendarUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
+
+// Now returns the current time, which is the time calendars are exported at.
+func (u CalendarUsecase) Now() time.Time {
+	return u.now()
+}
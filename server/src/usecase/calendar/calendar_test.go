@@ -0,0 +1,217 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/feed"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type repositories struct {
+	credential *mocks.MockICredentialRepository
+	login      *mocks.MockILoginRepository
+	feed       *mocks.MockIFeedRepository
+	term       *mocks.MockITermRepository
+	timetables *mocks.MockITimetablesRepository
+	exception  *mocks.MockIExceptionRepository
+	task       *mocks.MockITaskRepository
+	bell       *mocks.MockIBellRepository
+	exam       *mocks.MockIExamRepository
+	subject    *mocks.MockISubjectRepository
+}
+
+func newUsecase(ctrl *gomock.Controller) (CalendarUsecase, repositories) {
+	r := repositories{
+		mocks.NewMockICredentialRepository(ctrl),
+		mocks.NewMockILoginRepository(ctrl),
+		mocks.NewMockIFeedRepository(ctrl),
+		mocks.NewMockITermRepository(ctrl),
+		mocks.NewMockITimetablesRepository(ctrl),
+		mocks.NewMockIExceptionRepository(ctrl),
+		mocks.NewMockITaskRepository(ctrl),
+		mocks.NewMockIBellRepository(ctrl),
+		mocks.NewMockIExamRepository(ctrl),
+		mocks.NewMockISubjectRepository(ctrl),
+	}
+
+	return NewCalendarUsecase(
+		r.credential,
+		r.login,
+		r.feed,
+		r.term,
+		r.timetables,
+		r.exception,
+		r.task,
+		r.bell,
+		r.exam,
+		r.subject,
+	), r
+}
+
+var (
+	user, _   = username.NewUsername("user")
+	userToken = token.NewToken("123")
+	auth      = credential.NewAuth(user, userToken)
+)
+
+func signedIn(r repositories) {
+	r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+	r.credential.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+}
+
+func TestSubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+
+	t.Run("rotates the token", func(t *testing.T) {
+		signedIn(r)
+		gomock.InOrder(
+			r.feed.EXPECT().Remove(gomock.Any(), user).Return(nil),
+			r.feed.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		f, err := usecase.Subscribe(context.Background(), userToken)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if len(f.Token().Token()) != token.Length || f.Token() == userToken {
+			t.Fatalf("unexpected token: %v\n", f.Token())
+		}
+	})
+
+	t.Run("has no credential", func(t *testing.T) {
+		r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		_, err := usecase.Subscribe(context.Background(), token.NewToken(""))
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+
+	t.Run("not subscribed", func(t *testing.T) {
+		signedIn(r)
+		r.feed.EXPECT().GetByUsername(gomock.Any(), user).Return(feed.Feed{}, fmt.Errorf("record not found"))
+
+		_, err := usecase.Subscription(context.Background(), userToken)
+		if expected := FeedNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func timetablesOf(subject string) timetables.Timetables {
+	day := timetables.NewTimetable(
+		timetables.NewClass(subject, "101", ""),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+
+	return timetables.NewTimetables(day, day, day, day, day)
+}
+
+func TestExportFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+	secret := token.NewToken("secret")
+
+	spring, _ := term.NewTerm(1, "spring", "2020-04-01", "2020-09-30")
+	fall, _ := term.NewTerm(2, "fall", "2020-10-01", "2021-03-31")
+	cancelled, _ := exception.NewCancellation(1, "2020-04-08", 1)
+	report, _ := task.NewTask(1, "2020-04-10", "report")
+	final, _ := exam.NewExam(
+		1, 1, 1, "final",
+		time.Date(2020, 7, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 7, 20, 1, 0, 0, 0, time.UTC),
+		"101",
+	)
+
+	t.Run("terms with timetables", func(t *testing.T) {
+		r.feed.EXPECT().Exists(gomock.Any(), secret).Return(true, nil)
+		r.feed.EXPECT().GetByToken(gomock.Any(), secret).Return(feed.NewFeed(user, secret), nil)
+		r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{spring, fall}, nil)
+		r.timetables.EXPECT().Exists(gomock.Any(), user, 1).Return(true, nil)
+		r.timetables.EXPECT().Get(gomock.Any(), user, 1).Return(timetablesOf("A"), nil)
+		r.timetables.EXPECT().Exists(gomock.Any(), user, 2).Return(false, nil)
+		r.exception.EXPECT().GetAll(gomock.Any(), user).Return([]exception.Exception{cancelled}, nil)
+		r.task.EXPECT().GetAll(gomock.Any(), user).Return([]task.Task{report}, nil)
+		r.bell.EXPECT().Exists(gomock.Any(), user).Return(false, nil).Times(2)
+		r.exam.EXPECT().GetAll(gomock.Any(), user).Return([]exam.Exam{final}, nil)
+
+		c, err := usecase.ExportFeed(context.Background(), secret)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+
+		if len(c.Spans()) != 1 || c.Spans()[0].Key() != "term1" {
+			t.Fatalf("expected: %v; got: %v\n", "[term1]", c.Spans())
+		}
+		if c.Owner() != "user" || len(c.Exceptions()) != 1 || len(c.Tasks()) != 1 || len(c.Exams()) != 1 {
+			t.Fatalf("unexpected calendar: %v\n", c)
+		}
+	})
+
+	t.Run("no terms", func(t *testing.T) {
+		usecase.now = func() time.Time {
+			return time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)
+		}
+
+		r.feed.EXPECT().Exists(gomock.Any(), secret).Return(true, nil)
+		r.feed.EXPECT().GetByToken(gomock.Any(), secret).Return(feed.NewFeed(user, secret), nil)
+		r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{}, nil)
+		r.timetables.EXPECT().Exists(gomock.Any(), user, 0).Return(true, nil)
+		r.timetables.EXPECT().Get(gomock.Any(), user, 0).Return(timetablesOf("A"), nil)
+		r.exception.EXPECT().GetAll(gomock.Any(), user).Return(nil, nil)
+		r.task.EXPECT().GetAll(gomock.Any(), user).Return(nil, nil)
+		r.bell.EXPECT().Exists(gomock.Any(), user).Return(false, nil).Times(2)
+		r.exam.EXPECT().GetAll(gomock.Any(), user).Return(nil, nil)
+
+		c, err := usecase.ExportFeed(context.Background(), secret)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+
+		// 2021-01-02 is in the 53rd week of the ISO year 2020.
+		s := c.Spans()[0]
+		start := time.Date(2019, time.December, 30, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC)
+		if s.Key() != "year2020" || !s.Start().Equal(start) || !s.End().Equal(end) {
+			t.Fatalf("unexpected span: %v %v %v\n", s.Key(), s.Start(), s.End())
+		}
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		r.feed.EXPECT().Exists(gomock.Any(), secret).Return(false, nil)
+
+		_, err := usecase.ExportFeed(context.Background(), secret)
+		if expected := FeedNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
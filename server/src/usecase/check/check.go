@@ -0,0 +1,233 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	calendarModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/calendar"
+	checkModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/check"
+	termModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	checkRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/check"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	bellUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/bell"
+	examUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/exam"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type CheckUsecase struct {
+	credentialUsecase    credentialUsecase.CredentialUsecase
+	checkRepository      checkRepository.ICheckRepository
+	timetablesRepository timetablesRepository.ITimetablesRepository
+	termRepository       termRepository.ITermRepository
+	subjectRepository    subjectRepository.ISubjectRepository
+	exceptionRepository  exceptionRepository.IExceptionRepository
+	bellUsecase          bellUsecase.BellUsecase
+	examUsecase          examUsecase.ExamUsecase
+	now                  func() time.Time
+}
+
+func NewCheckUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	ch checkRepository.ICheckRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+	s subjectRepository.ISubjectRepository,
+	x examRepository.IExamRepository,
+	e exceptionRepository.IExceptionRepository,
+	b bellRepository.IBellRepository,
+) CheckUsecase {
+	return CheckUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		ch,
+		t,
+		tm,
+		s,
+		e,
+		bellUsecase.NewBellUsecase(c, l, b),
+		examUsecase.NewExamUsecase(c, l, x, s, tm, b),
+		time.Now,
+	}
+}
+
+// Check reviews the timetables as if they were registered to the given term,
+// or to the active term if not specified, without registering them.
+func (u CheckUsecase) Check(ctx context.Context, t token.Token, term int, specified bool, ts timetablesModel.Timetables) (checkModel.Report, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+
+	span, _, err := u.span(ctx, user, term, specified, ts)
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+
+	return u.check(ctx, user, span)
+}
+
+// Current reviews the registered timetables of the given term, or of the active term if not specified.
+func (u CheckUsecase) Current(ctx context.Context, t token.Token, term int, specified bool) (checkModel.Report, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+
+	span, id, err := u.span(ctx, user, term, specified, timetablesModel.Timetables{})
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+
+	exist, err := u.timetablesRepository.Exists(ctx, user, id)
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+	if !exist {
+		return checkModel.Report{}, timetablesUsecase.TimetablesNotFound
+	}
+
+	ts, err := u.timetablesRepository.Get(ctx, user, id)
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+
+	return u.check(ctx, user, calendarModel.NewSpan(span.Key(), span.Start(), span.End(), ts))
+}
+
+func (u CheckUsecase) SetLimits(ctx context.Context, t token.Token, l checkModel.Limits) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.checkRepository.SetLimits(ctx, user, l)
+}
+
+// Limits returns the limits of the user, or the default ones if not set.
+func (u CheckUsecase) Limits(ctx context.Context, t token.Token) (checkModel.Limits, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return checkModel.Limits{}, err
+	}
+
+	return u.limitsOf(ctx, user)
+}
+
+// DeleteAll removes the limits of the user.
+func (u CheckUsecase) DeleteAll(ctx context.Context, t token.Token) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.checkRepository.RemoveLimits(ctx, user)
+}
+
+func (u CheckUsecase) check(ctx context.Context, user username.Username, span calendarModel.Span) (checkModel.Report, error) {
+	subjects, err := u.subjectRepository.GetAll(ctx, user)
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+
+	exams, err := u.examUsecase.Of(ctx, user)
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+
+	exceptions, err := u.exceptionRepository.GetAll(ctx, user)
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+
+	schedule, err := u.bellUsecase.Of(ctx, user)
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+
+	limits, err := u.limitsOf(ctx, user)
+	if err != nil {
+		return checkModel.Report{}, err
+	}
+
+	return checkModel.Check(span, schedule, subjects, exams, exceptions, limits), nil
+}
+
+// span returns the span of the term timetables are registered to, and its ID.
+// Timetables of a user without terms are held throughout the ISO week-numbering year of today.
+func (u CheckUsecase) span(ctx context.Context,
+	user username.Username,
+	id int,
+	specified bool,
+	ts timetablesModel.Timetables,
+) (calendarModel.Span, int, error) {
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return calendarModel.Span{}, 0, err
+	}
+
+	if !specified && len(terms) == 0 {
+		year, _ := u.now().ISOWeek()
+		start := timetablesModel.Monday(time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC))
+		end := timetablesModel.Monday(time.Date(year+1, time.January, 4, 0, 0, 0, 0, time.UTC)).AddDate(0, 0, -1)
+
+		return calendarModel.NewSpan(fmt.Sprintf("year%d", year), start, end, ts), timetablesUsecase.NoTerm, nil
+	}
+
+	var (
+		term  termModel.Term
+		found bool
+	)
+	if specified {
+		for _, t := range terms {
+			if t.ID() == id {
+				term, found = t, true
+			}
+		}
+		if !found {
+			return calendarModel.Span{}, 0, termUsecase.TermNotFound
+		}
+	} else {
+		term, found = termModel.Active(terms, u.now())
+		if !found {
+			return calendarModel.Span{}, 0, termUsecase.ActiveTermNotFound
+		}
+	}
+
+	return calendarModel.NewSpan(fmt.Sprintf("term%d", term.ID()), term.Start(), term.End(), ts), term.ID(), nil
+}
+
+func (u CheckUsecase) limitsOf(ctx context.Context, user username.Username) (checkModel.Limits, error) {
+	exists, err := u.checkRepository.LimitsExist(ctx, user)
+	if err != nil {
+		return checkModel.Limits{}, err
+	}
+	if !exists {
+		return checkModel.DefaultLimits(), nil
+	}
+
+	return u.checkRepository.GetLimits(ctx, user)
+}
+
+func (u CheckUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
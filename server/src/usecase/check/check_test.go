@@ -0,0 +1,250 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	checkModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/check"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type repositories struct {
+	credential *mocks.MockICredentialRepository
+	login      *mocks.MockILoginRepository
+	check      *mocks.MockICheckRepository
+	timetables *mocks.MockITimetablesRepository
+	term       *mocks.MockITermRepository
+	subject    *mocks.MockISubjectRepository
+	exam       *mocks.MockIExamRepository
+	exception  *mocks.MockIExceptionRepository
+	bell       *mocks.MockIBellRepository
+}
+
+func newUsecase(ctrl *gomock.Controller) (CheckUsecase, repositories) {
+	r := repositories{
+		mocks.NewMockICredentialRepository(ctrl),
+		mocks.NewMockILoginRepository(ctrl),
+		mocks.NewMockICheckRepository(ctrl),
+		mocks.NewMockITimetablesRepository(ctrl),
+		mocks.NewMockITermRepository(ctrl),
+		mocks.NewMockISubjectRepository(ctrl),
+		mocks.NewMockIExamRepository(ctrl),
+		mocks.NewMockIExceptionRepository(ctrl),
+		mocks.NewMockIBellRepository(ctrl),
+	}
+
+	u := NewCheckUsecase(r.credential, r.login, r.check, r.timetables, r.term, r.subject, r.exam, r.exception, r.bell)
+	u.now = func() time.Time { return time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC) }
+
+	return u, r
+}
+
+var (
+	user, _   = username.NewUsername("user")
+	userToken = token.NewToken("123")
+)
+
+func signedIn(r repositories) {
+	r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+	r.credential.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(credential.NewAuth(user, userToken), nil)
+}
+
+// loaded expects everything but timetables to be loaded for a check.
+func loaded(r repositories, subjects []subject.Subject, exams []exam.Exam, limits *checkModel.Limits) {
+	r.subject.EXPECT().GetAll(gomock.Any(), user).Return(subjects, nil)
+	r.bell.EXPECT().Exists(gomock.Any(), user).Return(false, nil).Times(2)
+	r.exam.EXPECT().GetAll(gomock.Any(), user).Return(exams, nil)
+	r.exception.EXPECT().GetAll(gomock.Any(), user).Return(nil, nil)
+	r.check.EXPECT().LimitsExist(gomock.Any(), user).Return(limits != nil, nil)
+	if limits != nil {
+		r.check.EXPECT().GetLimits(gomock.Any(), user).Return(*limits, nil)
+	}
+}
+
+func week(c timetables.Class) timetables.Timetables {
+	day := timetables.NewTimetable(
+		c,
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+
+	return timetables.NewTimetables(day, day, day, day, day)
+}
+
+func TestCheck(t *testing.T) {
+	spring, _ := term.NewTerm(1, "spring", "2020-04-01", "2020-07-31")
+	fall, _ := term.NewTerm(2, "fall", "2020-10-01", "2021-01-31")
+	algebra, _ := subject.NewSubject(1, "Algebra", "", 2, "", "", "101")
+	ts := week(timetables.NoRoom("Algebra", "").WithSubject(1))
+
+	jst := time.FixedZone("JST", 9*60*60)
+	// Friday 2020-10-02 is in the fall term only.
+	midterm, _ := exam.NewExam(1, 1, 2, "Midterm",
+		time.Date(2020, 10, 2, 9, 0, 0, 0, jst), time.Date(2020, 10, 2, 10, 0, 0, 0, jst), "")
+	limits, _ := checkModel.NewLimits(5, 2)
+
+	tests := []struct {
+		name      string
+		terms     []term.Term
+		term      int
+		specified bool
+		limits    *checkModel.Limits
+		expected  []checkModel.Kind
+	}{
+		{"active term", []term.Term{spring, fall}, 0, false, nil, []checkModel.Kind{checkModel.TooManySlots}},
+		{"given term", []term.Term{spring, fall}, 2, true, nil, []checkModel.Kind{checkModel.TooManySlots, checkModel.ExamOverlap}},
+		{"no terms", []term.Term{}, 0, false, &limits, []checkModel.Kind{checkModel.ExamOverlap}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase, r := newUsecase(ctrl)
+			signedIn(r)
+			r.term.EXPECT().GetAll(gomock.Any(), user).Return(test.terms, nil)
+			loaded(r, []subject.Subject{algebra}, []exam.Exam{midterm}, test.limits)
+
+			report, err := usecase.Check(context.Background(), userToken, test.term, test.specified, ts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+
+			ws := report.Warnings()
+			if len(ws) != len(test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, ws)
+			}
+			for i, k := range test.expected {
+				if ws[i].Kind() != k {
+					t.Fatalf("expected: %v; got: %v\n", test.expected, ws)
+				}
+			}
+		})
+	}
+
+	failures := []struct {
+		name      string
+		terms     []term.Term
+		term      int
+		specified bool
+		expected  error
+	}{
+		{"unknown term", []term.Term{spring, fall}, 3, true, termUsecase.TermNotFound},
+		{"given term without terms", []term.Term{}, 1, true, termUsecase.TermNotFound},
+		{"no active term", []term.Term{fall}, 0, false, termUsecase.ActiveTermNotFound},
+	}
+
+	for _, test := range failures {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase, r := newUsecase(ctrl)
+			signedIn(r)
+			r.term.EXPECT().GetAll(gomock.Any(), user).Return(test.terms, nil)
+
+			_, err := usecase.Check(context.Background(), userToken, test.term, test.specified, ts)
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+
+	t.Run("has no credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		_, err := usecase.Check(context.Background(), token.NewToken(""), 0, false, ts)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestCurrent(t *testing.T) {
+	spring, _ := term.NewTerm(1, "spring", "2020-04-01", "2020-07-31")
+	ts := week(timetables.NewClass("Algebra", "101", ""))
+
+	t.Run("registered timetables", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		signedIn(r)
+		r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{spring}, nil)
+		r.timetables.EXPECT().Exists(gomock.Any(), user, 1).Return(true, nil)
+		r.timetables.EXPECT().Get(gomock.Any(), user, 1).Return(ts, nil)
+		loaded(r, nil, nil, nil)
+
+		report, err := usecase.Current(context.Background(), userToken, 0, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if ws := report.Warnings(); len(ws) != 1 || ws[0].Kind() != checkModel.TooManySlots || ws[0].Count() != 5 {
+			t.Fatalf("expected: %v; got: %v\n", checkModel.TooManySlots, ws)
+		}
+	})
+
+	t.Run("no timetables", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		signedIn(r)
+		r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{}, nil)
+		r.timetables.EXPECT().Exists(gomock.Any(), user, timetablesUsecase.NoTerm).Return(false, nil)
+
+		_, err := usecase.Current(context.Background(), userToken, 0, false)
+		if expected := timetablesUsecase.TimetablesNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestLimits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+
+	t.Run("default", func(t *testing.T) {
+		signedIn(r)
+		r.check.EXPECT().LimitsExist(gomock.Any(), user).Return(false, nil)
+
+		l, err := usecase.Limits(context.Background(), userToken)
+		if err != nil || l != checkModel.DefaultLimits() {
+			t.Fatalf("expected: %v; got: %v %v\n", checkModel.DefaultLimits(), l, err)
+		}
+	})
+
+	t.Run("set", func(t *testing.T) {
+		limits, _ := checkModel.NewLimits(3, 30)
+		signedIn(r)
+		r.check.EXPECT().LimitsExist(gomock.Any(), user).Return(true, nil)
+		r.check.EXPECT().GetLimits(gomock.Any(), user).Return(limits, nil)
+
+		l, err := usecase.Limits(context.Background(), userToken)
+		if err != nil || l != limits {
+			t.Fatalf("expected: %v; got: %v %v\n", limits, l, err)
+		}
+	})
+}
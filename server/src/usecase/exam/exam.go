@@ -0,0 +1,210 @@
+package exam
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	examModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	termModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	bellUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/bell"
+	subjectUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/subject"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type ExamUsecase struct {
+	credentialUsecase credentialUsecase.CredentialUsecase
+	examRepository    examRepository.IExamRepository
+	subjectRepository subjectRepository.ISubjectRepository
+	termRepository    termRepository.ITermRepository
+	bellUsecase       bellUsecase.BellUsecase
+	now               func() time.Time
+}
+
+func NewExamUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	x examRepository.IExamRepository,
+	s subjectRepository.ISubjectRepository,
+	tm termRepository.ITermRepository,
+	b bellRepository.IBellRepository,
+) ExamUsecase {
+	return ExamUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		x,
+		s,
+		tm,
+		bellUsecase.NewBellUsecase(c, l, b),
+		time.Now,
+	}
+}
+
+var (
+	ExamNotFound  = errs.NotFound("exam_not_found", "exam not found")
+	ExamOutOfTerm = errs.Invalid("exam_out_of_term", "exam is out of the term")
+)
+
+// Save adds the exam if its ID is -1, or updates the exam of the ID otherwise.
+// An exam given no term belongs to the term containing its day, if any.
+func (u ExamUsecase) Save(ctx context.Context, t token.Token, e examModel.Exam) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	exists, err := u.subjectRepository.Exists(ctx, user, e.SubjectID())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return subjectUsecase.SubjectNotFound
+	}
+
+	e, err = u.inTerm(ctx, user, e)
+	if err != nil {
+		return err
+	}
+
+	if e.ID() == -1 {
+		return u.examRepository.Create(ctx, user, e)
+	}
+
+	exists, err = u.examRepository.Exists(ctx, user, e.ID())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ExamNotFound
+	}
+
+	return u.examRepository.Update(ctx, user, e)
+}
+
+// inTerm checks the term of the exam contains its day in the time zone of
+// the bell schedule, or assigns the term containing it to an exam without one.
+func (u ExamUsecase) inTerm(ctx context.Context, user username.Username, e examModel.Exam) (examModel.Exam, error) {
+	schedule, err := u.bellUsecase.Of(ctx, user)
+	if err != nil {
+		return examModel.Exam{}, err
+	}
+	day := e.Start().In(schedule.Location())
+
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return examModel.Exam{}, err
+	}
+
+	if e.TermID() == 0 {
+		if active, found := termModel.Active(terms, day); found {
+			return e.WithTerm(active.ID()), nil
+		}
+		return e, nil
+	}
+
+	for _, term := range terms {
+		if term.ID() != e.TermID() {
+			continue
+		}
+		if !term.Contains(day) {
+			return examModel.Exam{}, ExamOutOfTerm
+		}
+		return e, nil
+	}
+
+	return examModel.Exam{}, termUsecase.TermNotFound
+}
+
+func (u ExamUsecase) GetAll(ctx context.Context, t token.Token) ([]examModel.Exam, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.Of(ctx, user)
+}
+
+// Of returns the exams of the user in the time zone of the bell schedule.
+func (u ExamUsecase) Of(ctx context.Context, user username.Username) ([]examModel.Exam, error) {
+	exams, _, err := u.of(ctx, user)
+	return exams, err
+}
+
+// Upcoming returns the countdowns of the exams of the user which have not
+// ended yet, counting days in the time zone of the bell schedule.
+func (u ExamUsecase) Upcoming(ctx context.Context, t token.Token) ([]examModel.Countdown, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	exams, loc, err := u.of(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return examModel.Upcoming(exams, u.now().In(loc)), nil
+}
+
+func (u ExamUsecase) of(ctx context.Context, user username.Username) ([]examModel.Exam, *time.Location, error) {
+	schedule, err := u.bellUsecase.Of(ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exams, err := u.examRepository.GetAll(ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, e := range exams {
+		exams[i] = e.In(schedule.Location())
+	}
+
+	return exams, schedule.Location(), nil
+}
+
+func (u ExamUsecase) Delete(ctx context.Context, t token.Token, id int) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	exists, err := u.examRepository.Exists(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ExamNotFound
+	}
+
+	return u.examRepository.Remove(ctx, user, id)
+}
+
+func (u ExamUsecase) DeleteAll(ctx context.Context, t token.Token) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.examRepository.RemoveAll(ctx, user)
+}
+
+func (u ExamUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
@@ -0,0 +1,190 @@
+package exam
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	examModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	subjectUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/subject"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type repositories struct {
+	credential *mocks.MockICredentialRepository
+	login      *mocks.MockILoginRepository
+	exam       *mocks.MockIExamRepository
+	subject    *mocks.MockISubjectRepository
+	term       *mocks.MockITermRepository
+	bell       *mocks.MockIBellRepository
+}
+
+func newUsecase(ctrl *gomock.Controller) (ExamUsecase, repositories) {
+	r := repositories{
+		mocks.NewMockICredentialRepository(ctrl),
+		mocks.NewMockILoginRepository(ctrl),
+		mocks.NewMockIExamRepository(ctrl),
+		mocks.NewMockISubjectRepository(ctrl),
+		mocks.NewMockITermRepository(ctrl),
+		mocks.NewMockIBellRepository(ctrl),
+	}
+
+	return NewExamUsecase(r.credential, r.login, r.exam, r.subject, r.term, r.bell), r
+}
+
+var (
+	user, _   = username.NewUsername("user")
+	userToken = token.NewToken("123")
+	jst       = time.FixedZone("JST", 9*60*60)
+)
+
+func signedIn(r repositories) {
+	r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+	r.credential.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(credential.NewAuth(user, userToken), nil)
+}
+
+func at(month time.Month, day, hour int) time.Time {
+	return time.Date(2020, month, day, hour, 0, 0, 0, jst)
+}
+
+func TestSave(t *testing.T) {
+	spring, _ := term.NewTerm(1, "spring", "2020-04-01", "2020-07-31")
+	fall, _ := term.NewTerm(2, "fall", "2020-10-01", "2021-01-31")
+	terms := []term.Term{spring, fall}
+
+	final, _ := examModel.NewExam(-1, 1, 0, "Final", at(7, 20, 9), at(7, 20, 10), "101")
+	// It starts on 2020-07-31 in UTC, which is already 2020-08-01 in Japan.
+	late, _ := examModel.NewExam(-1, 1, 1, "Final", at(8, 1, 1).UTC(), at(8, 1, 2).UTC(), "101")
+	summer, _ := examModel.NewExam(-1, 1, 0, "Intensive", at(8, 20, 9), at(8, 20, 10), "101")
+	unknownTerm, _ := examModel.NewExam(-1, 1, 3, "Final", at(7, 20, 9), at(7, 20, 10), "101")
+	existing, _ := examModel.NewExam(5, 1, 1, "Final", at(7, 20, 9), at(7, 20, 10), "101")
+
+	tests := []struct {
+		name     string
+		exam     examModel.Exam
+		subject  bool
+		saved    *examModel.Exam
+		exists   *bool
+		expected error
+	}{
+		{"assigns the term of the day", final, true, examPtr(final.WithTerm(1)), nil, nil},
+		{"out of any term", summer, true, &summer, nil, nil},
+		{"subject out of the catalog", final, false, nil, nil, subjectUsecase.SubjectNotFound},
+		{"out of the given term", late, true, nil, nil, ExamOutOfTerm},
+		{"unknown term", unknownTerm, true, nil, nil, termUsecase.TermNotFound},
+		{"update", existing, true, &existing, boolPtr(true), nil},
+		{"update of unknown exam", existing, true, nil, boolPtr(false), ExamNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase, r := newUsecase(ctrl)
+			signedIn(r)
+			r.subject.EXPECT().Exists(gomock.Any(), user, 1).Return(test.subject, nil)
+			if test.subject {
+				r.bell.EXPECT().Exists(gomock.Any(), user).Return(false, nil)
+				r.term.EXPECT().GetAll(gomock.Any(), user).Return(terms, nil)
+			}
+			if test.exists != nil {
+				r.exam.EXPECT().Exists(gomock.Any(), user, test.exam.ID()).Return(*test.exists, nil)
+			}
+			if test.saved != nil && test.exam.ID() == -1 {
+				r.exam.EXPECT().Create(gomock.Any(), user, *test.saved).Return(nil)
+			}
+			if test.saved != nil && test.exam.ID() != -1 {
+				r.exam.EXPECT().Update(gomock.Any(), user, *test.saved).Return(nil)
+			}
+
+			err := usecase.Save(context.Background(), userToken, test.exam)
+			if test.expected == nil && err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if test.expected != nil && !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+
+	t.Run("has no credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		err := usecase.Save(context.Background(), token.NewToken(""), final)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func examPtr(e examModel.Exam) *examModel.Exam {
+	return &e
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestUpcoming(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+	usecase.now = func() time.Time { return at(7, 20, 0).UTC() }
+
+	done, _ := examModel.NewExam(1, 1, 1, "Midterm", at(6, 1, 9), at(6, 1, 10), "101")
+	final, _ := examModel.NewExam(2, 1, 1, "Final", at(7, 21, 8), at(7, 21, 9), "101")
+
+	signedIn(r)
+	r.bell.EXPECT().Exists(gomock.Any(), user).Return(false, nil)
+	r.exam.EXPECT().GetAll(gomock.Any(), user).Return([]examModel.Exam{done, final.In(time.UTC)}, nil)
+
+	cs, err := usecase.Upcoming(context.Background(), userToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	// The exam starts on the next day in Japan, though on the same day in UTC.
+	if len(cs) != 1 || cs[0].Exam().ID() != 2 || cs[0].Days() != 1 || cs[0].Minutes() != 32*60 {
+		t.Fatalf("expected: %v; got: %v\n", final, cs)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+
+	t.Run("existing exam", func(t *testing.T) {
+		signedIn(r)
+		r.exam.EXPECT().Exists(gomock.Any(), user, 1).Return(true, nil)
+		r.exam.EXPECT().Remove(gomock.Any(), user, 1).Return(nil)
+
+		if err := usecase.Delete(context.Background(), userToken, 1); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("unknown exam", func(t *testing.T) {
+		signedIn(r)
+		r.exam.EXPECT().Exists(gomock.Any(), user, 2).Return(false, nil)
+
+		err := usecase.Delete(context.Background(), userToken, 2)
+		if expected := ExamNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package exception
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	exceptionModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	tokenModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type ExceptionUsecase struct {
+	credentialUsecase   credentialUsecase.CredentialUsecase
+	exceptionRepository exceptionRepository.IExceptionRepository
+	timetablesUsecase   timetablesUsecase.TimetablesUsecase
+}
+
+func NewExceptionUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	e exceptionRepository.IExceptionRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) ExceptionUsecase {
+	return ExceptionUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		e,
+		timetablesUsecase.NewTimetablesUsecase(c, l, t, tm),
+	}
+}
+
+var (
+	IDIsNotZero = errs.Invalid("id_is_not_zero", "ID is not zero")
+	InvalidID   = errs.Invalid("invalid_id", "Invalid ID")
+)
+
+func (u ExceptionUsecase) Add(ctx context.Context, token tokenModel.Token, exception exceptionModel.Exception) error {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !credentialed {
+		return credentialUsecase.InvalidToken
+	}
+
+	user, err := u.credentialUsecase.Whose(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return u.exceptionRepository.Create(ctx, user, exception)
+}
+
+func (u ExceptionUsecase) Delete(ctx context.Context, token tokenModel.Token, id int) error {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !credentialed {
+		return credentialUsecase.InvalidToken
+	}
+
+	if id == 0 {
+		return IDIsNotZero
+	}
+	if id < 0 {
+		return InvalidID
+	}
+
+	user, err := u.credentialUsecase.Whose(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	exceptions, err := u.exceptionRepository.GetAll(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	if !isValidID(id, exceptions) {
+		return InvalidID
+	}
+
+	return u.exceptionRepository.Remove(ctx, user, id)
+}
+
+func (u ExceptionUsecase) DeleteAll(ctx context.Context, token tokenModel.Token) error {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !credentialed {
+		return credentialUsecase.InvalidToken
+	}
+
+	user, err := u.credentialUsecase.Whose(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return u.exceptionRepository.RemoveAll(ctx, user)
+}
+
+func isValidID(id int, exceptions []exceptionModel.Exception) bool {
+	for _, e := range exceptions {
+		if e.ID() == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (u ExceptionUsecase) GetAll(ctx context.Context, token tokenModel.Token) ([]exceptionModel.Exception, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !credentialed {
+		return nil, credentialUsecase.InvalidToken
+	}
+
+	user, err := u.credentialUsecase.Whose(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.exceptionRepository.GetAll(ctx, user)
+}
+
+// EffectiveDay returns the timetable of date d with its exceptions applied.
+// Weekends and dates outside every term have no regular classes but may have make-up classes.
+func (u ExceptionUsecase) EffectiveDay(ctx context.Context, token tokenModel.Token, d time.Time) (timetablesModel.Timetable, error) {
+	exceptions, err := u.GetAll(ctx, token)
+	if err != nil {
+		return timetablesModel.Timetable{}, err
+	}
+
+	r := u.newResolver(ctx, token)
+	base, err := r.day(d)
+	if err != nil {
+		return timetablesModel.Timetable{}, err
+	}
+
+	return exceptionModel.Apply(base, d, exceptions, r.origin), nil
+}
+
+// EffectiveWeek returns the timetables of the week containing d
+// with the exceptions of each weekday applied. Each weekday has the classes of its own term.
+func (u ExceptionUsecase) EffectiveWeek(ctx context.Context, token tokenModel.Token, d time.Time) (timetablesModel.Timetables, error) {
+	exceptions, err := u.GetAll(ctx, token)
+	if err != nil {
+		return timetablesModel.Timetables{}, err
+	}
+
+	r := u.newResolver(ctx, token)
+	mon := timetablesModel.Monday(d)
+	days := make([]timetablesModel.Timetable, 0, 5)
+	for i := 0; i < 5; i++ {
+		date := mon.AddDate(0, 0, i)
+		base, err := r.day(date)
+		if err != nil {
+			return timetablesModel.Timetables{}, err
+		}
+		days = append(days, exceptionModel.Apply(base, date, exceptions, r.origin))
+	}
+
+	return timetablesModel.NewTimetables(days[0], days[1], days[2], days[3], days[4]), nil
+}
+
+// resolver looks up the regular classes of dates for a request, caching them by date.
+// Each date is resolved on its own, as the days of a week may belong to different terms.
+type resolver struct {
+	ctx               context.Context
+	token             tokenModel.Token
+	timetablesUsecase timetablesUsecase.TimetablesUsecase
+	days              map[time.Time]timetablesModel.Timetable
+}
+
+func (u ExceptionUsecase) newResolver(ctx context.Context, token tokenModel.Token) *resolver {
+	return &resolver{ctx, token, u.timetablesUsecase, map[time.Time]timetablesModel.Timetable{}}
+}
+
+// day returns the regular classes of date d. Weekends and dates outside every term have no classes.
+func (r *resolver) day(d time.Time) (timetablesModel.Timetable, error) {
+	if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+		return timetablesModel.EmptyTimetable(), nil
+	}
+
+	date := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+	if day, ok := r.days[date]; ok {
+		return day, nil
+	}
+
+	week, err := r.timetablesUsecase.GetWeek(r.ctx, r.token, date)
+	if err != nil && !errors.Is(err, termUsecase.TermNotFound) {
+		return timetablesModel.Timetable{}, err
+	}
+
+	day := timetablesModel.EmptyTimetable()
+	if err == nil {
+		day, _ = week.Day(d.Weekday())
+	}
+	r.days[date] = day
+
+	return day, nil
+}
+
+// origin returns the regular class of the period on date d.
+// Classes of dates without timetables are treated as no class.
+func (r *resolver) origin(d time.Time, n int) timetablesModel.Class {
+	day, err := r.day(d)
+	if err != nil {
+		return timetablesModel.NoClass()
+	}
+
+	s, ok := day.Period(n)
+	if !ok || s.IsNoClass() {
+		return timetablesModel.NoClass()
+	}
+
+	return s.Classes()[0]
+}
@@ -0,0 +1,257 @@
+package exception
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	exceptionModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+var (
+	day = timetables.NewTimetable(
+		timetables.NewClass("A", "1", ""),
+		timetables.NewClass("B", "2", ""),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	ts = timetables.NewTimetables(day, day, day, day, day)
+)
+
+func TestAdd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	exceptionRepository := mocks.NewMockIExceptionRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+
+	usecase := NewExceptionUsecase(
+		credentialRepository,
+		loginRepository,
+		exceptionRepository,
+		timetablesRepository,
+		termRepository,
+	)
+
+	cancel, _ := exceptionModel.NewCancellation(-1, "2020-04-08", 1)
+
+	t.Run("success", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+
+		username, _ := username.NewUsername("user")
+		userToken := token.NewToken("123")
+		auth := credential.NewAuth(username, userToken)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		exceptionRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		err := usecase.Add(context.Background(), userToken, cancel)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("has no credential", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		err := usecase.Add(context.Background(), token.NewToken(""), cancel)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	exceptionRepository := mocks.NewMockIExceptionRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+
+	usecase := NewExceptionUsecase(
+		credentialRepository,
+		loginRepository,
+		exceptionRepository,
+		timetablesRepository,
+		termRepository,
+	)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+	cancel, _ := exceptionModel.NewCancellation(1, "2020-04-08", 1)
+
+	t.Run("success", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		exceptionRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]exceptionModel.Exception{cancel}, nil)
+		exceptionRepository.EXPECT().Remove(gomock.Any(), gomock.Any(), 1).Return(nil)
+
+		err := usecase.Delete(context.Background(), userToken, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		exceptionRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]exceptionModel.Exception{cancel}, nil)
+
+		err := usecase.Delete(context.Background(), userToken, 2)
+		if expected := InvalidID; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestEffective(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	exceptionRepository := mocks.NewMockIExceptionRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+
+	usecase := NewExceptionUsecase(
+		credentialRepository,
+		loginRepository,
+		exceptionRepository,
+		timetablesRepository,
+		termRepository,
+	)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+	spring, _ := term.NewTerm(1, "spring", "2020-04-01", "2020-09-30")
+
+	credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+	credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil).AnyTimes()
+	termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]term.Term{spring}, nil).AnyTimes()
+	timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), spring.ID()).Return(true, nil).AnyTimes()
+	timetablesRepository.EXPECT().Get(gomock.Any(), gomock.Any(), spring.ID()).Return(ts, nil).AnyTimes()
+
+	// 2020-04-08 is a Wednesday.
+	cancel, _ := exceptionModel.NewCancellation(1, "2020-04-08", 1)
+	move, _ := exceptionModel.NewMove(2, "2020-04-08", 2, "2020-04-11", 3)
+	exceptionRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]exceptionModel.Exception{cancel, move}, nil).AnyTimes()
+
+	t.Run("week", func(t *testing.T) {
+		week, err := usecase.EffectiveWeek(context.Background(), userToken, time.Date(2020, time.April, 6, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if !week.Wed().First().IsNoClass() || !week.Wed().Second().IsNoClass() {
+			t.Fatal("classes on wednesday should be cancelled and moved")
+		}
+		if week.Tue().First().IsNoClass() {
+			t.Fatal("classes on tuesday should not be changed")
+		}
+	})
+
+	t.Run("weekend make-up", func(t *testing.T) {
+		sat, err := usecase.EffectiveDay(context.Background(), userToken, time.Date(2020, time.April, 11, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if sat.Third().IsNoClass() || sat.Third().Classes()[0].Subject() != "B" {
+			t.Fatalf("expected: %v; got: %v\n", "B", sat.Third())
+		}
+	})
+}
+
+func TestEffectiveAcrossTerms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	exceptionRepository := mocks.NewMockIExceptionRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+
+	usecase := NewExceptionUsecase(
+		credentialRepository,
+		loginRepository,
+		exceptionRepository,
+		timetablesRepository,
+		termRepository,
+	)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+	// The spring term starts and ends on Wednesdays, followed by the fall term from the next day.
+	spring, _ := term.NewTerm(1, "spring", "2020-04-01", "2020-09-30")
+	fall, _ := term.NewTerm(2, "fall", "2020-10-01", "2021-03-31")
+	other := timetables.NewTimetable(
+		timetables.NewClass("F", "3", ""),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	fallTs := timetables.NewTimetables(other, other, other, other, other)
+
+	credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+	credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil).AnyTimes()
+	termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]term.Term{spring, fall}, nil).AnyTimes()
+	timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+	timetablesRepository.EXPECT().Get(gomock.Any(), gomock.Any(), spring.ID()).Return(ts, nil).AnyTimes()
+	timetablesRepository.EXPECT().Get(gomock.Any(), gomock.Any(), fall.ID()).Return(fallTs, nil).AnyTimes()
+	exceptionRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]exceptionModel.Exception{}, nil).AnyTimes()
+
+	subjects := func(week timetables.Timetables) []string {
+		ss := make([]string, 0, 5)
+		for w := time.Monday; w <= time.Friday; w++ {
+			day, _ := week.Day(w)
+			if day.First().IsNoClass() {
+				ss = append(ss, "")
+				continue
+			}
+			ss = append(ss, day.First().Classes()[0].Subject())
+		}
+		return ss
+	}
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected []string
+	}{
+		{"term starting on a wednesday", time.Date(2020, time.March, 30, 0, 0, 0, 0, time.UTC), []string{"", "", "A", "A", "A"}},
+		{"term ending on a wednesday", time.Date(2020, time.September, 28, 0, 0, 0, 0, time.UTC), []string{"A", "A", "A", "F", "F"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			week, err := usecase.EffectiveWeek(context.Background(), userToken, test.date)
+			if err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if got := subjects(week); !reflect.DeepEqual(got, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package grade
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	gradeModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/grade"
+	subjectModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	gradeRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/grade"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	subjectUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/subject"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type GradeUsecase struct {
+	credentialUsecase credentialUsecase.CredentialUsecase
+	timetablesUsecase timetablesUsecase.TimetablesUsecase
+	gradeRepository   gradeRepository.IGradeRepository
+	subjectRepository subjectRepository.ISubjectRepository
+}
+
+func NewGradeUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	g gradeRepository.IGradeRepository,
+	s subjectRepository.ISubjectRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) GradeUsecase {
+	return GradeUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		timetablesUsecase.NewTimetablesUsecase(c, l, t, tm),
+		g,
+		s,
+	}
+}
+
+var ScoreNotFound = errs.NotFound("score_not_found", "score not found")
+
+// AddScore adds a score to a subject in the catalog of the user.
+func (u GradeUsecase) AddScore(ctx context.Context, t token.Token, s gradeModel.Score) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	exists, err := u.subjectRepository.Exists(ctx, user, s.SubjectID())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return subjectUsecase.SubjectNotFound
+	}
+
+	return u.gradeRepository.Create(ctx, user, s)
+}
+
+func (u GradeUsecase) GetScores(ctx context.Context, t token.Token) ([]gradeModel.Score, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.gradeRepository.GetAll(ctx, user)
+}
+
+func (u GradeUsecase) DeleteScore(ctx context.Context, t token.Token, id int) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	exists, err := u.gradeRepository.Exists(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ScoreNotFound
+	}
+
+	return u.gradeRepository.Remove(ctx, user, id)
+}
+
+// DeleteAll removes the scores and the grading scale of the user.
+func (u GradeUsecase) DeleteAll(ctx context.Context, t token.Token) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	if err = u.gradeRepository.RemoveAll(ctx, user); err != nil {
+		return err
+	}
+
+	return u.gradeRepository.RemoveScale(ctx, user)
+}
+
+func (u GradeUsecase) SetScale(ctx context.Context, t token.Token, s gradeModel.Scale) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.gradeRepository.SetScale(ctx, user, s)
+}
+
+// Scale returns the grading scale of the user, or the default one if not set.
+func (u GradeUsecase) Scale(ctx context.Context, t token.Token) (gradeModel.Scale, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return gradeModel.Scale{}, err
+	}
+
+	return u.scaleOf(ctx, user)
+}
+
+// Report projects the grades of the subjects in the timetables of the active
+// term, or of every subject if all is set or there are no such timetables.
+func (u GradeUsecase) Report(ctx context.Context, t token.Token, all bool) (gradeModel.Report, gradeModel.Scale, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return gradeModel.Report{}, gradeModel.Scale{}, err
+	}
+
+	scale, err := u.scaleOf(ctx, user)
+	if err != nil {
+		return gradeModel.Report{}, gradeModel.Scale{}, err
+	}
+
+	subjects, err := u.subjectRepository.GetAll(ctx, user)
+	if err != nil {
+		return gradeModel.Report{}, gradeModel.Scale{}, err
+	}
+
+	if !all {
+		subjects, err = u.ofTerm(ctx, user, subjects)
+		if err != nil {
+			return gradeModel.Report{}, gradeModel.Scale{}, err
+		}
+	}
+
+	scores, err := u.gradeRepository.GetAll(ctx, user)
+	if err != nil {
+		return gradeModel.Report{}, gradeModel.Scale{}, err
+	}
+
+	return gradeModel.Project(subjects, scores, scale), scale, nil
+}
+
+// ofTerm picks the subjects taking place in the timetables of the active term.
+func (u GradeUsecase) ofTerm(ctx context.Context, user username.Username, subjects []subjectModel.Subject) ([]subjectModel.Subject, error) {
+	ts, err := u.timetablesUsecase.Of(ctx, user)
+	if err != nil && (errors.Is(err, timetablesUsecase.TimetablesNotFound) ||
+		errors.Is(err, termUsecase.ActiveTermNotFound)) {
+		return subjects, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	in := map[int]bool{}
+	for w := time.Monday; w <= time.Friday; w++ {
+		day, _ := ts.Day(w)
+		for _, s := range day.Slots() {
+			for _, c := range s.Classes() {
+				in[c.SubjectID()] = true
+			}
+		}
+	}
+
+	picked := make([]subjectModel.Subject, 0)
+	for _, s := range subjects {
+		if in[s.ID()] {
+			picked = append(picked, s)
+		}
+	}
+
+	return picked, nil
+}
+
+func (u GradeUsecase) scaleOf(ctx context.Context, user username.Username) (gradeModel.Scale, error) {
+	exists, err := u.gradeRepository.ScaleExists(ctx, user)
+	if err != nil {
+		return gradeModel.Scale{}, err
+	}
+	if !exists {
+		return gradeModel.DefaultScale(), nil
+	}
+
+	return u.gradeRepository.GetScale(ctx, user)
+}
+
+func (u GradeUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
@@ -0,0 +1,135 @@
+package grade
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	gradeModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/grade"
+	subjectModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	subjectUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/subject"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+)
+
+type repositories struct {
+	credential *mocks.MockICredentialRepository
+	login      *mocks.MockILoginRepository
+	grade      *mocks.MockIGradeRepository
+	subject    *mocks.MockISubjectRepository
+	timetables *mocks.MockITimetablesRepository
+	term       *mocks.MockITermRepository
+}
+
+func newUsecase(ctrl *gomock.Controller) (GradeUsecase, repositories) {
+	r := repositories{
+		mocks.NewMockICredentialRepository(ctrl),
+		mocks.NewMockILoginRepository(ctrl),
+		mocks.NewMockIGradeRepository(ctrl),
+		mocks.NewMockISubjectRepository(ctrl),
+		mocks.NewMockITimetablesRepository(ctrl),
+		mocks.NewMockITermRepository(ctrl),
+	}
+
+	return NewGradeUsecase(r.credential, r.login, r.grade, r.subject, r.timetables, r.term), r
+}
+
+var (
+	user, _   = username.NewUsername("user")
+	userToken = token.NewToken("123")
+)
+
+func signedIn(r repositories) {
+	r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+	r.credential.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(credential.NewAuth(user, userToken), nil)
+}
+
+func TestAddScore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+
+	t.Run("subject in the catalog", func(t *testing.T) {
+		score, _ := gradeModel.NewScore(-1, 1, "midterm", 72, 100, 40)
+		signedIn(r)
+		r.subject.EXPECT().Exists(gomock.Any(), user, 1).Return(true, nil)
+		r.grade.EXPECT().Create(gomock.Any(), user, score).Return(nil)
+
+		if err := usecase.AddScore(context.Background(), userToken, score); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("unknown subject", func(t *testing.T) {
+		score, _ := gradeModel.NewScore(-1, 2, "midterm", 72, 100, 40)
+		signedIn(r)
+		r.subject.EXPECT().Exists(gomock.Any(), user, 2).Return(false, nil)
+
+		err := usecase.AddScore(context.Background(), userToken, score)
+		if expected := subjectUsecase.SubjectNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestReport(t *testing.T) {
+	algebra, _ := subjectModel.NewSubject(1, "Algebra", "", 2, "", "", "")
+	history, _ := subjectModel.NewSubject(2, "History", "", 2, "", "", "")
+	algebraScore, _ := gradeModel.NewScore(1, 1, "final", 85, 100, 1)
+	historyScore, _ := gradeModel.NewScore(2, 2, "final", 55, 100, 1)
+
+	day := timetables.NewTimetable(
+		timetables.NewClass("Algebra", "101", "").WithSubject(1),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	ts := timetables.NewTimetables(day, day, day, day, day)
+
+	tests := []struct {
+		name     string
+		all      bool
+		subjects int
+		gpa      float64
+	}{
+		{"active term", false, 1, 3},
+		{"all subjects", true, 2, 1.5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase, r := newUsecase(ctrl)
+			signedIn(r)
+			r.grade.EXPECT().ScaleExists(gomock.Any(), user).Return(false, nil)
+			r.subject.EXPECT().GetAll(gomock.Any(), user).Return([]subjectModel.Subject{algebra, history}, nil)
+			if !test.all {
+				r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{}, nil)
+				r.timetables.EXPECT().Exists(gomock.Any(), user, timetablesUsecase.NoTerm).Return(true, nil)
+				r.timetables.EXPECT().Get(gomock.Any(), user, timetablesUsecase.NoTerm).Return(ts, nil)
+			}
+			r.grade.EXPECT().GetAll(gomock.Any(), user).Return([]gradeModel.Score{algebraScore, historyScore}, nil)
+
+			report, _, err := usecase.Report(context.Background(), userToken, test.all)
+			if err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if len(report.Projections()) != test.subjects {
+				t.Fatalf("expected: %v; got: %v\n", test.subjects, len(report.Projections()))
+			}
+			if gpa, _ := report.GPA(); gpa != test.gpa {
+				t.Fatalf("expected: %v; got: %v\n", test.gpa, gpa)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package group
+
+import (
+	"context"
+	"errors"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	groupModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/group"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	groupRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/group"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type GroupUsecase struct {
+	credentialUsecase credentialUsecase.CredentialUsecase
+	loginRepository   loginRepository.ILoginRepository
+	groupRepository   groupRepository.IGroupRepository
+	timetablesUsecase timetablesUsecase.TimetablesUsecase
+}
+
+func NewGroupUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	g groupRepository.IGroupRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) GroupUsecase {
+	return GroupUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		l,
+		g,
+		timetablesUsecase.NewTimetablesUsecase(c, l, t, tm),
+	}
+}
+
+var (
+	GroupNotFound = errs.NotFound("group_not_found", "group not found")
+	NotGroupOwner = errs.Forbidden("not_group_owner", "only the owner can invite members to the group")
+	UserNotFound  = errs.NotFound("user_not_found", "user not found")
+	AlreadyMember = errs.Conflict("already_member", "user is already a member of the group")
+)
+
+// Create makes a group owned by the user, who joins it with the given consent to sharing.
+func (u GroupUsecase) Create(ctx context.Context, t token.Token, name string, sharing bool) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	g, err := groupModel.NewGroup(-1, name, user, []groupModel.Member{
+		groupModel.NewMember(user, true, sharing),
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.groupRepository.Create(ctx, g)
+}
+
+// GetAll returns the groups the user has joined or been invited to.
+func (u GroupUsecase) GetAll(ctx context.Context, t token.Token) ([]groupModel.Group, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.groupRepository.GetAll(ctx, user)
+}
+
+// Invite invites another user to a group owned by the user.
+func (u GroupUsecase) Invite(ctx context.Context, t token.Token, id int, invitee username.Username) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	g, err := u.find(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	if !g.IsOwner(user) {
+		return NotGroupOwner
+	}
+	if _, ok := g.Member(invitee); ok {
+		return AlreadyMember
+	}
+
+	exist, err := u.loginRepository.Exists(ctx, invitee)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return UserNotFound
+	}
+
+	return u.groupRepository.SetMember(ctx, id, groupModel.NewMember(invitee, false, false))
+}
+
+// Join accepts the invitation to a group, or changes the consent of a member
+// who has already joined it.
+func (u GroupUsecase) Join(ctx context.Context, t token.Token, id int, sharing bool) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	if _, err = u.find(ctx, user, id); err != nil {
+		return err
+	}
+
+	return u.groupRepository.SetMember(ctx, id, groupModel.NewMember(user, true, sharing))
+}
+
+// Leave removes the user from a group, declining the invitation if the user
+// has not joined it yet. A group is removed when its owner leaves it.
+func (u GroupUsecase) Leave(ctx context.Context, t token.Token, id int) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	g, err := u.find(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	if g.IsOwner(user) {
+		return u.groupRepository.Remove(ctx, id)
+	}
+
+	return u.groupRepository.RemoveMember(ctx, id, user)
+}
+
+// LeaveAll removes the groups owned by the user and the memberships of the user.
+func (u GroupUsecase) LeaveAll(ctx context.Context, t token.Token) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.groupRepository.RemoveAll(ctx, user)
+}
+
+// Overlay lays the timetables of the active terms of the members sharing them
+// over each other. Only members who have joined the group can see it.
+// Members without timetables are free in every period.
+func (u GroupUsecase) Overlay(ctx context.Context, t token.Token, id int) (groupModel.Group, []groupModel.Slot, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return groupModel.Group{}, nil, err
+	}
+
+	g, err := u.find(ctx, user, id)
+	if err != nil {
+		return groupModel.Group{}, nil, err
+	}
+	if m, _ := g.Member(user); !m.Joined() {
+		return groupModel.Group{}, nil, GroupNotFound
+	}
+
+	members := make([]username.Username, 0)
+	timetables := make([]timetablesModel.Timetables, 0)
+	for _, m := range g.Members() {
+		if !m.IsSharing() {
+			continue
+		}
+
+		ts, err := u.timetablesUsecase.Of(ctx, m.Username())
+		if err != nil && (errors.Is(err, timetablesUsecase.TimetablesNotFound) ||
+			errors.Is(err, termUsecase.ActiveTermNotFound)) {
+			ts, err = timetablesModel.Timetables{}, nil
+		}
+		if err != nil {
+			return groupModel.Group{}, nil, err
+		}
+
+		members = append(members, m.Username())
+		timetables = append(timetables, ts)
+	}
+
+	return g, groupModel.Overlay(members, timetables), nil
+}
+
+// find returns a group of which the user is a member, including invited ones.
+// Groups of other users are reported as not found.
+func (u GroupUsecase) find(ctx context.Context, user username.Username, id int) (groupModel.Group, error) {
+	exist, err := u.groupRepository.Exists(ctx, id)
+	if err != nil {
+		return groupModel.Group{}, err
+	}
+	if !exist {
+		return groupModel.Group{}, GroupNotFound
+	}
+
+	g, err := u.groupRepository.Get(ctx, id)
+	if err != nil {
+		return groupModel.Group{}, err
+	}
+	if _, ok := g.Member(user); !ok {
+		return groupModel.Group{}, GroupNotFound
+	}
+
+	return g, nil
+}
+
+func (u GroupUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
@@ -0,0 +1,208 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/group"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type repositories struct {
+	credential *mocks.MockICredentialRepository
+	login      *mocks.MockILoginRepository
+	group      *mocks.MockIGroupRepository
+	timetables *mocks.MockITimetablesRepository
+	term       *mocks.MockITermRepository
+}
+
+func newUsecase(ctrl *gomock.Controller) (GroupUsecase, repositories) {
+	r := repositories{
+		mocks.NewMockICredentialRepository(ctrl),
+		mocks.NewMockILoginRepository(ctrl),
+		mocks.NewMockIGroupRepository(ctrl),
+		mocks.NewMockITimetablesRepository(ctrl),
+		mocks.NewMockITermRepository(ctrl),
+	}
+
+	return NewGroupUsecase(r.credential, r.login, r.group, r.timetables, r.term), r
+}
+
+var (
+	owner, _   = username.NewUsername("owner")
+	friend, _  = username.NewUsername("friend")
+	private, _ = username.NewUsername("private")
+	invited, _ = username.NewUsername("invited")
+	ownerToken = token.NewToken("123")
+)
+
+func signedInAs(r repositories, u username.Username) {
+	r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+	r.credential.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(credential.NewAuth(u, ownerToken), nil)
+}
+
+func studyGroup() group.Group {
+	g, _ := group.NewGroup(1, "study", owner, []group.Member{
+		group.NewMember(owner, true, true),
+		group.NewMember(friend, true, true),
+		group.NewMember(private, true, false),
+		group.NewMember(invited, false, true),
+	})
+	return g
+}
+
+func found(r repositories) {
+	r.group.EXPECT().Exists(gomock.Any(), 1).Return(true, nil)
+	r.group.EXPECT().Get(gomock.Any(), 1).Return(studyGroup(), nil)
+}
+
+func TestInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+	newcomer, _ := username.NewUsername("newcomer")
+
+	t.Run("by the owner", func(t *testing.T) {
+		signedInAs(r, owner)
+		found(r)
+		r.login.EXPECT().Exists(gomock.Any(), newcomer).Return(true, nil)
+		r.group.EXPECT().SetMember(gomock.Any(), 1, group.NewMember(newcomer, false, false)).Return(nil)
+
+		if err := usecase.Invite(context.Background(), ownerToken, 1, newcomer); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		user     username.Username
+		invitee  username.Username
+		exists   bool
+		expected error
+	}{
+		{"by a member", friend, newcomer, true, NotGroupOwner},
+		{"a member", owner, friend, true, AlreadyMember},
+		{"unknown user", owner, newcomer, false, UserNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			signedInAs(r, test.user)
+			found(r)
+			if test.expected == UserNotFound {
+				r.login.EXPECT().Exists(gomock.Any(), test.invitee).Return(test.exists, nil)
+			}
+
+			err := usecase.Invite(context.Background(), ownerToken, 1, test.invitee)
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+
+	t.Run("group of others", func(t *testing.T) {
+		stranger, _ := username.NewUsername("stranger")
+		signedInAs(r, stranger)
+		found(r)
+
+		err := usecase.Invite(context.Background(), ownerToken, 1, newcomer)
+		if expected := GroupNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestLeave(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+
+	t.Run("member", func(t *testing.T) {
+		signedInAs(r, friend)
+		found(r)
+		r.group.EXPECT().RemoveMember(gomock.Any(), 1, friend).Return(nil)
+
+		if err := usecase.Leave(context.Background(), ownerToken, 1); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		signedInAs(r, owner)
+		found(r)
+		r.group.EXPECT().Remove(gomock.Any(), 1).Return(nil)
+
+		if err := usecase.Leave(context.Background(), ownerToken, 1); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+}
+
+func TestOverlay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+
+	day := timetables.NewTimetable(
+		timetables.NewClass("A", "101", ""),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	ts := timetables.NewTimetables(day, day, day, day, day)
+
+	t.Run("consenting members only", func(t *testing.T) {
+		signedInAs(r, owner)
+		found(r)
+		r.term.EXPECT().GetAll(gomock.Any(), owner).Return([]term.Term{}, nil)
+		r.timetables.EXPECT().Exists(gomock.Any(), owner, timetablesUsecase.NoTerm).Return(true, nil)
+		r.timetables.EXPECT().Get(gomock.Any(), owner, timetablesUsecase.NoTerm).Return(ts, nil)
+		r.term.EXPECT().GetAll(gomock.Any(), friend).Return([]term.Term{}, nil)
+		r.timetables.EXPECT().Exists(gomock.Any(), friend, timetablesUsecase.NoTerm).Return(false, nil)
+
+		_, slots, err := usecase.Overlay(context.Background(), ownerToken, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+
+		first := slots[0]
+		if len(first.Free()) != 1 || first.Free()[0] != friend || len(first.Busy()) != 1 || first.Busy()[0] != owner {
+			t.Fatalf("unexpected slot: %v %v\n", first.Free(), first.Busy())
+		}
+		if len(slots[1].Free()) != 2 {
+			t.Fatalf("expected: %v; got: %v\n", 2, len(slots[1].Free()))
+		}
+	})
+
+	t.Run("before joining", func(t *testing.T) {
+		signedInAs(r, invited)
+		found(r)
+
+		_, _, err := usecase.Overlay(context.Background(), ownerToken, 1)
+		if expected := GroupNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+
+	t.Run("has no credential", func(t *testing.T) {
+		r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		_, _, err := usecase.Overlay(context.Background(), token.NewToken(""), 1)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
@@ -0,0 +1,371 @@
+package institution
+
+import (
+	"context"
+	"errors"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	institutionModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/institution"
+	templateModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/template"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	institutionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/institution"
+	templateRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/template"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type InstitutionUsecase struct {
+	credentialUsecase     credentialUsecase.CredentialUsecase
+	loginRepository       loginRepository.ILoginRepository
+	institutionRepository institutionRepository.IInstitutionRepository
+	templateRepository    templateRepository.ITemplateRepository
+	timetablesUsecase     timetablesUsecase.TimetablesUsecase
+}
+
+func NewInstitutionUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	i institutionRepository.IInstitutionRepository,
+	tp templateRepository.ITemplateRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) InstitutionUsecase {
+	return InstitutionUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		l,
+		i,
+		tp,
+		timetablesUsecase.NewTimetablesUsecase(c, l, t, tm),
+	}
+}
+
+var (
+	InstitutionNotFound = errs.NotFound("institution_not_found", "institution not found")
+	NotAdmin            = errs.Forbidden("not_admin", "only admins can manage the institution")
+	LastAdmin           = errs.Conflict("last_admin", "the last admin cannot leave the institution while it has other members")
+	UserNotFound        = errs.NotFound("user_not_found", "user not found")
+	TemplateNotFound    = errs.NotFound("template_not_found", "template not found")
+)
+
+// Create makes an institution, of which the user is the first admin.
+func (u InstitutionUsecase) Create(ctx context.Context, t token.Token, name string) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	i, err := institutionModel.NewInstitution(-1, name, []institutionModel.Member{
+		institutionModel.NewMember(user, institutionModel.Admin, true),
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.institutionRepository.Create(ctx, i)
+}
+
+// GetAll returns the institutions the user has joined or been invited to.
+func (u InstitutionUsecase) GetAll(ctx context.Context, t token.Token) ([]institutionModel.Institution, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.institutionRepository.GetAll(ctx, user)
+}
+
+// SetMember invites a user to an institution the user administers with the role, or changes
+// the role of a member. Invited users become members only after they join the institution.
+// The last admin cannot step down to a student.
+func (u InstitutionUsecase) SetMember(ctx context.Context, t token.Token, id int, member username.Username, role institutionModel.Role) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	i, err := u.administered(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	if role != institutionModel.Admin && i.IsLastAdmin(member) {
+		return LastAdmin
+	}
+	if m, ok := i.Member(member); ok {
+		return u.institutionRepository.SetMember(ctx, id, institutionModel.NewMember(member, role, m.Joined()))
+	}
+
+	exist, err := u.loginRepository.Exists(ctx, member)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return UserNotFound
+	}
+
+	return u.institutionRepository.SetMember(ctx, id, institutionModel.NewMember(member, role, false))
+}
+
+// Join accepts the invitation to an institution with the role the admin gave.
+func (u InstitutionUsecase) Join(ctx context.Context, t token.Token, id int) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	i, err := u.find(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	m, _ := i.Member(user)
+	if m.Joined() {
+		return nil
+	}
+
+	return u.institutionRepository.SetMember(ctx, id, institutionModel.NewMember(user, m.Role(), true))
+}
+
+// RemoveMember removes a member from an institution the user administers.
+func (u InstitutionUsecase) RemoveMember(ctx context.Context, t token.Token, id int, member username.Username) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	i, err := u.administered(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	if _, ok := i.Member(member); !ok {
+		return UserNotFound
+	}
+
+	return u.leave(ctx, i, member)
+}
+
+// Leave removes the user from an institution, declining the invitation if the user
+// has not joined it yet.
+func (u InstitutionUsecase) Leave(ctx context.Context, t token.Token, id int) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	i, err := u.find(ctx, user, id)
+	if err != nil {
+		return err
+	}
+
+	return u.leave(ctx, i, user)
+}
+
+// LeaveAll removes the user from every institution. Institutions the user is
+// the last admin of are removed together with their templates.
+func (u InstitutionUsecase) LeaveAll(ctx context.Context, t token.Token) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	is, err := u.institutionRepository.GetAll(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range is {
+		if i.IsLastAdmin(user) {
+			err = u.remove(ctx, i.ID())
+		} else {
+			err = u.institutionRepository.RemoveMember(ctx, i.ID(), user)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// leave removes the member from the institution. An institution is removed
+// when its last member leaves it, while its last admin cannot leave it to the others.
+// Users only invited to the institution are not counted as its members.
+func (u InstitutionUsecase) leave(ctx context.Context, i institutionModel.Institution, member username.Username) error {
+	if i.IsLastMember(member) {
+		return u.remove(ctx, i.ID())
+	}
+	if i.IsLastAdmin(member) {
+		return LastAdmin
+	}
+
+	return u.institutionRepository.RemoveMember(ctx, i.ID(), member)
+}
+
+func (u InstitutionUsecase) remove(ctx context.Context, id int) error {
+	if err := u.templateRepository.RemoveAll(ctx, id); err != nil {
+		return err
+	}
+
+	return u.institutionRepository.Remove(ctx, id)
+}
+
+// SaveTemplate publishes a template to an institution the user administers,
+// or updates it if its ID is not -1.
+func (u InstitutionUsecase) SaveTemplate(ctx context.Context, t token.Token, tmpl templateModel.Template) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	if _, err = u.administered(ctx, user, tmpl.InstitutionID()); err != nil {
+		return err
+	}
+
+	if tmpl.ID() == -1 {
+		return u.templateRepository.Create(ctx, tmpl)
+	}
+
+	old, err := u.template(ctx, tmpl.ID())
+	if err != nil {
+		return err
+	}
+	if old.InstitutionID() != tmpl.InstitutionID() {
+		return TemplateNotFound
+	}
+
+	return u.templateRepository.Update(ctx, tmpl)
+}
+
+// Templates returns the templates of an institution the user has joined.
+func (u InstitutionUsecase) Templates(ctx context.Context, t token.Token, id int) ([]templateModel.Template, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = u.joined(ctx, user, id); err != nil {
+		return nil, err
+	}
+
+	return u.templateRepository.GetAll(ctx, id)
+}
+
+// DeleteTemplate removes a template of an institution the user administers.
+func (u InstitutionUsecase) DeleteTemplate(ctx context.Context, t token.Token, id int) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := u.template(ctx, id)
+	if err != nil {
+		return err
+	}
+	if _, err = u.administered(ctx, user, tmpl.InstitutionID()); err != nil {
+		return err
+	}
+
+	return u.templateRepository.Remove(ctx, id)
+}
+
+// Instantiate registers the timetables of a template of an institution the
+// user has joined as the timetables of the given term, or of the active term
+// if not specified. The timetables replaced are kept as a version.
+func (u InstitutionUsecase) Instantiate(ctx context.Context, t token.Token, id int, term int, specified bool) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := u.template(ctx, id)
+	if err != nil {
+		return err
+	}
+	if _, err = u.joined(ctx, user, tmpl.InstitutionID()); errors.Is(err, InstitutionNotFound) {
+		return TemplateNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if specified {
+		return u.timetablesUsecase.AddToTerm(ctx, t, term, tmpl.Timetables())
+	}
+
+	return u.timetablesUsecase.Add(ctx, t, tmpl.Timetables())
+}
+
+func (u InstitutionUsecase) template(ctx context.Context, id int) (templateModel.Template, error) {
+	exist, err := u.templateRepository.Exists(ctx, id)
+	if err != nil {
+		return templateModel.Template{}, err
+	}
+	if !exist {
+		return templateModel.Template{}, TemplateNotFound
+	}
+
+	return u.templateRepository.Get(ctx, id)
+}
+
+// find returns an institution of which the user is a member, including invited ones.
+// Institutions of other users are reported as not found.
+func (u InstitutionUsecase) find(ctx context.Context, user username.Username, id int) (institutionModel.Institution, error) {
+	exist, err := u.institutionRepository.Exists(ctx, id)
+	if err != nil {
+		return institutionModel.Institution{}, err
+	}
+	if !exist {
+		return institutionModel.Institution{}, InstitutionNotFound
+	}
+
+	i, err := u.institutionRepository.Get(ctx, id)
+	if err != nil {
+		return institutionModel.Institution{}, err
+	}
+	if _, ok := i.Member(user); !ok {
+		return institutionModel.Institution{}, InstitutionNotFound
+	}
+
+	return i, nil
+}
+
+// joined returns an institution the user has joined. Institutions the user has
+// only been invited to are reported as not found.
+func (u InstitutionUsecase) joined(ctx context.Context, user username.Username, id int) (institutionModel.Institution, error) {
+	i, err := u.find(ctx, user, id)
+	if err != nil {
+		return institutionModel.Institution{}, err
+	}
+	if !i.IsJoined(user) {
+		return institutionModel.Institution{}, InstitutionNotFound
+	}
+
+	return i, nil
+}
+
+// administered returns an institution of which the user is an admin.
+func (u InstitutionUsecase) administered(ctx context.Context, user username.Username, id int) (institutionModel.Institution, error) {
+	i, err := u.find(ctx, user, id)
+	if err != nil {
+		return institutionModel.Institution{}, err
+	}
+	if !i.IsAdmin(user) {
+		return institutionModel.Institution{}, NotAdmin
+	}
+
+	return i, nil
+}
+
+func (u InstitutionUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
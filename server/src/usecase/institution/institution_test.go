@@ -0,0 +1,364 @@
+package institution
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/institution"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/template"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+)
+
+type repositories struct {
+	credential  *mocks.MockICredentialRepository
+	login       *mocks.MockILoginRepository
+	institution *mocks.MockIInstitutionRepository
+	template    *mocks.MockITemplateRepository
+	timetables  *mocks.MockITimetablesRepository
+	term        *mocks.MockITermRepository
+}
+
+func newUsecase(ctrl *gomock.Controller) (InstitutionUsecase, repositories) {
+	r := repositories{
+		mocks.NewMockICredentialRepository(ctrl),
+		mocks.NewMockILoginRepository(ctrl),
+		mocks.NewMockIInstitutionRepository(ctrl),
+		mocks.NewMockITemplateRepository(ctrl),
+		mocks.NewMockITimetablesRepository(ctrl),
+		mocks.NewMockITermRepository(ctrl),
+	}
+
+	return NewInstitutionUsecase(r.credential, r.login, r.institution, r.template, r.timetables, r.term), r
+}
+
+var (
+	admin, _    = username.NewUsername("admin")
+	student, _  = username.NewUsername("student")
+	invitee, _  = username.NewUsername("invitee")
+	stranger, _ = username.NewUsername("stranger")
+	adminToken  = token.NewToken("123")
+)
+
+func signedInAs(r repositories, u username.Username) {
+	r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+	r.credential.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(credential.NewAuth(u, adminToken), nil)
+}
+
+func school() institution.Institution {
+	i, _ := institution.NewInstitution(1, "school", []institution.Member{
+		institution.NewMember(admin, institution.Admin, true),
+		institution.NewMember(student, institution.Student, true),
+		institution.NewMember(invitee, institution.Student, false),
+	})
+	return i
+}
+
+func found(r repositories, i institution.Institution) {
+	r.institution.EXPECT().Exists(gomock.Any(), i.ID()).Return(true, nil)
+	r.institution.EXPECT().Get(gomock.Any(), i.ID()).Return(i, nil)
+}
+
+func curriculum() template.Template {
+	day := timetables.NewTimetable(
+		timetables.NewClass("Math", "101", "").WithSubject(3),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	tmpl, _ := template.NewTemplate(2, 1, "first year", "science", 1, timetables.NewTimetables(day, day, day, day, day))
+	return tmpl
+}
+
+func TestCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+
+	signedInAs(r, admin)
+	expected, _ := institution.NewInstitution(-1, "school", []institution.Member{
+		institution.NewMember(admin, institution.Admin, true),
+	})
+	r.institution.EXPECT().Create(gomock.Any(), expected).Return(nil)
+
+	if err := usecase.Create(context.Background(), adminToken, "school"); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+}
+
+func TestSetMember(t *testing.T) {
+	newcomer, _ := username.NewUsername("newcomer")
+
+	tests := []struct {
+		name     string
+		user     username.Username
+		member   username.Username
+		role     institution.Role
+		exists   bool
+		saved    institution.Member
+		expected error
+	}{
+		{"invite", admin, newcomer, institution.Student, true, institution.NewMember(newcomer, institution.Student, false), nil},
+		{"promote", admin, student, institution.Admin, true, institution.NewMember(student, institution.Admin, true), nil},
+		{"promote an invitee", admin, invitee, institution.Admin, true, institution.NewMember(invitee, institution.Admin, false), nil},
+		{"by a student", student, newcomer, institution.Student, true, institution.Member{}, NotAdmin},
+		{"by an invitee", invitee, newcomer, institution.Student, true, institution.Member{}, NotAdmin},
+		{"by a stranger", stranger, newcomer, institution.Student, true, institution.Member{}, InstitutionNotFound},
+		{"last admin steps down", admin, admin, institution.Student, true, institution.Member{}, LastAdmin},
+		{"unknown user", admin, newcomer, institution.Student, false, institution.Member{}, UserNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase, r := newUsecase(ctrl)
+			signedInAs(r, test.user)
+			found(r, school())
+			if test.member == newcomer && (test.expected == nil || test.expected == UserNotFound) {
+				r.login.EXPECT().Exists(gomock.Any(), newcomer).Return(test.exists, nil)
+			}
+			if test.expected == nil {
+				r.institution.EXPECT().SetMember(gomock.Any(), 1, test.saved).Return(nil)
+			}
+
+			err := usecase.SetMember(context.Background(), adminToken, 1, test.member, test.role)
+			if test.expected == nil && err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if test.expected != nil && !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     username.Username
+		saved    bool
+		expected error
+	}{
+		{"invitee", invitee, true, nil},
+		{"member", student, false, nil},
+		{"stranger", stranger, false, InstitutionNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase, r := newUsecase(ctrl)
+			signedInAs(r, test.user)
+			found(r, school())
+			if test.saved {
+				r.institution.EXPECT().SetMember(gomock.Any(), 1, institution.NewMember(test.user, institution.Student, true)).Return(nil)
+			}
+
+			err := usecase.Join(context.Background(), adminToken, 1)
+			if test.expected == nil && err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if test.expected != nil && !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestLeave(t *testing.T) {
+	t.Run("student", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		signedInAs(r, student)
+		found(r, school())
+		r.institution.EXPECT().RemoveMember(gomock.Any(), 1, student).Return(nil)
+
+		if err := usecase.Leave(context.Background(), adminToken, 1); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("last admin with students", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		signedInAs(r, admin)
+		found(r, school())
+
+		err := usecase.Leave(context.Background(), adminToken, 1)
+		if expected := LastAdmin; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+
+	t.Run("invitee declines", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		signedInAs(r, invitee)
+		found(r, school())
+		r.institution.EXPECT().RemoveMember(gomock.Any(), 1, invitee).Return(nil)
+
+		if err := usecase.Leave(context.Background(), adminToken, 1); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("last member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		alone, _ := institution.NewInstitution(1, "school", []institution.Member{
+			institution.NewMember(admin, institution.Admin, true),
+			institution.NewMember(invitee, institution.Student, false),
+		})
+		signedInAs(r, admin)
+		found(r, alone)
+		r.template.EXPECT().RemoveAll(gomock.Any(), 1).Return(nil)
+		r.institution.EXPECT().Remove(gomock.Any(), 1).Return(nil)
+
+		if err := usecase.Leave(context.Background(), adminToken, 1); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+}
+
+func TestLeaveAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+
+	shared, _ := institution.NewInstitution(4, "shared", []institution.Member{
+		institution.NewMember(admin, institution.Admin, true),
+		institution.NewMember(student, institution.Admin, true),
+	})
+	signedInAs(r, admin)
+	r.institution.EXPECT().GetAll(gomock.Any(), admin).Return([]institution.Institution{school(), shared}, nil)
+	r.template.EXPECT().RemoveAll(gomock.Any(), 1).Return(nil)
+	r.institution.EXPECT().Remove(gomock.Any(), 1).Return(nil)
+	r.institution.EXPECT().RemoveMember(gomock.Any(), 4, admin).Return(nil)
+
+	if err := usecase.LeaveAll(context.Background(), adminToken); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+}
+
+func TestSaveTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     username.Username
+		id       int
+		stored   int
+		expected error
+	}{
+		{"create", admin, -1, 0, nil},
+		{"update", admin, 2, 1, nil},
+		{"by a student", student, -1, 0, NotAdmin},
+		{"template of another institution", admin, 2, 5, TemplateNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase, r := newUsecase(ctrl)
+			tmpl, _ := template.NewTemplate(test.id, 1, "first year", "", 0, curriculum().Timetables())
+			signedInAs(r, test.user)
+			found(r, school())
+			if test.id == -1 && test.expected == nil {
+				r.template.EXPECT().Create(gomock.Any(), tmpl).Return(nil)
+			}
+			if test.id != -1 {
+				stored, _ := template.NewTemplate(test.id, test.stored, "old", "", 0, curriculum().Timetables())
+				r.template.EXPECT().Exists(gomock.Any(), test.id).Return(true, nil)
+				r.template.EXPECT().Get(gomock.Any(), test.id).Return(stored, nil)
+			}
+			if test.id != -1 && test.expected == nil {
+				r.template.EXPECT().Update(gomock.Any(), tmpl).Return(nil)
+			}
+
+			err := usecase.SaveTemplate(context.Background(), adminToken, tmpl)
+			if test.expected == nil && err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if test.expected != nil && !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestInstantiate(t *testing.T) {
+	t.Run("into the active term", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		tmpl := curriculum()
+		signedInAs(r, student)
+		r.template.EXPECT().Exists(gomock.Any(), 2).Return(true, nil)
+		r.template.EXPECT().Get(gomock.Any(), 2).Return(tmpl, nil)
+		found(r, school())
+		signedInAs(r, student)
+		r.term.EXPECT().GetAll(gomock.Any(), student).Return([]term.Term{}, nil)
+		r.timetables.EXPECT().Exists(gomock.Any(), student, timetablesUsecase.NoTerm).Return(true, nil)
+		r.timetables.EXPECT().Delete(gomock.Any(), student, timetablesUsecase.NoTerm).Return(nil)
+		r.timetables.EXPECT().Create(gomock.Any(), student, timetablesUsecase.NoTerm, tmpl.Timetables()).Return(nil)
+
+		if err := usecase.Instantiate(context.Background(), adminToken, 2, 0, false); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("template of an institution not joined", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		signedInAs(r, invitee)
+		r.template.EXPECT().Exists(gomock.Any(), 2).Return(true, nil)
+		r.template.EXPECT().Get(gomock.Any(), 2).Return(curriculum(), nil)
+		found(r, school())
+
+		err := usecase.Instantiate(context.Background(), adminToken, 2, 0, false)
+		if expected := TemplateNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+
+	t.Run("template of others", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		signedInAs(r, stranger)
+		r.template.EXPECT().Exists(gomock.Any(), 2).Return(true, nil)
+		r.template.EXPECT().Get(gomock.Any(), 2).Return(curriculum(), nil)
+		found(r, school())
+
+		err := usecase.Instantiate(context.Background(), adminToken, 2, 0, false)
+		if expected := TemplateNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
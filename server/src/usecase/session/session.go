@@ -0,0 +1,122 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sessionModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/session"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	bellRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/bell"
+	examRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exam"
+	exceptionRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/exception"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	taskRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/task"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	bellUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/bell"
+	examUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/exam"
+	exceptionUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/exception"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type SessionUsecase struct {
+	credentialUsecase credentialUsecase.CredentialUsecase
+	exceptionUsecase  exceptionUsecase.ExceptionUsecase
+	bellUsecase       bellUsecase.BellUsecase
+	examUsecase       examUsecase.ExamUsecase
+	taskRepository    taskRepository.ITaskRepository
+	now               func() time.Time
+}
+
+func NewSessionUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	e exceptionRepository.IExceptionRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+	b bellRepository.IBellRepository,
+	tk taskRepository.ITaskRepository,
+	x examRepository.IExamRepository,
+	s subjectRepository.ISubjectRepository,
+) SessionUsecase {
+	return SessionUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		exceptionUsecase.NewExceptionUsecase(c, l, e, t, tm),
+		bellUsecase.NewBellUsecase(c, l, b),
+		examUsecase.NewExamUsecase(c, l, x, s, tm, b),
+		tk,
+		time.Now,
+	}
+}
+
+const (
+	// LookAhead is the number of days after today searched for the next session.
+	LookAhead = 7
+)
+
+// Now returns the current and the next sessions of the user in the time zone
+// of the bell schedule, with the exceptions applied and the exams in place of
+// the classes they overlap. Days without timetables, such as those between
+// terms, have no classes but still have their exams.
+func (u SessionUsecase) Now(ctx context.Context, t token.Token) (sessionModel.Now, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return sessionModel.Now{}, err
+	}
+
+	schedule, err := u.bellUsecase.Of(ctx, user)
+	if err != nil {
+		return sessionModel.Now{}, err
+	}
+
+	exams, err := u.examUsecase.Of(ctx, user)
+	if err != nil {
+		return sessionModel.Now{}, err
+	}
+
+	at := u.now().In(schedule.Location())
+	sessions := make([]sessionModel.Session, 0)
+	for i := 0; i <= LookAhead; i++ {
+		local := at.AddDate(0, 0, i)
+		date := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+
+		day, err := u.exceptionUsecase.EffectiveDay(ctx, t, date)
+		if err != nil && (errors.Is(err, timetablesUsecase.TimetablesNotFound) ||
+			errors.Is(err, termUsecase.TermNotFound)) {
+			day, err = timetablesModel.EmptyTimetable(), nil
+		}
+		if err != nil {
+			return sessionModel.Now{}, err
+		}
+
+		sessions = append(sessions, sessionModel.Of(schedule, date, day, exams)...)
+		if _, found := sessionModel.NewNow(at, sessions, nil).Next(); found {
+			break
+		}
+	}
+
+	tasks, err := u.taskRepository.GetAll(ctx, user)
+	if err != nil {
+		return sessionModel.Now{}, err
+	}
+
+	return sessionModel.NewNow(at, sessions, tasks), nil
+}
+
+func (u SessionUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
@@ -0,0 +1,226 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exam"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/exception"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type repositories struct {
+	credential *mocks.MockICredentialRepository
+	login      *mocks.MockILoginRepository
+	exception  *mocks.MockIExceptionRepository
+	timetables *mocks.MockITimetablesRepository
+	term       *mocks.MockITermRepository
+	bell       *mocks.MockIBellRepository
+	task       *mocks.MockITaskRepository
+	exam       *mocks.MockIExamRepository
+	subject    *mocks.MockISubjectRepository
+}
+
+func newUsecase(ctrl *gomock.Controller) (SessionUsecase, repositories) {
+	r := repositories{
+		mocks.NewMockICredentialRepository(ctrl),
+		mocks.NewMockILoginRepository(ctrl),
+		mocks.NewMockIExceptionRepository(ctrl),
+		mocks.NewMockITimetablesRepository(ctrl),
+		mocks.NewMockITermRepository(ctrl),
+		mocks.NewMockIBellRepository(ctrl),
+		mocks.NewMockITaskRepository(ctrl),
+		mocks.NewMockIExamRepository(ctrl),
+		mocks.NewMockISubjectRepository(ctrl),
+	}
+
+	return NewSessionUsecase(
+		r.credential,
+		r.login,
+		r.exception,
+		r.timetables,
+		r.term,
+		r.bell,
+		r.task,
+		r.exam,
+		r.subject,
+	), r
+}
+
+var (
+	user, _   = username.NewUsername("user")
+	userToken = token.NewToken("123")
+	auth      = credential.NewAuth(user, userToken)
+)
+
+// at returns a moment of 2020-04-08 (Wednesday) in Japan.
+func at(hour, min int) func() time.Time {
+	return func() time.Time {
+		return time.Date(2020, time.April, 8, hour, min, 0, 0, time.FixedZone("JST", 9*60*60))
+	}
+}
+
+func TestNow(t *testing.T) {
+	wed := timetables.NewTimetable(
+		timetables.NewClass("A", "101", ""),
+		timetables.NoClass(),
+		timetables.NewClass("B", "201", ""),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	thu := timetables.NewTimetable(
+		timetables.NoClass(),
+		timetables.NewClass("C", "301", ""),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	empty := timetables.NewTimetable(
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	ts := timetables.NewTimetables(empty, empty, wed, thu, empty)
+
+	reportA, _ := task.NewTask(1, "2020-04-08", "A report")
+	reportC, _ := task.NewTask(2, "2020-04-08", "C report")
+	tomorrow, _ := task.NewTask(3, "2020-04-09", "B report")
+	cancelled, _ := exception.NewCancellation(1, "2020-04-08", 3)
+	final, _ := exam.NewExam(1, 2, 0, "Final", at(13, 0)(), at(14, 0)(), "Hall")
+
+	registered := func(r repositories, es []exception.Exception, xs []exam.Exam) {
+		r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+		r.credential.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil).AnyTimes()
+		r.bell.EXPECT().Exists(gomock.Any(), user).Return(false, nil).Times(2)
+		r.exam.EXPECT().GetAll(gomock.Any(), user).Return(xs, nil)
+		r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{}, nil).AnyTimes()
+		r.timetables.EXPECT().Exists(gomock.Any(), user, timetablesUsecase.NoTerm).Return(true, nil).AnyTimes()
+		r.timetables.EXPECT().Get(gomock.Any(), user, timetablesUsecase.NoTerm).Return(ts, nil).AnyTimes()
+		r.exception.EXPECT().GetAll(gomock.Any(), user).Return(es, nil).AnyTimes()
+		r.task.EXPECT().GetAll(gomock.Any(), user).Return([]task.Task{reportA, reportC, tomorrow}, nil)
+	}
+
+	tests := []struct {
+		name       string
+		now        func() time.Time
+		exceptions []exception.Exception
+		exams      []exam.Exam
+		current    string
+		next       string
+		nextDate   string
+		minutes    int
+		tasks      int
+		nextIsExam bool
+	}{
+		{"during a class", at(9, 50), nil, nil, "A", "B", "2020-04-08", 190, 1, false},
+		{"between classes", at(12, 59), nil, nil, "", "B", "2020-04-08", 1, 0, false},
+		{"next is cancelled", at(9, 50), []exception.Exception{cancelled}, nil, "A", "C", "2020-04-09", 1490, 2, false},
+		{"after school", at(18, 0), nil, nil, "", "C", "2020-04-09", 1000, 1, false},
+		{"next is an exam", at(9, 50), nil, []exam.Exam{final.WithSubject("B")}, "A", "B", "2020-04-08", 190, 1, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase, r := newUsecase(ctrl)
+			usecase.now = test.now
+			registered(r, test.exceptions, test.exams)
+
+			n, err := usecase.Now(context.Background(), userToken)
+			if err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+
+			current, ok := n.Current()
+			if (test.current != "") != ok || current.Class().Subject() != test.current {
+				t.Fatalf("expected: %v; got: %v\n", test.current, current.Class().Subject())
+			}
+
+			next, _ := n.Next()
+			if next.Class().Subject() != test.next || next.Date().Format("2006-01-02") != test.nextDate {
+				t.Fatalf("expected: %v %v; got: %v %v\n", test.next, test.nextDate, next.Class().Subject(), next.Date())
+			}
+			if _, isExam := next.Exam(); isExam != test.nextIsExam {
+				t.Fatalf("expected: %v; got: %v\n", test.nextIsExam, isExam)
+			}
+
+			minutes, _ := n.MinutesUntilNext()
+			if minutes != test.minutes {
+				t.Fatalf("expected: %v; got: %v\n", test.minutes, minutes)
+			}
+
+			if len(n.Tasks()) != test.tasks {
+				t.Fatalf("expected: %v; got: %v\n", test.tasks, n.Tasks())
+			}
+		})
+	}
+
+	t.Run("has no credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		usecase, r := newUsecase(ctrl)
+		r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		_, err := usecase.Now(context.Background(), token.NewToken(""))
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestNowWithoutTimetables(t *testing.T) {
+	fall, _ := term.NewTerm(1, "fall", "2020-10-01", "2021-03-31")
+	final, _ := exam.NewExam(1, 2, 0, "Final", at(13, 0)(), at(14, 0)(), "Hall")
+
+	tests := []struct {
+		name  string
+		terms []term.Term
+	}{
+		{"no term on the day", []term.Term{fall}},
+		{"no timetables", []term.Term{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase, r := newUsecase(ctrl)
+			usecase.now = at(9, 50)
+			r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+			r.credential.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil).AnyTimes()
+			r.bell.EXPECT().Exists(gomock.Any(), user).Return(false, nil).Times(2)
+			r.exam.EXPECT().GetAll(gomock.Any(), user).Return([]exam.Exam{final.WithSubject("B")}, nil)
+			r.term.EXPECT().GetAll(gomock.Any(), user).Return(test.terms, nil).AnyTimes()
+			r.timetables.EXPECT().Exists(gomock.Any(), user, timetablesUsecase.NoTerm).Return(false, nil).AnyTimes()
+			r.exception.EXPECT().GetAll(gomock.Any(), user).Return([]exception.Exception{}, nil).AnyTimes()
+			r.task.EXPECT().GetAll(gomock.Any(), user).Return([]task.Task{}, nil)
+
+			n, err := usecase.Now(context.Background(), userToken)
+			if err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+
+			next, found := n.Next()
+			if _, isExam := next.Exam(); !found || !isExam || next.Date().Format("2006-01-02") != "2020-04-08" {
+				t.Fatalf("expected the exam on %v; got: %v %v\n", "2020-04-08", next, found)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package share
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	shareModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/share"
+	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	shareRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/share"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type ShareUsecase struct {
+	credentialUsecase credentialUsecase.CredentialUsecase
+	shareRepository   shareRepository.IShareRepository
+	timetablesUsecase timetablesUsecase.TimetablesUsecase
+	now               func() time.Time
+}
+
+func NewShareUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	s shareRepository.IShareRepository,
+	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
+) ShareUsecase {
+	return ShareUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		s,
+		timetablesUsecase.NewTimetablesUsecase(c, l, t, tm),
+		time.Now,
+	}
+}
+
+var (
+	ShareNotFound = errs.NotFound("share_not_found", "share link not found")
+	ShareExpired  = errs.Gone("share_expired", "share link has expired")
+	ExpiryInPast  = errs.Invalid("expiry_in_past", "share link must expire in the future")
+)
+
+// Create issues a new share link of the timetables of the user.
+// A zero expires means the link never expires.
+func (u ShareUsecase) Create(ctx context.Context, t token.Token, v shareModel.Visibility, expires time.Time) (shareModel.Share, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return shareModel.Share{}, err
+	}
+
+	if !expires.IsZero() && !expires.After(u.now()) {
+		return shareModel.Share{}, ExpiryInPast
+	}
+
+	secret, err := token.GenToken()
+	if err != nil {
+		return shareModel.Share{}, err
+	}
+
+	err = u.shareRepository.Create(ctx, shareModel.NewShare(-1, user, secret, v, expires))
+	if err != nil {
+		return shareModel.Share{}, err
+	}
+
+	return u.shareRepository.GetByToken(ctx, secret)
+}
+
+// GetAll returns the share links of the user, including expired ones.
+func (u ShareUsecase) GetAll(ctx context.Context, t token.Token) ([]shareModel.Share, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.shareRepository.GetAll(ctx, user)
+}
+
+// Revoke removes a share link of the user so that it stops working.
+func (u ShareUsecase) Revoke(ctx context.Context, t token.Token, id int) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	shares, err := u.shareRepository.GetAll(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range shares {
+		if s.ID() == id {
+			return u.shareRepository.Remove(ctx, user, id)
+		}
+	}
+
+	return ShareNotFound
+}
+
+func (u ShareUsecase) RevokeAll(ctx context.Context, t token.Token) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.shareRepository.RemoveAll(ctx, user)
+}
+
+// Shared returns the timetables of the share link whose secret token is t,
+// with the fields hidden by its owner removed.
+func (u ShareUsecase) Shared(ctx context.Context, t token.Token) (shareModel.Share, timetablesModel.Timetables, error) {
+	exist, err := u.shareRepository.Exists(ctx, t)
+	if err != nil {
+		return shareModel.Share{}, timetablesModel.Timetables{}, err
+	}
+	if !exist {
+		return shareModel.Share{}, timetablesModel.Timetables{}, ShareNotFound
+	}
+
+	s, err := u.shareRepository.GetByToken(ctx, t)
+	if err != nil {
+		return shareModel.Share{}, timetablesModel.Timetables{}, err
+	}
+	if s.IsExpired(u.now()) {
+		return shareModel.Share{}, timetablesModel.Timetables{}, ShareExpired
+	}
+
+	ts, err := u.timetablesUsecase.Of(ctx, s.Username())
+	if err != nil {
+		return shareModel.Share{}, timetablesModel.Timetables{}, err
+	}
+
+	return s, s.Visibility().Apply(ts), nil
+}
+
+func (u ShareUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
@@ -0,0 +1,201 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/share"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	timetablesUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/timetables"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type repositories struct {
+	credential *mocks.MockICredentialRepository
+	login      *mocks.MockILoginRepository
+	share      *mocks.MockIShareRepository
+	timetables *mocks.MockITimetablesRepository
+	term       *mocks.MockITermRepository
+}
+
+func newUsecase(ctrl *gomock.Controller) (ShareUsecase, repositories) {
+	r := repositories{
+		mocks.NewMockICredentialRepository(ctrl),
+		mocks.NewMockILoginRepository(ctrl),
+		mocks.NewMockIShareRepository(ctrl),
+		mocks.NewMockITimetablesRepository(ctrl),
+		mocks.NewMockITermRepository(ctrl),
+	}
+
+	u := NewShareUsecase(r.credential, r.login, r.share, r.timetables, r.term)
+	u.now = func() time.Time {
+		return time.Date(2020, time.April, 8, 12, 0, 0, 0, time.UTC)
+	}
+
+	return u, r
+}
+
+var (
+	user, _   = username.NewUsername("user")
+	userToken = token.NewToken("123")
+	auth      = credential.NewAuth(user, userToken)
+	secret    = token.NewToken("secret")
+)
+
+func signedIn(r repositories) {
+	r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+	r.credential.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+}
+
+func TestCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+	v := share.NewVisibility(true, false)
+
+	t.Run("never expires", func(t *testing.T) {
+		signedIn(r)
+		var created share.Share
+		r.share.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s share.Share) error {
+			created = s
+			return nil
+		})
+		r.share.EXPECT().GetByToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t token.Token) (share.Share, error) {
+			return share.NewShare(1, user, t, v, time.Time{}), nil
+		})
+
+		s, err := usecase.Create(context.Background(), userToken, v, time.Time{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if created.ID() != -1 || len(created.Token().Token()) != token.Length || created.Token() == userToken {
+			t.Fatalf("unexpected share: %v\n", created)
+		}
+		if s.ID() != 1 || s.Token() != created.Token() {
+			t.Fatalf("expected: %v; got: %v\n", created.Token(), s.Token())
+		}
+	})
+
+	t.Run("expires in the past", func(t *testing.T) {
+		signedIn(r)
+
+		_, err := usecase.Create(context.Background(), userToken, v, time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC))
+		if expected := ExpiryInPast; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+
+	t.Run("has no credential", func(t *testing.T) {
+		r.credential.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		_, err := usecase.Create(context.Background(), token.NewToken(""), v, time.Time{})
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestRevoke(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+	s := share.NewShare(1, user, secret, share.NewVisibility(true, true), time.Time{})
+
+	t.Run("own link", func(t *testing.T) {
+		signedIn(r)
+		r.share.EXPECT().GetAll(gomock.Any(), user).Return([]share.Share{s}, nil)
+		r.share.EXPECT().Remove(gomock.Any(), user, 1).Return(nil)
+
+		if err := usecase.Revoke(context.Background(), userToken, 1); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("link of another user", func(t *testing.T) {
+		signedIn(r)
+		r.share.EXPECT().GetAll(gomock.Any(), user).Return([]share.Share{s}, nil)
+
+		err := usecase.Revoke(context.Background(), userToken, 2)
+		if expected := ShareNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestShared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, r := newUsecase(ctrl)
+
+	day := timetables.NewTimetable(
+		timetables.NewClass("A", "101", "https://zoom.us/j/1"),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+		timetables.NoClass(),
+	)
+	ts := timetables.NewTimetables(day, day, day, day, day)
+
+	t.Run("hides memos", func(t *testing.T) {
+		s := share.NewShare(1, user, secret, share.NewVisibility(true, false), time.Time{})
+		r.share.EXPECT().Exists(gomock.Any(), secret).Return(true, nil)
+		r.share.EXPECT().GetByToken(gomock.Any(), secret).Return(s, nil)
+		r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{}, nil)
+		r.timetables.EXPECT().Exists(gomock.Any(), user, timetablesUsecase.NoTerm).Return(true, nil)
+		r.timetables.EXPECT().Get(gomock.Any(), user, timetablesUsecase.NoTerm).Return(ts, nil)
+
+		_, shared, err := usecase.Shared(context.Background(), secret)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+
+		c := shared.Mon().First().Classes()[0]
+		if c.Subject() != "A" || c.Room() != "101" || c.Memo() != "" {
+			t.Fatalf("unexpected class: %v\n", c)
+		}
+	})
+
+	t.Run("no timetables", func(t *testing.T) {
+		s := share.NewShare(1, user, secret, share.NewVisibility(true, true), time.Time{})
+		r.share.EXPECT().Exists(gomock.Any(), secret).Return(true, nil)
+		r.share.EXPECT().GetByToken(gomock.Any(), secret).Return(s, nil)
+		r.term.EXPECT().GetAll(gomock.Any(), user).Return([]term.Term{}, nil)
+		r.timetables.EXPECT().Exists(gomock.Any(), user, timetablesUsecase.NoTerm).Return(false, nil)
+
+		_, _, err := usecase.Shared(context.Background(), secret)
+		if expected := timetablesUsecase.TimetablesNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		s := share.NewShare(1, user, secret, share.NewVisibility(true, true), usecase.now())
+		r.share.EXPECT().Exists(gomock.Any(), secret).Return(true, nil)
+		r.share.EXPECT().GetByToken(gomock.Any(), secret).Return(s, nil)
+
+		_, _, err := usecase.Shared(context.Background(), secret)
+		if expected := ShareExpired; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		r.share.EXPECT().Exists(gomock.Any(), secret).Return(false, nil)
+
+		_, _, err := usecase.Shared(context.Background(), secret)
+		if expected := ShareNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package subject
+
+import (
+	"context"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	subjectModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	subjectRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/subject"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type SubjectUsecase struct {
+	credentialUsecase credentialUsecase.CredentialUsecase
+	subjectRepository subjectRepository.ISubjectRepository
+}
+
+func NewSubjectUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	s subjectRepository.ISubjectRepository,
+) SubjectUsecase {
+	return SubjectUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		s,
+	}
+}
+
+var (
+	SubjectNotFound      = errs.NotFound("subject_not_found", "subject not found")
+	SubjectAlreadyExists = errs.Conflict("subject_already_exists", "subject already exists")
+)
+
+// Save adds the subject to the catalog if its ID is -1, or updates the
+// subject of the ID otherwise. Renaming a subject renames the classes of
+// every slot referring to it.
+func (u SubjectUsecase) Save(ctx context.Context, t token.Token, s subjectModel.Subject) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	subjects, err := u.subjectRepository.GetAll(ctx, user)
+	if err != nil {
+		return err
+	}
+	for _, o := range subjects {
+		if o.Name() == s.Name() && o.ID() != s.ID() {
+			return SubjectAlreadyExists
+		}
+	}
+
+	if s.ID() == -1 {
+		return u.subjectRepository.Create(ctx, user, s)
+	}
+
+	exists, err := u.subjectRepository.Exists(ctx, user, s.ID())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return SubjectNotFound
+	}
+
+	return u.subjectRepository.Update(ctx, user, s)
+}
+
+func (u SubjectUsecase) GetAll(ctx context.Context, t token.Token) ([]subjectModel.Subject, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.subjectRepository.GetAll(ctx, user)
+}
+
+// Delete removes the subject from the catalog. Classes referring to it stay
+// in the timetables under its name.
+func (u SubjectUsecase) Delete(ctx context.Context, t token.Token, id int) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	exists, err := u.subjectRepository.Exists(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return SubjectNotFound
+	}
+
+	return u.subjectRepository.Remove(ctx, user, id)
+}
+
+func (u SubjectUsecase) DeleteAll(ctx context.Context, t token.Token) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.subjectRepository.RemoveAll(ctx, user)
+}
+
+func (u SubjectUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
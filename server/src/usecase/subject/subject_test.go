@@ -0,0 +1,125 @@
+package subject
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	subjectModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/subject"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+var (
+	user, _   = username.NewUsername("user")
+	userToken = token.NewToken("123")
+)
+
+func signedIn(c *mocks.MockICredentialRepository) {
+	c.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+	c.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(credential.NewAuth(user, userToken), nil)
+}
+
+func TestSave(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mocks.NewMockICredentialRepository(ctrl)
+	s := mocks.NewMockISubjectRepository(ctrl)
+	usecase := NewSubjectUsecase(c, mocks.NewMockILoginRepository(ctrl), s)
+
+	algebra, _ := subjectModel.NewSubject(1, "Algebra", "", 2, "", "", "")
+	catalog := []subjectModel.Subject{algebra}
+
+	t.Run("new subject", func(t *testing.T) {
+		geometry, _ := subjectModel.NewSubject(-1, "Geometry", "", 2, "", "", "")
+		signedIn(c)
+		s.EXPECT().GetAll(gomock.Any(), user).Return(catalog, nil)
+		s.EXPECT().Create(gomock.Any(), user, geometry).Return(nil)
+
+		if err := usecase.Save(context.Background(), userToken, geometry); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		renamed, _ := subjectModel.NewSubject(1, "Linear Algebra", "", 2, "", "", "")
+		signedIn(c)
+		s.EXPECT().GetAll(gomock.Any(), user).Return(catalog, nil)
+		s.EXPECT().Exists(gomock.Any(), user, 1).Return(true, nil)
+		s.EXPECT().Update(gomock.Any(), user, renamed).Return(nil)
+
+		if err := usecase.Save(context.Background(), userToken, renamed); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		id       int
+		subject  string
+		exists   bool
+		expected error
+	}{
+		{"duplicated name", -1, "Algebra", false, SubjectAlreadyExists},
+		{"subject of others", 2, "Geometry", false, SubjectNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			subject, _ := subjectModel.NewSubject(test.id, test.subject, "", 2, "", "", "")
+			signedIn(c)
+			s.EXPECT().GetAll(gomock.Any(), user).Return(catalog, nil)
+			if test.expected == SubjectNotFound {
+				s.EXPECT().Exists(gomock.Any(), user, test.id).Return(test.exists, nil)
+			}
+
+			err := usecase.Save(context.Background(), userToken, subject)
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected: %v; got: %v\n", test.expected, err)
+			}
+		})
+	}
+
+	t.Run("has no credential", func(t *testing.T) {
+		c.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		err := usecase.Save(context.Background(), token.NewToken(""), algebra)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mocks.NewMockICredentialRepository(ctrl)
+	s := mocks.NewMockISubjectRepository(ctrl)
+	usecase := NewSubjectUsecase(c, mocks.NewMockILoginRepository(ctrl), s)
+
+	t.Run("own subject", func(t *testing.T) {
+		signedIn(c)
+		s.EXPECT().Exists(gomock.Any(), user, 1).Return(true, nil)
+		s.EXPECT().Remove(gomock.Any(), user, 1).Return(nil)
+
+		if err := usecase.Delete(context.Background(), userToken, 1); err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("subject of others", func(t *testing.T) {
+		signedIn(c)
+		s.EXPECT().Exists(gomock.Any(), user, 2).Return(false, nil)
+
+		err := usecase.Delete(context.Background(), userToken, 2)
+		if expected := SubjectNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
@@ -1,8 +1,9 @@
 package task
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 	taskModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/task"
 	tokenModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
@@ -27,76 +28,76 @@ func NewTaskUsecase(c credentialRepository.ICredentialRepository,
 	}
 }
 
-const (
-	IDIsNotZero = "ID is not zero"
-	InvalidID   = "Invalid ID"
+var (
+	IDIsNotZero = errs.Invalid("id_is_not_zero", "ID is not zero")
+	InvalidID   = errs.Invalid("invalid_id", "Invalid ID")
 )
 
-func (u TaskUsecase) Add(token tokenModel.Token, task taskModel.Task) error {
-	credentialed, err := u.credentialUsecase.HasCredential(token)
+func (u TaskUsecase) Add(ctx context.Context, token tokenModel.Token, task taskModel.Task) error {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
 	if err != nil {
 		return err
 	}
 	if !credentialed {
-		return fmt.Errorf(credentialUsecase.InvalidToken)
+		return credentialUsecase.InvalidToken
 	}
 
-	user, err := u.credentialUsecase.Whose(token)
+	user, err := u.credentialUsecase.Whose(ctx, token)
 	if err != nil {
 		return err
 	}
 
-	return u.taskRepository.Create(user, task)
+	return u.taskRepository.Create(ctx, user, task)
 }
 
-func (u TaskUsecase) Delete(token tokenModel.Token, id int) error {
-	credentialed, err := u.credentialUsecase.HasCredential(token)
+func (u TaskUsecase) Delete(ctx context.Context, token tokenModel.Token, id int) error {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
 	if err != nil {
 		return err
 	}
 	if !credentialed {
-		return fmt.Errorf(credentialUsecase.InvalidToken)
+		return credentialUsecase.InvalidToken
 	}
 
 	if id == 0 {
-		return fmt.Errorf(IDIsNotZero)
+		return IDIsNotZero
 	}
 	if id < 0 {
-		return fmt.Errorf(InvalidID)
+		return InvalidID
 	}
 
-	user, err := u.credentialUsecase.Whose(token)
+	user, err := u.credentialUsecase.Whose(ctx, token)
 	if err != nil {
 		return err
 	}
 
-	tasks, err := u.taskRepository.GetAll(user)
+	tasks, err := u.taskRepository.GetAll(ctx, user)
 	if err != nil {
 		return err
 	}
 
 	if !isValidID(user, id, tasks) {
-		return fmt.Errorf(InvalidID)
+		return InvalidID
 	}
 
-	return u.taskRepository.Remove(user, id)
+	return u.taskRepository.Remove(ctx, user, id)
 }
 
-func (u TaskUsecase) DeleteAll(token tokenModel.Token) error {
-	credentialed, err := u.credentialUsecase.HasCredential(token)
+func (u TaskUsecase) DeleteAll(ctx context.Context, token tokenModel.Token) error {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
 	if err != nil {
 		return err
 	}
 	if !credentialed {
-		return fmt.Errorf(credentialUsecase.InvalidToken)
+		return credentialUsecase.InvalidToken
 	}
 
-	user, err := u.credentialUsecase.Whose(token)
+	user, err := u.credentialUsecase.Whose(ctx, token)
 	if err != nil {
 		return err
 	}
 
-	return u.taskRepository.RemoveAll(user)
+	return u.taskRepository.RemoveAll(ctx, user)
 }
 
 func isValidID(username username.Username, id int, tasks []taskModel.Task) bool {
@@ -109,19 +110,19 @@ func isValidID(username username.Username, id int, tasks []taskModel.Task) bool
 	return false
 }
 
-func (u TaskUsecase) GetAll(token tokenModel.Token) ([]taskModel.Task, error) {
-	credentialed, err := u.credentialUsecase.HasCredential(token)
+func (u TaskUsecase) GetAll(ctx context.Context, token tokenModel.Token) ([]taskModel.Task, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
 	if err != nil {
 		return nil, err
 	}
 	if !credentialed {
-		return nil, fmt.Errorf(credentialUsecase.InvalidToken)
+		return nil, credentialUsecase.InvalidToken
 	}
 
-	user, err := u.credentialUsecase.Whose(token)
+	user, err := u.credentialUsecase.Whose(ctx, token)
 	if err != nil {
 		return nil, err
 	}
 
-	return u.taskRepository.GetAll(user)
+	return u.taskRepository.GetAll(ctx, user)
 }
@@ -1,6 +1,8 @@
 package task
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -27,28 +29,28 @@ func TestAdd(t *testing.T) {
 	)
 
 	t.Run("success", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
 		username, _ := username.NewUsername("user")
 		userToken := token.NewToken("123")
 		auth := credential.NewAuth(username, userToken)
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
 
-		taskRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		taskRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 		task, _ := task.NewTask(0, "2020-10-10", "")
-		err := usecase.Add(token.NewToken(""), task)
+		err := usecase.Add(context.Background(), token.NewToken(""), task)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
 	})
 
 	t.Run("has no credential", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
 		task, _ := task.NewTask(0, "2020-10-10", "")
-		err := usecase.Add(token.NewToken(""), task)
-		if expected := credentialUsecase.InvalidToken; err.Error() != expected {
+		err := usecase.Add(context.Background(), token.NewToken(""), task)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -73,67 +75,67 @@ func TestDelete(t *testing.T) {
 	task3, _ := task.NewTask(3, "2020-01-01", "3")
 
 	t.Run("success", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
 		username, _ := username.NewUsername("user")
 		userToken := token.NewToken("123")
 		auth := credential.NewAuth(username, userToken)
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
 
-		taskRepository.EXPECT().GetAll(gomock.Any()).Return(
+		taskRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(
 			[]task.Task{task1, task2, task3},
 			nil,
 		)
-		taskRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+		taskRepository.EXPECT().Remove(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-		err := usecase.Delete(token.NewToken(""), 1)
+		err := usecase.Delete(context.Background(), token.NewToken(""), 1)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
 	})
 
 	t.Run("has no credential", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		err := usecase.Delete(token.NewToken(""), 1)
-		if expected := credentialUsecase.InvalidToken; err.Error() != expected {
+		err := usecase.Delete(context.Background(), token.NewToken(""), 1)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
 
 	t.Run("with id 0", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
-		err := usecase.Delete(token.NewToken(""), 0)
-		if expected := IDIsNotZero; err.Error() != expected {
+		err := usecase.Delete(context.Background(), token.NewToken(""), 0)
+		if expected := IDIsNotZero; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
 
 	t.Run("with negative id", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
-		err := usecase.Delete(token.NewToken(""), -1)
-		if expected := InvalidID; err.Error() != expected {
+		err := usecase.Delete(context.Background(), token.NewToken(""), -1)
+		if expected := InvalidID; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
 
 	t.Run("given invalid id", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
 		username, _ := username.NewUsername("user")
 		userToken := token.NewToken("123")
 		auth := credential.NewAuth(username, userToken)
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
 
-		taskRepository.EXPECT().GetAll(gomock.Any()).Return(
+		taskRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(
 			[]task.Task{task1, task2, task3},
 			nil,
 		)
 
-		err := usecase.Delete(token.NewToken(""), 4)
-		if expected := InvalidID; err.Error() != expected {
+		err := usecase.Delete(context.Background(), token.NewToken(""), 4)
+		if expected := InvalidID; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -154,26 +156,26 @@ func TestDeleteAll(t *testing.T) {
 	)
 
 	t.Run("success", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
 		username, _ := username.NewUsername("user")
 		userToken := token.NewToken("123")
 		auth := credential.NewAuth(username, userToken)
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
 
-		taskRepository.EXPECT().RemoveAll(gomock.Any()).Return(nil)
+		taskRepository.EXPECT().RemoveAll(gomock.Any(), gomock.Any()).Return(nil)
 
-		err := usecase.DeleteAll(token.NewToken(""))
+		err := usecase.DeleteAll(context.Background(), token.NewToken(""))
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
 	})
 
 	t.Run("has no credential", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		err := usecase.DeleteAll(token.NewToken(""))
-		if expected := credentialUsecase.InvalidToken; err.Error() != expected {
+		err := usecase.DeleteAll(context.Background(), token.NewToken(""))
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -198,29 +200,29 @@ func TestGetAll(t *testing.T) {
 	task3, _ := task.NewTask(3, "2020-01-01", "3")
 
 	t.Run("success", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
 		username, _ := username.NewUsername("user")
 		userToken := token.NewToken("123")
 		auth := credential.NewAuth(username, userToken)
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
 
-		taskRepository.EXPECT().GetAll(gomock.Any()).Return(
+		taskRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(
 			[]task.Task{task1, task2, task3},
 			nil,
 		)
 
-		_, err := usecase.GetAll(token.NewToken(""))
+		_, err := usecase.GetAll(context.Background(), token.NewToken(""))
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
 	})
 
 	t.Run("has no credential", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		_, err := usecase.GetAll(token.NewToken(""))
-		if expected := credentialUsecase.InvalidToken; err.Error() != expected {
+		_, err := usecase.GetAll(context.Background(), token.NewToken(""))
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -0,0 +1,184 @@
+package term
+
+import (
+	"context"
+	"time"
+
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	termModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	tokenModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
+	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type TermUsecase struct {
+	credentialUsecase    credentialUsecase.CredentialUsecase
+	termRepository       termRepository.ITermRepository
+	timetablesRepository timetablesRepository.ITimetablesRepository
+	now                  func() time.Time
+}
+
+func NewTermUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	t termRepository.ITermRepository,
+	tt timetablesRepository.ITimetablesRepository,
+) TermUsecase {
+	return TermUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		t,
+		tt,
+		time.Now,
+	}
+}
+
+var (
+	IDIsNotZero        = errs.Invalid("id_is_not_zero", "ID is not zero")
+	InvalidID          = errs.Invalid("invalid_id", "Invalid ID")
+	TermNotFound       = errs.NotFound("term_not_found", "term not found")
+	ActiveTermNotFound = errs.NotFound("active_term_not_found", "active term not found")
+)
+
+func (u TermUsecase) Add(ctx context.Context, token tokenModel.Token, term termModel.Term) error {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !credentialed {
+		return credentialUsecase.InvalidToken
+	}
+
+	user, err := u.credentialUsecase.Whose(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	if err = u.termRepository.Create(ctx, user, term); err != nil {
+		return err
+	}
+	if len(terms) > 0 {
+		return nil
+	}
+
+	return u.adopt(ctx, user)
+}
+
+// noTerm is the ID of the term of the timetables registered before a user has any terms.
+const noTerm = 0
+
+// adopt moves the timetables registered before the user had any terms to the first term,
+// so that they are not left behind when the first term becomes the active one.
+func (u TermUsecase) adopt(ctx context.Context, user username.Username) error {
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return err
+	}
+	if len(terms) != 1 {
+		return nil
+	}
+
+	return u.timetablesRepository.MoveTerm(ctx, user, noTerm, terms[0].ID())
+}
+
+func (u TermUsecase) GetAll(ctx context.Context, token tokenModel.Token) ([]termModel.Term, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !credentialed {
+		return nil, credentialUsecase.InvalidToken
+	}
+
+	user, err := u.credentialUsecase.Whose(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.termRepository.GetAll(ctx, user)
+}
+
+func (u TermUsecase) Active(ctx context.Context, token tokenModel.Token) (termModel.Term, error) {
+	terms, err := u.GetAll(ctx, token)
+	if err != nil {
+		return termModel.Term{}, err
+	}
+
+	active, found := termModel.Active(terms, u.now())
+	if !found {
+		return termModel.Term{}, ActiveTermNotFound
+	}
+
+	return active, nil
+}
+
+// Delete removes the term together with its timetables and their versions.
+func (u TermUsecase) Delete(ctx context.Context, token tokenModel.Token, id int) error {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !credentialed {
+		return credentialUsecase.InvalidToken
+	}
+
+	if id == 0 {
+		return IDIsNotZero
+	}
+	if id < 0 {
+		return InvalidID
+	}
+
+	user, err := u.credentialUsecase.Whose(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return err
+	}
+	if !isValidID(id, terms) {
+		return TermNotFound
+	}
+
+	if err = u.timetablesRepository.DeleteWithVersions(ctx, user, id); err != nil {
+		return err
+	}
+
+	return u.termRepository.Remove(ctx, user, id)
+}
+
+func (u TermUsecase) DeleteAll(ctx context.Context, token tokenModel.Token) error {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !credentialed {
+		return credentialUsecase.InvalidToken
+	}
+
+	user, err := u.credentialUsecase.Whose(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return u.termRepository.RemoveAll(ctx, user)
+}
+
+func isValidID(id int, terms []termModel.Term) bool {
+	for _, term := range terms {
+		if term.ID() == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,192 @@
+package term
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+var (
+	spring, _ = term.NewTerm(1, "spring", "2020-04-01", "2020-09-30")
+	fall, _   = term.NewTerm(2, "fall", "2020-10-01", "2021-03-31")
+	terms     = []term.Term{spring, fall}
+)
+
+func TestAdd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+
+	usecase := NewTermUsecase(
+		credentialRepository,
+		loginRepository,
+		termRepository,
+		timetablesRepository,
+	)
+
+	t.Run("success", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+
+		username, _ := username.NewUsername("user")
+		userToken := token.NewToken("123")
+		auth := credential.NewAuth(username, userToken)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		termRepository.EXPECT().GetAll(gomock.Any(), username).Return([]term.Term{spring}, nil)
+		termRepository.EXPECT().Create(gomock.Any(), username, fall).Return(nil)
+
+		err := usecase.Add(context.Background(), userToken, fall)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("first term adopts timetables without terms", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+
+		username, _ := username.NewUsername("user")
+		userToken := token.NewToken("123")
+		auth := credential.NewAuth(username, userToken)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		gomock.InOrder(
+			termRepository.EXPECT().GetAll(gomock.Any(), username).Return([]term.Term{}, nil),
+			termRepository.EXPECT().Create(gomock.Any(), username, spring).Return(nil),
+			termRepository.EXPECT().GetAll(gomock.Any(), username).Return([]term.Term{spring}, nil),
+			timetablesRepository.EXPECT().MoveTerm(gomock.Any(), username, 0, spring.ID()).Return(nil),
+		)
+
+		err := usecase.Add(context.Background(), userToken, spring)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("has no credential", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		err := usecase.Add(context.Background(), token.NewToken(""), spring)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestActive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+
+	usecase := NewTermUsecase(
+		credentialRepository,
+		loginRepository,
+		termRepository,
+		timetablesRepository,
+	)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+
+	t.Run("success", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+
+		usecase.now = func() time.Time {
+			return time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC)
+		}
+		active, err := usecase.Active(context.Background(), userToken)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if active.ID() != spring.ID() {
+			t.Fatalf("expected: %v; got: %v\n", spring.ID(), active.ID())
+		}
+	})
+
+	t.Run("active term not found", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+
+		usecase.now = func() time.Time {
+			return time.Date(2021, time.May, 1, 0, 0, 0, 0, time.UTC)
+		}
+		_, err := usecase.Active(context.Background(), userToken)
+		if expected := ActiveTermNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+
+	usecase := NewTermUsecase(
+		credentialRepository,
+		loginRepository,
+		termRepository,
+		timetablesRepository,
+	)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+
+	t.Run("success", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+		timetablesRepository.EXPECT().DeleteWithVersions(gomock.Any(), gomock.Any(), spring.ID()).Return(nil)
+		termRepository.EXPECT().Remove(gomock.Any(), gomock.Any(), spring.ID()).Return(nil)
+
+		err := usecase.Delete(context.Background(), userToken, spring.ID())
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("term not found", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+
+		err := usecase.Delete(context.Background(), userToken, 3)
+		if expected := TermNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+
+	t.Run("zero ID", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+
+		err := usecase.Delete(context.Background(), userToken, 0)
+		if expected := IDIsNotZero; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
@@ -1,109 +1,389 @@
 package timetables
 
 import (
-	"fmt"
+	"context"
+	"time"
 
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
+	termModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
 	timetablesModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	termRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/term"
 	timetablesRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/timetables"
 	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
 	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
 	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
 )
 
 type TimetablesUsecase struct {
 	credentialUsecase    credentialUsecase.CredentialUsecase
 	timetablesRepository timetablesRepository.ITimetablesRepository
+	termRepository       termRepository.ITermRepository
+	now                  func() time.Time
 }
 
 func NewTimetablesUsecase(c credentialRepository.ICredentialRepository,
 	l loginRepository.ILoginRepository,
 	t timetablesRepository.ITimetablesRepository,
+	tm termRepository.ITermRepository,
 ) TimetablesUsecase {
 	return TimetablesUsecase{
 		credentialUsecase.NewCredentialUsecase(c, l),
 		t,
+		tm,
+		time.Now,
 	}
 }
 
+var (
+	TimetablesNotFound = errs.NotFound("timetables_not_found", "timetables not found")
+	PastTermIsReadOnly = errs.Forbidden("past_term_is_read_only", "timetables of a past term are read-only")
+	VersionNotFound    = errs.NotFound("version_not_found", "version not found")
+)
+
 const (
-	TimetablesNotFound = "timetables not found"
+	// NoTerm is the term ID of timetables registered by a user who has no terms.
+	NoTerm = 0
+	// Latest selects the newest version of timetables.
+	Latest = 0
 )
 
-func (u TimetablesUsecase) Add(token token.Token, timetables timetablesModel.Timetables) error {
-	credentialed, err := u.credentialUsecase.HasCredential(token)
+// Add registers the timetables of the active term.
+func (u TimetablesUsecase) Add(ctx context.Context, token token.Token, timetables timetablesModel.Timetables) error {
+	user, err := u.whose(ctx, token)
 	if err != nil {
 		return err
 	}
-	if !credentialed {
-		return fmt.Errorf(credentialUsecase.InvalidToken)
+
+	term, err := u.activeTerm(ctx, user)
+	if err != nil {
+		return err
 	}
 
-	user, err := u.credentialUsecase.Whose(token)
+	return u.replace(ctx, user, term, timetables)
+}
+
+// AddToTerm registers the timetables of the given term unless the term has already ended.
+func (u TimetablesUsecase) AddToTerm(ctx context.Context, token token.Token, id int, timetables timetablesModel.Timetables) error {
+	user, err := u.whose(ctx, token)
 	if err != nil {
 		return err
 	}
 
-	exist, err := u.timetablesRepository.Exists(user)
+	term, err := u.findTerm(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	if term.IsPast(u.now()) {
+		return PastTermIsReadOnly
+	}
+
+	return u.replace(ctx, user, term.ID(), timetables)
+}
+
+func (u TimetablesUsecase) replace(ctx context.Context, user username.Username, term int, timetables timetablesModel.Timetables) error {
+	exist, err := u.timetablesRepository.Exists(ctx, user, term)
 	if err != nil {
 		return err
 	}
 	if exist {
-		if err = u.Delete(token); err != nil {
+		if err = u.timetablesRepository.Delete(ctx, user, term); err != nil {
 			return err
 		}
 	}
 
-	return u.timetablesRepository.Create(user, timetables)
+	return u.timetablesRepository.Create(ctx, user, term, timetables)
 }
 
-func (u TimetablesUsecase) Delete(token token.Token) error {
-	credentialed, err := u.credentialUsecase.HasCredential(token)
+// Delete removes the timetables of the active term.
+func (u TimetablesUsecase) Delete(ctx context.Context, token token.Token) error {
+	user, err := u.whose(ctx, token)
 	if err != nil {
 		return err
 	}
-	if !credentialed {
-		return fmt.Errorf(credentialUsecase.InvalidToken)
-	}
 
-	user, err := u.credentialUsecase.Whose(token)
+	term, err := u.activeTerm(ctx, user)
 	if err != nil {
 		return err
 	}
 
-	exist, err := u.timetablesRepository.Exists(user)
+	exist, err := u.timetablesRepository.Exists(ctx, user, term)
 	if err != nil {
 		return err
 	}
 	if !exist {
-		return fmt.Errorf(TimetablesNotFound)
+		return TimetablesNotFound
+	}
+
+	return u.timetablesRepository.Delete(ctx, user, term)
+}
+
+// DeleteAll removes the timetables of every term, together with their versions.
+func (u TimetablesUsecase) DeleteAll(ctx context.Context, token token.Token) error {
+	user, err := u.whose(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	ids := []int{NoTerm}
+	for _, t := range terms {
+		ids = append(ids, t.ID())
 	}
 
-	return u.timetablesRepository.Delete(user)
+	for _, id := range ids {
+		if err = u.timetablesRepository.DeleteWithVersions(ctx, user, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (u TimetablesUsecase) Get(token token.Token) (timetablesModel.Timetables, error) {
-	credentialed, err := u.credentialUsecase.HasCredential(token)
+// Get returns the timetables of the active term.
+func (u TimetablesUsecase) Get(ctx context.Context, token token.Token) (timetablesModel.Timetables, error) {
+	user, err := u.whose(ctx, token)
 	if err != nil {
 		return timetablesModel.Timetables{}, err
 	}
-	if !credentialed {
-		return timetablesModel.Timetables{}, fmt.Errorf(credentialUsecase.InvalidToken)
+
+	term, err := u.activeTerm(ctx, user)
+	if err != nil {
+		return timetablesModel.Timetables{}, err
 	}
 
-	user, err := u.credentialUsecase.Whose(token)
+	return u.get(ctx, user, term)
+}
+
+// Of returns the timetables of the active term of a user who has already been authorized.
+func (u TimetablesUsecase) Of(ctx context.Context, user username.Username) (timetablesModel.Timetables, error) {
+	term, err := u.activeTerm(ctx, user)
+	if err != nil {
+		return timetablesModel.Timetables{}, err
+	}
+
+	return u.get(ctx, user, term)
+}
+
+// GetByTerm returns the timetables of the given term, which may have already ended.
+func (u TimetablesUsecase) GetByTerm(ctx context.Context, token token.Token, id int) (timetablesModel.Timetables, error) {
+	user, err := u.whose(ctx, token)
 	if err != nil {
 		return timetablesModel.Timetables{}, err
 	}
 
-	exist, err := u.timetablesRepository.Exists(user)
+	term, err := u.findTerm(ctx, user, id)
 	if err != nil {
 		return timetablesModel.Timetables{}, err
 	}
 
+	return u.get(ctx, user, term.ID())
+}
+
+// GetWeek returns the timetables of the week containing d,
+// with every slot resolved to the class taking place on that week.
+func (u TimetablesUsecase) GetWeek(ctx context.Context, token token.Token, d time.Time) (timetablesModel.Timetables, error) {
+	user, err := u.whose(ctx, token)
+	if err != nil {
+		return timetablesModel.Timetables{}, err
+	}
+
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return timetablesModel.Timetables{}, err
+	}
+
+	if len(terms) == 0 {
+		ts, err := u.get(ctx, user, NoTerm)
+		if err != nil {
+			return timetablesModel.Timetables{}, err
+		}
+		return ts.Resolve(timetablesModel.FirstISOWeek(d), d), nil
+	}
+
+	term, found := termModel.Active(terms, d)
+	if !found {
+		return timetablesModel.Timetables{}, termUsecase.TermNotFound
+	}
+
+	ts, err := u.get(ctx, user, term.ID())
+	if err != nil {
+		return timetablesModel.Timetables{}, err
+	}
+
+	return ts.Resolve(term.Start(), d), nil
+}
+
+// Versions returns the versions of the timetables of the given term, or of the active term if not specified,
+// oldest first.
+func (u TimetablesUsecase) Versions(ctx context.Context, token token.Token, id int, specified bool) ([]timetablesModel.Version, error) {
+	user, err := u.whose(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	term, err := u.termOf(ctx, user, id, specified)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.timetablesRepository.GetVersions(ctx, user, term)
+}
+
+// Diff lists the changes from a version of the timetables to another, either of which may be Latest.
+func (u TimetablesUsecase) Diff(ctx context.Context, token token.Token, id int, specified bool, from, to int) ([]timetablesModel.Change, error) {
+	user, err := u.whose(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	term, err := u.termOf(ctx, user, id, specified)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := u.version(ctx, user, term, from)
+	if err != nil {
+		return nil, err
+	}
+	after, err := u.version(ctx, user, term, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return timetablesModel.Diff(before.Timetables(), after.Timetables()), nil
+}
+
+// Rollback registers a version of the timetables of the given term, or of the active term if not specified,
+// again. The versions in between are kept, and the rollback is saved as a new version.
+func (u TimetablesUsecase) Rollback(ctx context.Context, token token.Token, id int, specified bool, number int) error {
+	user, err := u.whose(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	term := id
+	if specified {
+		t, err := u.findTerm(ctx, user, id)
+		if err != nil {
+			return err
+		}
+		if t.IsPast(u.now()) {
+			return PastTermIsReadOnly
+		}
+	} else {
+		term, err = u.activeTerm(ctx, user)
+		if err != nil {
+			return err
+		}
+	}
+
+	v, err := u.version(ctx, user, term, number)
+	if err != nil {
+		return err
+	}
+
+	return u.replace(ctx, user, term, v.Timetables())
+}
+
+// termOf returns the ID of the given term, which may have already ended, or of the active term if not specified.
+func (u TimetablesUsecase) termOf(ctx context.Context, user username.Username, id int, specified bool) (int, error) {
+	if !specified {
+		return u.activeTerm(ctx, user)
+	}
+
+	term, err := u.findTerm(ctx, user, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return term.ID(), nil
+}
+
+func (u TimetablesUsecase) version(ctx context.Context, user username.Username, term, number int) (timetablesModel.Version, error) {
+	if number == Latest {
+		vs, err := u.timetablesRepository.GetVersions(ctx, user, term)
+		if err != nil {
+			return timetablesModel.Version{}, err
+		}
+		if len(vs) == 0 {
+			return timetablesModel.Version{}, VersionNotFound
+		}
+		return vs[len(vs)-1], nil
+	}
+
+	exist, err := u.timetablesRepository.VersionExists(ctx, user, term, number)
+	if err != nil {
+		return timetablesModel.Version{}, err
+	}
 	if !exist {
-		return timetablesModel.Timetables{}, fmt.Errorf(TimetablesNotFound)
+		return timetablesModel.Version{}, VersionNotFound
+	}
+
+	return u.timetablesRepository.GetVersion(ctx, user, term, number)
+}
+
+func (u TimetablesUsecase) get(ctx context.Context, user username.Username, term int) (timetablesModel.Timetables, error) {
+	exist, err := u.timetablesRepository.Exists(ctx, user, term)
+	if err != nil {
+		return timetablesModel.Timetables{}, err
+	}
+
+	if !exist {
+		return timetablesModel.Timetables{}, TimetablesNotFound
+	}
+
+	return u.timetablesRepository.Get(ctx, user, term)
+}
+
+func (u TimetablesUsecase) whose(ctx context.Context, token token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, token)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, token)
+}
+
+// activeTerm returns the ID of the term containing today,
+// or NoTerm if the user has not registered any terms yet.
+func (u TimetablesUsecase) activeTerm(ctx context.Context, user username.Username) (int, error) {
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return 0, err
+	}
+	if len(terms) == 0 {
+		return NoTerm, nil
+	}
+
+	active, found := termModel.Active(terms, u.now())
+	if !found {
+		return 0, termUsecase.ActiveTermNotFound
+	}
+
+	return active.ID(), nil
+}
+
+func (u TimetablesUsecase) findTerm(ctx context.Context, user username.Username, id int) (termModel.Term, error) {
+	terms, err := u.termRepository.GetAll(ctx, user)
+	if err != nil {
+		return termModel.Term{}, err
+	}
+
+	for _, t := range terms {
+		if t.ID() == id {
+			return t, nil
+		}
 	}
 
-	return u.timetablesRepository.Get(user)
+	return termModel.Term{}, termUsecase.TermNotFound
 }
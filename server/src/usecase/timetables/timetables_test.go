@@ -1,14 +1,19 @@
 package timetables
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/term"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/timetables"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	termUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/term"
 	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
 )
 
@@ -52,6 +57,18 @@ var (
 	)
 )
 
+var (
+	spring, _ = term.NewTerm(1, "spring", "2020-04-01", "2020-09-30")
+	fall, _   = term.NewTerm(2, "fall", "2020-10-01", "2021-03-31")
+	terms     = []term.Term{spring, fall}
+)
+
+func at(year int, month time.Month, day int) func() time.Time {
+	return func() time.Time {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+	}
+}
+
 func TestAdd(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -59,35 +76,75 @@ func TestAdd(t *testing.T) {
 	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
 	loginRepository := mocks.NewMockILoginRepository(ctrl)
 	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
 
 	usecase := NewTimetablesUsecase(
 		credentialRepository,
 		loginRepository,
 		timetablesRepository,
+		termRepository,
 	)
 
 	t.Run("success", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+
+		username, _ := username.NewUsername("user")
+		userToken := token.NewToken("123")
+		auth := credential.NewAuth(username, userToken)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]term.Term{}, nil)
+		timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), NoTerm).Return(false, nil)
+		timetablesRepository.EXPECT().Create(gomock.Any(), gomock.Any(), NoTerm, gomock.Any()).Return(nil)
+
+		err := usecase.Add(context.Background(), token.NewToken(""), ts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("active term", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
 		username, _ := username.NewUsername("user")
 		userToken := token.NewToken("123")
 		auth := credential.NewAuth(username, userToken)
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
 
-		timetablesRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
-		timetablesRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+		timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), fall.ID()).Return(true, nil)
+		timetablesRepository.EXPECT().Delete(gomock.Any(), gomock.Any(), fall.ID()).Return(nil)
+		timetablesRepository.EXPECT().Create(gomock.Any(), gomock.Any(), fall.ID(), gomock.Any()).Return(nil)
 
-		err := usecase.Add(token.NewToken(""), ts)
+		usecase.now = at(2020, time.November, 1)
+		err := usecase.Add(context.Background(), userToken, ts)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
 	})
 
+	t.Run("active term not found", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+
+		username, _ := username.NewUsername("user")
+		userToken := token.NewToken("123")
+		auth := credential.NewAuth(username, userToken)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+
+		usecase.now = at(2021, time.April, 1)
+		err := usecase.Add(context.Background(), userToken, ts)
+		if expected := termUsecase.ActiveTermNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+
 	t.Run("has no credential", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		err := usecase.Add(token.NewToken(""), ts)
-		if expected := credentialUsecase.InvalidToken; err.Error() != expected {
+		err := usecase.Add(context.Background(), token.NewToken(""), ts)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -100,35 +157,38 @@ func TestDelete(t *testing.T) {
 	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
 	loginRepository := mocks.NewMockILoginRepository(ctrl)
 	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
 
 	usecase := NewTimetablesUsecase(
 		credentialRepository,
 		loginRepository,
 		timetablesRepository,
+		termRepository,
 	)
 
 	t.Run("success", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
 		username, _ := username.NewUsername("user")
 		userToken := token.NewToken("123")
 		auth := credential.NewAuth(username, userToken)
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
 
-		timetablesRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		timetablesRepository.EXPECT().Delete(gomock.Any()).Return(nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]term.Term{}, nil)
+		timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), NoTerm).Return(true, nil)
+		timetablesRepository.EXPECT().Delete(gomock.Any(), gomock.Any(), NoTerm).Return(nil)
 
-		err := usecase.Delete(userToken)
+		err := usecase.Delete(context.Background(), userToken)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
 	})
 
 	t.Run("has no credential", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		err := usecase.Delete(token.NewToken(""))
-		if expected := credentialUsecase.InvalidToken; err.Error() != expected {
+		err := usecase.Delete(context.Background(), token.NewToken(""))
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -141,51 +201,367 @@ func TestGet(t *testing.T) {
 	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
 	loginRepository := mocks.NewMockILoginRepository(ctrl)
 	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
 
 	usecase := NewTimetablesUsecase(
 		credentialRepository,
 		loginRepository,
 		timetablesRepository,
+		termRepository,
 	)
 
 	t.Run("success", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
 		username, _ := username.NewUsername("user")
 		userToken := token.NewToken("123")
 		auth := credential.NewAuth(username, userToken)
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
 
-		timetablesRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		timetablesRepository.EXPECT().Get(gomock.Any()).Return(ts, nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]term.Term{}, nil)
+		timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), NoTerm).Return(true, nil)
+		timetablesRepository.EXPECT().Get(gomock.Any(), gomock.Any(), NoTerm).Return(ts, nil)
 
-		_, err := usecase.Get(userToken)
+		_, err := usecase.Get(context.Background(), userToken)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
 	})
 
 	t.Run("has no credential", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		_, err := usecase.Get(token.NewToken("a"))
-		if expected := credentialUsecase.InvalidToken; err.Error() != expected {
+		_, err := usecase.Get(context.Background(), token.NewToken("a"))
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
 
 	t.Run("timetables not found", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+
+		username, _ := username.NewUsername("user")
+		userToken := token.NewToken("123")
+		auth := credential.NewAuth(username, userToken)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]term.Term{}, nil)
+		timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), NoTerm).Return(false, nil)
+
+		_, err := usecase.Get(context.Background(), userToken)
+		if expected := TimetablesNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestAddToTerm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+
+	usecase := NewTimetablesUsecase(
+		credentialRepository,
+		loginRepository,
+		timetablesRepository,
+		termRepository,
+	)
+	usecase.now = at(2020, time.November, 1)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+
+	t.Run("success", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+		timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), fall.ID()).Return(false, nil)
+		timetablesRepository.EXPECT().Create(gomock.Any(), gomock.Any(), fall.ID(), gomock.Any()).Return(nil)
+
+		err := usecase.AddToTerm(context.Background(), userToken, fall.ID(), ts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("past term", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+
+		err := usecase.AddToTerm(context.Background(), userToken, spring.ID(), ts)
+		if expected := PastTermIsReadOnly; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+
+	t.Run("term not found", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+
+		err := usecase.AddToTerm(context.Background(), userToken, 3, ts)
+		if expected := termUsecase.TermNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestGetByTerm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+
+	usecase := NewTimetablesUsecase(
+		credentialRepository,
+		loginRepository,
+		timetablesRepository,
+		termRepository,
+	)
+	usecase.now = at(2020, time.November, 1)
+
+	t.Run("past term", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+
+		username, _ := username.NewUsername("user")
+		userToken := token.NewToken("123")
+		auth := credential.NewAuth(username, userToken)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+		timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), spring.ID()).Return(true, nil)
+		timetablesRepository.EXPECT().Get(gomock.Any(), gomock.Any(), spring.ID()).Return(ts, nil)
+
+		_, err := usecase.GetByTerm(context.Background(), userToken, spring.ID())
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+}
+
+func TestDeleteAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+
+	usecase := NewTimetablesUsecase(
+		credentialRepository,
+		loginRepository,
+		timetablesRepository,
+		termRepository,
+	)
+
+	t.Run("success", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
 		username, _ := username.NewUsername("user")
 		userToken := token.NewToken("123")
 		auth := credential.NewAuth(username, userToken)
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+		timetablesRepository.EXPECT().DeleteWithVersions(gomock.Any(), gomock.Any(), NoTerm).Return(nil)
+		timetablesRepository.EXPECT().DeleteWithVersions(gomock.Any(), gomock.Any(), spring.ID()).Return(nil)
+		timetablesRepository.EXPECT().DeleteWithVersions(gomock.Any(), gomock.Any(), fall.ID()).Return(nil)
+
+		err := usecase.DeleteAll(context.Background(), userToken)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+}
+
+func TestGetWeek(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+
+	usecase := NewTimetablesUsecase(
+		credentialRepository,
+		loginRepository,
+		timetablesRepository,
+		termRepository,
+	)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+
+	biweekly := timetables.NewTimetables(
+		timetables.NewTimetableOfSlots(
+			timetables.NewSlot(timetables.NewClass("Lab", "101", "").WithRule(timetables.Odd())),
+			timetables.EmptySlot(),
+			timetables.EmptySlot(),
+			timetables.EmptySlot(),
+			timetables.EmptySlot(),
+		),
+		timetables.Timetable{},
+		timetables.Timetable{},
+		timetables.Timetable{},
+		timetables.Timetable{},
+	)
+
+	tests := []struct {
+		name    string
+		date    time.Time
+		noClass bool
+	}{
+		// spring starts on Wednesday, 2020-04-01.
+		{"first week", time.Date(2020, time.April, 3, 0, 0, 0, 0, time.UTC), false},
+		{"second week", time.Date(2020, time.April, 6, 0, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+			credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+			termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+			timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), spring.ID()).Return(true, nil)
+			timetablesRepository.EXPECT().Get(gomock.Any(), gomock.Any(), spring.ID()).Return(biweekly, nil)
+
+			ts, err := usecase.GetWeek(context.Background(), userToken, test.date)
+			if err != nil {
+				t.Fatalf("unexpected error: %v\n", err)
+			}
+			if got := ts.Mon().First().IsNoClass(); got != test.noClass {
+				t.Fatalf("expected: %v; got: %v\n", test.noClass, got)
+			}
+		})
+	}
+
+	t.Run("no term on the date", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+
+		_, err := usecase.GetWeek(context.Background(), userToken, time.Date(2021, time.May, 1, 0, 0, 0, 0, time.UTC))
+		if expected := termUsecase.TermNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestDiff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+
+	usecase := NewTimetablesUsecase(
+		credentialRepository,
+		loginRepository,
+		timetablesRepository,
+		termRepository,
+	)
+	usecase.now = at(2020, time.November, 1)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+
+	changed := timetables.NewTimetables(ts.Mon().WithPeriod(1, timetables.EmptySlot()), ts.Tue(), ts.Wed(), ts.Thu(), ts.Fri())
+	first := timetables.NewVersion(1, time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC), ts)
+	second := timetables.NewVersion(2, time.Date(2020, time.April, 8, 0, 0, 0, 0, time.UTC), changed)
+
+	t.Run("to the latest version of a past term", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+		timetablesRepository.EXPECT().VersionExists(gomock.Any(), gomock.Any(), spring.ID(), 1).Return(true, nil)
+		timetablesRepository.EXPECT().GetVersion(gomock.Any(), gomock.Any(), spring.ID(), 1).Return(first, nil)
+		timetablesRepository.EXPECT().GetVersions(gomock.Any(), gomock.Any(), spring.ID()).Return([]timetables.Version{first, second}, nil)
+
+		changes, err := usecase.Diff(context.Background(), userToken, spring.ID(), true, 1, Latest)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+		if len(changes) != 1 || changes[0].Kind() != timetables.SlotRemoved || changes[0].Day() != time.Monday {
+			t.Fatalf("expected: %v; got: %v\n", timetables.SlotRemoved, changes)
+		}
+	})
+
+	t.Run("version not found", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+		timetablesRepository.EXPECT().VersionExists(gomock.Any(), gomock.Any(), fall.ID(), 3).Return(false, nil)
+
+		_, err := usecase.Diff(context.Background(), userToken, 0, false, 3, Latest)
+		if expected := VersionNotFound; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestRollback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	timetablesRepository := mocks.NewMockITimetablesRepository(ctrl)
+	termRepository := mocks.NewMockITermRepository(ctrl)
+
+	usecase := NewTimetablesUsecase(
+		credentialRepository,
+		loginRepository,
+		timetablesRepository,
+		termRepository,
+	)
+	usecase.now = at(2020, time.November, 1)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+
+	first := timetables.NewVersion(1, time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC), ts)
+
+	t.Run("success", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
+		timetablesRepository.EXPECT().VersionExists(gomock.Any(), gomock.Any(), fall.ID(), 1).Return(true, nil)
+		timetablesRepository.EXPECT().GetVersion(gomock.Any(), gomock.Any(), fall.ID(), 1).Return(first, nil)
+		timetablesRepository.EXPECT().Exists(gomock.Any(), gomock.Any(), fall.ID()).Return(true, nil)
+		timetablesRepository.EXPECT().Delete(gomock.Any(), gomock.Any(), fall.ID()).Return(nil)
+		timetablesRepository.EXPECT().Create(gomock.Any(), gomock.Any(), fall.ID(), ts).Return(nil)
+
+		err := usecase.Rollback(context.Background(), userToken, 0, false, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
 
-		timetablesRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+	t.Run("past term", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		termRepository.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(terms, nil)
 
-		_, err := usecase.Get(userToken)
-		if expected := TimetablesNotFound; err.Error() != expected {
+		err := usecase.Rollback(context.Background(), userToken, spring.ID(), true, 1)
+		if expected := PastTermIsReadOnly; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -1,8 +1,11 @@
 package credential
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"time"
 
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 	credentialModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
 	loginModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/login"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
@@ -15,6 +18,8 @@ import (
 type CredentialUsecase struct {
 	credentialRepository credentialRepository.ICredentialRepository
 	loginUsecase         loginUsecase.LoginUsecase
+	ttl                  time.Duration
+	now                  func() time.Time
 }
 
 func NewCredentialUsecase(
@@ -24,26 +29,49 @@ func NewCredentialUsecase(
 	return CredentialUsecase{
 		c,
 		loginUsecase.NewLoginUsecase(l),
+		0,
+		time.Now,
 	}
 }
 
-const (
-	UserNotFound              = "user not found"
-	InvalidUsernameOrPassword = "invalid username or password"
-	InvalidToken              = "invalid token"
+// Expiring returns the usecase which generates tokens expiring after ttl, or never if ttl is zero.
+func (u CredentialUsecase) Expiring(ttl time.Duration) CredentialUsecase {
+	u.ttl = ttl
+	return u
+}
+
+type authenticatedKey struct{}
+
+// WithAuthenticated returns the context in which f is called with the user once their password or token
+// is verified, so that for example the logs of a request tell whose it is.
+func WithAuthenticated(ctx context.Context, f func(username.Username)) context.Context {
+	return context.WithValue(ctx, authenticatedKey{}, f)
+}
+
+func authenticated(ctx context.Context, u username.Username) {
+	if f, ok := ctx.Value(authenticatedKey{}).(func(username.Username)); ok {
+		f(u)
+	}
+}
+
+var (
+	UserNotFound              = errs.NotFound("user_not_found", "user not found")
+	InvalidUsernameOrPassword = errs.Unauthorized("invalid_username_or_password", "invalid username or password")
+	InvalidToken              = errs.Unauthorized("invalid_token", "invalid token")
 )
 
-func (u CredentialUsecase) Generate(login loginModel.Login) (token.Token, error) {
-	verified, err := u.loginUsecase.Verify(login)
+func (u CredentialUsecase) Generate(ctx context.Context, login loginModel.Login) (token.Token, error) {
+	verified, err := u.loginUsecase.Verify(ctx, login)
 	if err != nil {
-		if err.Error() == loginUsecase.UsernameNotFound {
-			return token.NewToken(""), fmt.Errorf(InvalidUsernameOrPassword)
+		if errors.Is(err, loginUsecase.UsernameNotFound) {
+			return token.NewToken(""), InvalidUsernameOrPassword
 		}
 		return token.NewToken(""), err
 	}
 	if !verified {
-		return token.NewToken(""), fmt.Errorf(InvalidUsernameOrPassword)
+		return token.NewToken(""), InvalidUsernameOrPassword
 	}
+	authenticated(ctx, login.Username())
 
 	t, err := token.GenToken()
 	if err != nil {
@@ -51,44 +79,52 @@ func (u CredentialUsecase) Generate(login loginModel.Login) (token.Token, error)
 	}
 
 	a := credentialModel.NewAuth(login.Username(), t)
+	if u.ttl > 0 {
+		a = credentialModel.NewExpiringAuth(login.Username(), t, u.now().Add(u.ttl))
+	}
 
-	err = u.credentialRepository.Remove(a.Username())
+	err = u.credentialRepository.Remove(ctx, a.Username())
 	if err != nil {
 		return token.NewToken(""), err
 	}
 
-	return t, u.credentialRepository.Append(a)
+	return t, u.credentialRepository.Append(ctx, a)
 }
 
-func (u CredentialUsecase) Delete(login loginModel.Login) error {
-	verified, err := u.loginUsecase.Verify(login)
+func (u CredentialUsecase) Delete(ctx context.Context, login loginModel.Login) error {
+	verified, err := u.loginUsecase.Verify(ctx, login)
 	if err != nil {
 		return err
 	}
 	if !verified {
-		return fmt.Errorf(InvalidUsernameOrPassword)
+		return InvalidUsernameOrPassword
 	}
 
-	return u.credentialRepository.Remove(login.Username())
+	return u.credentialRepository.Remove(ctx, login.Username())
 }
 
-func (u CredentialUsecase) Get(login loginModel.Login) (credentialModel.Auth, error) {
-	verified, err := u.loginUsecase.Verify(login)
+func (u CredentialUsecase) Get(ctx context.Context, login loginModel.Login) (credentialModel.Auth, error) {
+	verified, err := u.loginUsecase.Verify(ctx, login)
 	if err != nil {
 		return credentialModel.Auth{}, err
 	}
 	if !verified {
-		return credentialModel.Auth{}, fmt.Errorf(InvalidUsernameOrPassword)
+		return credentialModel.Auth{}, InvalidUsernameOrPassword
 	}
 
-	return u.credentialRepository.GetByUsername(login.Username())
+	return u.credentialRepository.GetByUsername(ctx, login.Username())
 }
 
-func (u CredentialUsecase) HasCredential(t token.Token) (bool, error) {
-	return u.credentialRepository.Exists(t)
+func (u CredentialUsecase) HasCredential(ctx context.Context, t token.Token) (bool, error) {
+	return u.credentialRepository.Exists(ctx, t)
 }
 
-func (u CredentialUsecase) Whose(t token.Token) (username.Username, error) {
-	a, err := u.credentialRepository.GetByToken(t)
-	return a.Username(), err
+func (u CredentialUsecase) Whose(ctx context.Context, t token.Token) (username.Username, error) {
+	a, err := u.credentialRepository.GetByToken(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+
+	authenticated(ctx, a.Username())
+	return a.Username(), nil
 }
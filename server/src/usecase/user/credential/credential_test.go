@@ -1,8 +1,11 @@
 package credential
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
@@ -10,6 +13,7 @@ import (
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
 	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	loginUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/login"
 )
 
 func TestGenerate(t *testing.T) {
@@ -23,13 +27,38 @@ func TestGenerate(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(l, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(l, nil)
 
-		credentialRepository.EXPECT().Remove(gomock.Any()).Return(nil)
-		credentialRepository.EXPECT().Append(gomock.Any()).Return(nil)
+		credentialRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+		credentialRepository.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil)
 
-		_, err := usecase.Generate(l)
+		_, err := usecase.Generate(context.Background(), l)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("expiring", func(t *testing.T) {
+		username, _ := username.NewUsername("user")
+		l := login.NewLogin(username, "password")
+		now := time.Date(2020, 4, 1, 9, 0, 0, 0, time.UTC)
+
+		expiring := usecase.Expiring(time.Hour)
+		expiring.now = func() time.Time { return now }
+
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(l, nil)
+
+		credentialRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+		credentialRepository.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a credential.Auth) error {
+			if expires, ok := a.Expires(); !ok || !expires.Equal(now.Add(time.Hour)) {
+				t.Fatalf("expected: %v; got: %v %v\n", now.Add(time.Hour), expires, ok)
+			}
+			return nil
+		})
+
+		_, err := expiring.Generate(context.Background(), l)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
@@ -40,9 +69,9 @@ func TestGenerate(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, fmt.Errorf("error occurred"))
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, fmt.Errorf("error occurred"))
 
-		_, err := usecase.Generate(l)
+		_, err := usecase.Generate(context.Background(), l)
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
@@ -53,10 +82,10 @@ func TestGenerate(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		_, err := usecase.Generate(l)
-		if expected := InvalidUsernameOrPassword; err.Error() != expected {
+		_, err := usecase.Generate(context.Background(), l)
+		if expected := InvalidUsernameOrPassword; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -66,14 +95,14 @@ func TestGenerate(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
 			login.NewLogin(username, ""),
 			nil,
 		)
 
-		_, err := usecase.Generate(l)
-		if expected := InvalidUsernameOrPassword; err.Error() != expected {
+		_, err := usecase.Generate(context.Background(), l)
+		if expected := InvalidUsernameOrPassword; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -83,12 +112,12 @@ func TestGenerate(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(l, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(l, nil)
 
-		credentialRepository.EXPECT().Remove(gomock.Any()).Return(fmt.Errorf("error occurred"))
+		credentialRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error occurred"))
 
-		_, err := usecase.Generate(l)
+		_, err := usecase.Generate(context.Background(), l)
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
@@ -99,13 +128,13 @@ func TestGenerate(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(l, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(l, nil)
 
-		credentialRepository.EXPECT().Remove(gomock.Any()).Return(nil)
-		credentialRepository.EXPECT().Append(gomock.Any()).Return(fmt.Errorf("error occurred"))
+		credentialRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+		credentialRepository.EXPECT().Append(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error occurred"))
 
-		_, err := usecase.Generate(l)
+		_, err := usecase.Generate(context.Background(), l)
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
@@ -123,12 +152,12 @@ func TestDelete(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(l, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(l, nil)
 
-		credentialRepository.EXPECT().Remove(gomock.Any()).Return(nil)
+		credentialRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
 
-		err := usecase.Delete(l)
+		err := usecase.Delete(context.Background(), l)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
@@ -139,9 +168,9 @@ func TestDelete(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, fmt.Errorf("error occurred"))
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, fmt.Errorf("error occurred"))
 
-		err := usecase.Delete(l)
+		err := usecase.Delete(context.Background(), l)
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
@@ -152,10 +181,10 @@ func TestDelete(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		err := usecase.Delete(l)
-		if expected := "username not found"; err.Error() != expected {
+		err := usecase.Delete(context.Background(), l)
+		if expected := loginUsecase.UsernameNotFound; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -165,14 +194,14 @@ func TestDelete(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
 			login.NewLogin(username, ""),
 			nil,
 		)
 
-		err := usecase.Delete(l)
-		if expected := InvalidUsernameOrPassword; err.Error() != expected {
+		err := usecase.Delete(context.Background(), l)
+		if expected := InvalidUsernameOrPassword; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -182,12 +211,12 @@ func TestDelete(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(l, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(l, nil)
 
-		credentialRepository.EXPECT().Remove(gomock.Any()).Return(fmt.Errorf("error occurred"))
+		credentialRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error occurred"))
 
-		err := usecase.Delete(l)
+		err := usecase.Delete(context.Background(), l)
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
@@ -205,14 +234,14 @@ func TestGet(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(l, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(l, nil)
 
 		token := token.NewToken("123")
 		auth := credential.NewAuth(username, token)
-		credentialRepository.EXPECT().GetByUsername(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByUsername(gomock.Any(), gomock.Any()).Return(auth, nil)
 
-		v, err := usecase.Get(l)
+		v, err := usecase.Get(context.Background(), l)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
@@ -226,9 +255,9 @@ func TestGet(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, fmt.Errorf("error occurred"))
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, fmt.Errorf("error occurred"))
 
-		_, err := usecase.Get(l)
+		_, err := usecase.Get(context.Background(), l)
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
@@ -239,10 +268,10 @@ func TestGet(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		_, err := usecase.Get(l)
-		if expected := "username not found"; err.Error() != expected {
+		_, err := usecase.Get(context.Background(), l)
+		if expected := loginUsecase.UsernameNotFound; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -252,14 +281,14 @@ func TestGet(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
 			login.NewLogin(username, ""),
 			nil,
 		)
 
-		_, err := usecase.Get(l)
-		if expected := InvalidUsernameOrPassword; err.Error() != expected {
+		_, err := usecase.Get(context.Background(), l)
+		if expected := InvalidUsernameOrPassword; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -269,14 +298,14 @@ func TestGet(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(l, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(l, nil)
 
 		token := token.NewToken("123")
 		auth := credential.NewAuth(username, token)
-		credentialRepository.EXPECT().GetByUsername(gomock.Any()).Return(auth, fmt.Errorf("error occurred"))
+		credentialRepository.EXPECT().GetByUsername(gomock.Any(), gomock.Any()).Return(auth, fmt.Errorf("error occurred"))
 
-		_, err := usecase.Get(l)
+		_, err := usecase.Get(context.Background(), l)
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
@@ -290,9 +319,9 @@ func TestHasCredential(t *testing.T) {
 	usecase := NewCredentialUsecase(credentialRepository, loginRepository)
 
 	t.Run("success", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
-		v, err := usecase.HasCredential(token.NewToken(""))
+		v, err := usecase.HasCredential(context.Background(), token.NewToken(""))
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
@@ -302,9 +331,9 @@ func TestHasCredential(t *testing.T) {
 	})
 
 	t.Run("has not credential", func(t *testing.T) {
-		credentialRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		v, err := usecase.HasCredential(token.NewToken(""))
+		v, err := usecase.HasCredential(context.Background(), token.NewToken(""))
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
@@ -325,9 +354,9 @@ func TestWhose(t *testing.T) {
 		token := token.NewToken("123")
 		auth := credential.NewAuth(username, token)
 
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
 
-		u, err := usecase.Whose(token)
+		u, err := usecase.Whose(context.Background(), token)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
@@ -341,11 +370,34 @@ func TestWhose(t *testing.T) {
 		token := token.NewToken("123")
 		auth := credential.NewAuth(username, token)
 
-		credentialRepository.EXPECT().GetByToken(gomock.Any()).Return(auth, fmt.Errorf("error occurred"))
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, fmt.Errorf("error occurred"))
 
-		_, err := usecase.Whose(token)
+		_, err := usecase.Whose(context.Background(), token)
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
 	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		user, _ := username.NewUsername("user")
+		token := token.NewToken("123")
+		auth := credential.NewAuth(user, token)
+
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(credential.Auth{}, fmt.Errorf("error occurred"))
+
+		users := 0
+		ctx := WithAuthenticated(context.Background(), func(u username.Username) {
+			if u != auth.Username() {
+				t.Fatalf("expected: %v; got: %v\n", auth.Username(), u)
+			}
+			users++
+		})
+
+		usecase.Whose(ctx, token)
+		usecase.Whose(ctx, token)
+		if users != 1 {
+			t.Fatalf("expected: %v; got: %v\n", 1, users)
+		}
+	})
 }
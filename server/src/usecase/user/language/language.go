@@ -0,0 +1,80 @@
+package language
+
+import (
+	"context"
+
+	languageModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	credentialRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/credential"
+	languageRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/language"
+	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+type LanguageUsecase struct {
+	credentialUsecase  credentialUsecase.CredentialUsecase
+	languageRepository languageRepository.ILanguageRepository
+}
+
+func NewLanguageUsecase(c credentialRepository.ICredentialRepository,
+	l loginRepository.ILoginRepository,
+	lg languageRepository.ILanguageRepository,
+) LanguageUsecase {
+	return LanguageUsecase{
+		credentialUsecase.NewCredentialUsecase(c, l),
+		lg,
+	}
+}
+
+// Set registers the language the user prefers, replacing the one registered before.
+func (u LanguageUsecase) Set(ctx context.Context, t token.Token, l languageModel.Language) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.languageRepository.Set(ctx, user, l)
+}
+
+// Get returns the language the user prefers, and whether it is registered.
+func (u LanguageUsecase) Get(ctx context.Context, t token.Token) (languageModel.Language, bool, error) {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return languageModel.Language{}, false, err
+	}
+
+	exist, err := u.languageRepository.Exists(ctx, user)
+	if err != nil || !exist {
+		return languageModel.Language{}, false, err
+	}
+
+	l, err := u.languageRepository.Get(ctx, user)
+	if err != nil {
+		return languageModel.Language{}, false, err
+	}
+
+	return l, true, nil
+}
+
+// Reset removes the language of the user so that the one requested by each request is used.
+func (u LanguageUsecase) Reset(ctx context.Context, t token.Token) error {
+	user, err := u.whose(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return u.languageRepository.Remove(ctx, user)
+}
+
+func (u LanguageUsecase) whose(ctx context.Context, t token.Token) (username.Username, error) {
+	credentialed, err := u.credentialUsecase.HasCredential(ctx, t)
+	if err != nil {
+		return username.Username{}, err
+	}
+	if !credentialed {
+		return username.Username{}, credentialUsecase.InvalidToken
+	}
+
+	return u.credentialUsecase.Whose(ctx, t)
+}
@@ -0,0 +1,90 @@
+package language
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/credential"
+	languageModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/language"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/token"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/model/user/username"
+	"github.com/team-gleam/kiwi-basket/server/src/domain/repository/mocks"
+	credentialUsecase "github.com/team-gleam/kiwi-basket/server/src/usecase/user/credential"
+)
+
+func TestSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	languageRepository := mocks.NewMockILanguageRepository(ctrl)
+
+	usecase := NewLanguageUsecase(credentialRepository, loginRepository, languageRepository)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+
+	t.Run("registers the language", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+		languageRepository.EXPECT().Set(gomock.Any(), username, languageModel.Japanese).Return(nil)
+
+		err := usecase.Set(context.Background(), userToken, languageModel.Japanese)
+		if err != nil {
+			t.Fatalf("unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("has no credential", func(t *testing.T) {
+		credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		err := usecase.Set(context.Background(), token.NewToken(""), languageModel.Japanese)
+		if expected := credentialUsecase.InvalidToken; !errors.Is(err, expected) {
+			t.Fatalf("expected: %v; got: %v\n", expected, err)
+		}
+	})
+}
+
+func TestGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	credentialRepository := mocks.NewMockICredentialRepository(ctrl)
+	loginRepository := mocks.NewMockILoginRepository(ctrl)
+	languageRepository := mocks.NewMockILanguageRepository(ctrl)
+
+	usecase := NewLanguageUsecase(credentialRepository, loginRepository, languageRepository)
+
+	username, _ := username.NewUsername("user")
+	userToken := token.NewToken("123")
+	auth := credential.NewAuth(username, userToken)
+
+	tests := []struct {
+		name     string
+		exist    bool
+		expected languageModel.Language
+	}{
+		{"registered", true, languageModel.Japanese},
+		{"not registered", false, languageModel.Language{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			credentialRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+			credentialRepository.EXPECT().GetByToken(gomock.Any(), gomock.Any()).Return(auth, nil)
+			languageRepository.EXPECT().Exists(gomock.Any(), username).Return(test.exist, nil)
+			if test.exist {
+				languageRepository.EXPECT().Get(gomock.Any(), username).Return(test.expected, nil)
+			}
+
+			l, set, err := usecase.Get(context.Background(), userToken)
+			if err != nil || set != test.exist || l != test.expected {
+				t.Fatalf("expected: %v %v; got: %v %v %v\n", test.expected, test.exist, l, set, err)
+			}
+		})
+	}
+}
@@ -1,8 +1,9 @@
 package login
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/team-gleam/kiwi-basket/server/src/domain/errs"
 	loginModel "github.com/team-gleam/kiwi-basket/server/src/domain/model/user/login"
 	loginRepository "github.com/team-gleam/kiwi-basket/server/src/domain/repository/user/login"
 )
@@ -15,45 +16,45 @@ func NewLoginUsecase(r loginRepository.ILoginRepository) LoginUsecase {
 	return LoginUsecase{r}
 }
 
-const (
-	UsernameAlreadyExists = "username already exists"
-	UsernameNotFound      = "username not found"
+var (
+	UsernameAlreadyExists = errs.Conflict("username_already_exists", "username already exists")
+	UsernameNotFound      = errs.NotFound("username_not_found", "username not found")
 )
 
-func (u LoginUsecase) Add(l loginModel.Login) error {
-	exist, err := u.loginRepository.Exists(l.Username())
+func (u LoginUsecase) Add(ctx context.Context, l loginModel.Login) error {
+	exist, err := u.loginRepository.Exists(ctx, l.Username())
 	if err != nil {
 		return err
 	}
 	if exist {
-		return fmt.Errorf(UsernameAlreadyExists)
+		return UsernameAlreadyExists
 	}
 
-	return u.loginRepository.Create(l)
+	return u.loginRepository.Create(ctx, l)
 }
 
-func (u LoginUsecase) Delete(l loginModel.Login) error {
-	exist, err := u.loginRepository.Exists(l.Username())
+func (u LoginUsecase) Delete(ctx context.Context, l loginModel.Login) error {
+	exist, err := u.loginRepository.Exists(ctx, l.Username())
 	if err != nil {
 		return err
 	}
 	if !exist {
-		return fmt.Errorf(UsernameNotFound)
+		return UsernameNotFound
 	}
 
-	return u.loginRepository.Delete(l)
+	return u.loginRepository.Delete(ctx, l)
 }
 
-func (u LoginUsecase) Verify(login loginModel.Login) (bool, error) {
-	exist, err := u.loginRepository.Exists(login.Username())
+func (u LoginUsecase) Verify(ctx context.Context, login loginModel.Login) (bool, error) {
+	exist, err := u.loginRepository.Exists(ctx, login.Username())
 	if err != nil {
 		return false, err
 	}
 	if !exist {
-		return false, fmt.Errorf(UsernameNotFound)
+		return false, UsernameNotFound
 	}
 
-	l, err := u.loginRepository.Get(login.Username())
+	l, err := u.loginRepository.Get(ctx, login.Username())
 	if err != nil {
 		return false, err
 	}
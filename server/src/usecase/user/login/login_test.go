@@ -1,6 +1,8 @@
 package login
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -16,42 +18,42 @@ func TestAdd(t *testing.T) {
 	usecase := NewLoginUsecase(loginRepository)
 
 	t.Run("success", func(t *testing.T) {
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
-		loginRepository.EXPECT().Create(gomock.Any()).Return(nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+		loginRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
 		username, _ := username.NewUsername("user")
-		err := usecase.Add(login.NewLogin(username, ""))
+		err := usecase.Add(context.Background(), login.NewLogin(username, ""))
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
 	})
 
 	t.Run("user already exists", func(t *testing.T) {
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
 
 		username, _ := username.NewUsername("user")
-		err := usecase.Add(login.NewLogin(username, ""))
-		if expected := UsernameAlreadyExists; err.Error() != expected {
+		err := usecase.Add(context.Background(), login.NewLogin(username, ""))
+		if expected := UsernameAlreadyExists; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
 
 	t.Run("Exists return error", func(t *testing.T) {
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(false, fmt.Errorf("error occurred"))
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("error occurred"))
 
 		username, _ := username.NewUsername("user")
-		err := usecase.Add(login.NewLogin(username, ""))
+		err := usecase.Add(context.Background(), login.NewLogin(username, ""))
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
 	})
 
 	t.Run("Create return error", func(t *testing.T) {
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
-		loginRepository.EXPECT().Create(gomock.Any()).Return(fmt.Errorf("error occurred"))
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+		loginRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error occurred"))
 
 		username, _ := username.NewUsername("user")
-		err := usecase.Add(login.NewLogin(username, ""))
+		err := usecase.Add(context.Background(), login.NewLogin(username, ""))
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
@@ -64,42 +66,42 @@ func TestDelete(t *testing.T) {
 	usecase := NewLoginUsecase(loginRepository)
 
 	t.Run("success", func(t *testing.T) {
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Delete(gomock.Any()).Return(nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
 
 		username, _ := username.NewUsername("user")
-		err := usecase.Delete(login.NewLogin(username, ""))
+		err := usecase.Delete(context.Background(), login.NewLogin(username, ""))
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
 	})
 
 	t.Run("user not found", func(t *testing.T) {
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
 		username, _ := username.NewUsername("user")
-		err := usecase.Delete(login.NewLogin(username, ""))
-		if expected := UsernameNotFound; err.Error() != expected {
+		err := usecase.Delete(context.Background(), login.NewLogin(username, ""))
+		if expected := UsernameNotFound; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
 
 	t.Run("Exists return error", func(t *testing.T) {
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, fmt.Errorf("error occurred"))
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, fmt.Errorf("error occurred"))
 
 		username, _ := username.NewUsername("user")
-		err := usecase.Delete(login.NewLogin(username, ""))
+		err := usecase.Delete(context.Background(), login.NewLogin(username, ""))
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
 	})
 
 	t.Run("Delete return error", func(t *testing.T) {
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("error occurred"))
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error occurred"))
 
 		username, _ := username.NewUsername("user")
-		err := usecase.Delete(login.NewLogin(username, ""))
+		err := usecase.Delete(context.Background(), login.NewLogin(username, ""))
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
@@ -116,10 +118,10 @@ func TestVerify(t *testing.T) {
 		password := "password"
 		login := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(login, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(login, nil)
 
-		v, err := usecase.Verify(login)
+		v, err := usecase.Verify(context.Background(), login)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
@@ -133,10 +135,10 @@ func TestVerify(t *testing.T) {
 		password := "password"
 		login := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(false, nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		_, err := usecase.Verify(login)
-		if expected := UsernameNotFound; err.Error() != expected {
+		_, err := usecase.Verify(context.Background(), login)
+		if expected := UsernameNotFound; !errors.Is(err, expected) {
 			t.Fatalf("expected: %v; got: %v\n", expected, err)
 		}
 	})
@@ -146,10 +148,10 @@ func TestVerify(t *testing.T) {
 		password := "password1"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(login.NewLogin(username, "password2"), nil)
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(login.NewLogin(username, "password2"), nil)
 
-		v, err := usecase.Verify(l)
+		v, err := usecase.Verify(context.Background(), l)
 		if err != nil {
 			t.Fatalf("unexpected error: %v\n", err)
 		}
@@ -163,9 +165,9 @@ func TestVerify(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, fmt.Errorf("error occurred"))
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, fmt.Errorf("error occurred"))
 
-		_, err := usecase.Verify(l)
+		_, err := usecase.Verify(context.Background(), l)
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}
@@ -176,10 +178,10 @@ func TestVerify(t *testing.T) {
 		password := "password"
 		l := login.NewLogin(username, password)
 
-		loginRepository.EXPECT().Exists(gomock.Any()).Return(true, nil)
-		loginRepository.EXPECT().Get(gomock.Any()).Return(l, fmt.Errorf("error occurred"))
+		loginRepository.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+		loginRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(l, fmt.Errorf("error occurred"))
 
-		_, err := usecase.Verify(l)
+		_, err := usecase.Verify(context.Background(), l)
 		if err == nil {
 			t.Fatalf("expected error but got nil")
 		}